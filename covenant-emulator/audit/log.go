@@ -0,0 +1,241 @@
+// Package audit implements an append-only, hash-chained log of the signing
+// decisions of a covenant member. Each record commits to the hash of the
+// previous one, so modifying, reordering or removing a record breaks the chain
+// from that record onwards.
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// the file is only appended to and only readable by its owner
+	logFilePermissions = 0o600
+	logDirPermissions  = 0o700
+)
+
+// GenesisHash is the previous hash of the first record of a log
+var GenesisHash = hex.EncodeToString(make([]byte, sha256.Size))
+
+// ErrBroken is returned by Append once a failed append could not be rolled
+// back, as the file may end with a partial record
+var ErrBroken = errors.New("the audit log is broken by a failed append")
+
+// logFile is the file of a Log, which is opened for appending
+type logFile interface {
+	io.Writer
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+// Log is an append-only, hash-chained audit log backed by a file of JSON
+// lines. It is safe for concurrent use.
+type Log struct {
+	mu       sync.Mutex
+	f        logFile
+	size     int64 // the end of the last complete record in the file
+	nextSeq  uint64
+	lastHash string
+	broken   error // the error of the failed rollback, if any
+
+	discardedBytes int64
+}
+
+// Open opens the audit log at the given path, creating it if it does not
+// exist. The existing records are verified first, so that no record is
+// appended to a broken chain. An incomplete last line, left by a process that
+// stopped in the middle of an append, is cut off the file, and its size is
+// returned by DiscardedBytes.
+func Open(path string) (*Log, error) {
+	if err := os.MkdirAll(filepath.Dir(path), logDirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create the audit log directory: %w", err)
+	}
+
+	res, err := Verify(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("the audit log %s is invalid: %w", path, err)
+	}
+	if res == nil {
+		res = &VerifyResult{LastHash: GenesisHash}
+	}
+
+	// the record of an interrupted append is not part of the chain, and its
+	// signatures are never returned
+	if res.PartialTailBytes > 0 {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat the audit log %s: %w", path, err)
+		}
+		if err := os.Truncate(path, info.Size()-res.PartialTailBytes); err != nil {
+			return nil, fmt.Errorf("failed to discard the incomplete last line of the audit log %s: %w", path, err)
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFilePermissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the audit log %s: %w", path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+
+		return nil, fmt.Errorf("failed to stat the audit log %s: %w", path, err)
+	}
+
+	return &Log{
+		f:              f,
+		size:           info.Size(),
+		nextSeq:        res.Records,
+		lastHash:       res.LastHash,
+		discardedBytes: res.PartialTailBytes,
+	}, nil
+}
+
+// DiscardedBytes returns the size of the incomplete last line cut off the file
+// by Open, or 0 if the log ended with a complete record
+func (l *Log) DiscardedBytes() int64 {
+	return l.discardedBytes
+}
+
+// Append chains the given record to the log and writes it durably. The
+// sequence number, time and hashes of the record are set by the log. If the
+// record cannot be written durably, the file is truncated back to the end of
+// the previous record. If that fails as well, the log refuses any further
+// append with ErrBroken, and has to be opened again, which cuts the partial
+// record off the file.
+func (l *Log) Append(r *Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.broken != nil {
+		return fmt.Errorf("%w: %w", ErrBroken, l.broken)
+	}
+
+	r.Seq = l.nextSeq
+	r.Timestamp = time.Now().UnixNano()
+	r.PrevHash = l.lastHash
+	hash, err := r.computeHash()
+	if err != nil {
+		return err
+	}
+	r.Hash = hash
+
+	line, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to encode the audit record: %w", err)
+	}
+	line = append(line, '\n')
+	n, err := l.f.Write(line)
+	if err == nil && n < len(line) {
+		err = io.ErrShortWrite
+	}
+	if err != nil {
+		return l.rollback(fmt.Errorf("failed to write the audit record: %w", err))
+	}
+	if err := l.f.Sync(); err != nil {
+		return l.rollback(fmt.Errorf("failed to sync the audit log: %w", err))
+	}
+
+	l.size += int64(n)
+	l.nextSeq++
+	l.lastHash = hash
+
+	return nil
+}
+
+// rollback truncates the file back to the end of the last complete record after
+// the given append error, and marks the log broken if it cannot
+// The caller should hold mu
+func (l *Log) rollback(appendErr error) error {
+	err := l.f.Truncate(l.size)
+	if err == nil {
+		err = l.f.Sync()
+	}
+	if err != nil {
+		l.broken = fmt.Errorf("failed to truncate the audit log after an append error: %w", err)
+
+		return fmt.Errorf("%w: %w", appendErr, l.broken)
+	}
+
+	return appendErr
+}
+
+// Close closes the file of the log
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.f.Close()
+}
+
+// VerifyResult is the summary of a verified audit log
+type VerifyResult struct {
+	// Records is the number of records in the log
+	Records uint64 `json:"records"`
+	// LastHash is the hash of the last record, which commits to the whole
+	// log and can be anchored elsewhere to detect the truncation of the log
+	LastHash string `json:"last_hash"`
+	// PartialTailBytes is the size of the incomplete last line of the log,
+	// which is left by an interrupted append and is not part of the chain
+	PartialTailBytes int64 `json:"partial_tail_bytes,omitempty"`
+}
+
+// Verify checks the integrity of the hash chain of the audit log at the given
+// path and returns the error of the first broken record. Every record is
+// written as a single line ending with a newline, so an unterminated last line
+// is reported as a partial tail rather than as a broken record.
+func Verify(path string) (*VerifyResult, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	res := &VerifyResult{LastHash: GenesisHash}
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to read the audit log: %w", err)
+		}
+		if errors.Is(err, io.EOF) {
+			res.PartialTailBytes = int64(len(line))
+			break
+		}
+
+		var r Record
+		if err := json.Unmarshal(line, &r); err != nil {
+			return nil, fmt.Errorf("record %d: malformed: %w", res.Records, err)
+		}
+		if r.Seq != res.Records {
+			return nil, fmt.Errorf("record %d: unexpected sequence number %d", res.Records, r.Seq)
+		}
+		if r.PrevHash != res.LastHash {
+			return nil, fmt.Errorf("record %d: previous hash %s does not match the hash %s of the previous record",
+				r.Seq, r.PrevHash, res.LastHash)
+		}
+		hash, err := r.computeHash()
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", r.Seq, err)
+		}
+		if r.Hash != hash {
+			return nil, fmt.Errorf("record %d: hash %s does not match its content hash %s", r.Seq, r.Hash, hash)
+		}
+
+		res.Records++
+		res.LastHash = r.Hash
+	}
+
+	return res, nil
+}
@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// faultyFile writes only half of the next record, and fails to truncate if
+// failTruncate is set
+type faultyFile struct {
+	logFile
+	shortWrite   bool
+	failTruncate bool
+}
+
+func (f *faultyFile) Write(p []byte) (int, error) {
+	if !f.shortWrite {
+		return f.logFile.Write(p)
+	}
+	f.shortWrite = false
+
+	return f.logFile.Write(p[:len(p)/2])
+}
+
+func (f *faultyFile) Truncate(size int64) error {
+	if f.failTruncate {
+		return errors.New("truncate failed")
+	}
+
+	return f.logFile.Truncate(size)
+}
+
+func newInternalTestRecord(lockTime uint32) *Record {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	tx.LockTime = lockTime
+
+	return NewRecord(tx, tx, tx, tx)
+}
+
+func TestAuditLogShortWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := Open(path)
+	require.NoError(t, err)
+	require.NoError(t, l.Append(newInternalTestRecord(0)))
+
+	// the partial record is truncated and the chain continues
+	f := &faultyFile{logFile: l.f, shortWrite: true}
+	l.f = f
+	require.ErrorIs(t, l.Append(newInternalTestRecord(1)), io.ErrShortWrite)
+	res, err := Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Records)
+	require.Zero(t, res.PartialTailBytes)
+
+	require.NoError(t, l.Append(newInternalTestRecord(2)))
+	res, err = Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Records)
+
+	// the log refuses to append once the partial record cannot be truncated
+	f.shortWrite = true
+	f.failTruncate = true
+	require.Error(t, l.Append(newInternalTestRecord(3)))
+	require.ErrorIs(t, l.Append(newInternalTestRecord(4)), ErrBroken)
+	require.NoError(t, l.Close())
+
+	// reopening the log cuts the partial record off
+	l, err = Open(path)
+	require.NoError(t, err)
+	require.Positive(t, l.DiscardedBytes())
+	require.NoError(t, l.Append(newInternalTestRecord(5)))
+	require.NoError(t, l.Close())
+
+	res, err = Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Records)
+}
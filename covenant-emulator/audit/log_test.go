@@ -0,0 +1,141 @@
+package audit_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/covenant-emulator/audit"
+)
+
+func newTestTx(lockTime uint32) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	tx.LockTime = lockTime
+
+	return tx
+}
+
+func appendTestRecords(t *testing.T, l *audit.Log, n int) {
+	for i := 0; i < n; i++ {
+		r := audit.NewRecord(newTestTx(uint32(i)), newTestTx(1), newTestTx(2), newTestTx(3))
+		if i%2 == 0 {
+			r.PolicyVerdict = audit.PolicyAccepted
+			r.SetSignatures([][]byte{{0x01, 0x02}}, []byte{0x03}, [][]byte{{0x04}})
+		} else {
+			r.PolicyVerdict = audit.PolicyRejected
+			r.PolicyReason = "max_staking_amount"
+		}
+		require.NoError(t, l.Append(r))
+	}
+}
+
+func TestAuditLogChain(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+
+	l, err := audit.Open(path)
+	require.NoError(t, err)
+	appendTestRecords(t, l, 3)
+	require.NoError(t, l.Close())
+
+	// the chain continues after reopening the log
+	l, err = audit.Open(path)
+	require.NoError(t, err)
+	appendTestRecords(t, l, 2)
+	require.NoError(t, l.Close())
+
+	res, err := audit.Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.Records)
+	require.NotEqual(t, audit.GenesisHash, res.LastHash)
+}
+
+func TestAuditLogTampering(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := audit.Open(path)
+	require.NoError(t, err)
+	appendTestRecords(t, l, 4)
+	require.NoError(t, l.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(content), "\n"), "\n")
+	require.Len(t, lines, 4)
+
+	writeLines := func(lines ...string) string {
+		p := filepath.Join(t.TempDir(), "tampered.log")
+		require.NoError(t, os.WriteFile(p, []byte(strings.Join(lines, "")), 0o600))
+		return p
+	}
+
+	// modifying the verdict of a record
+	modified := strings.Replace(lines[1], `"policy_verdict":"rejected"`, `"policy_verdict":"accepted"`, 1)
+	require.NotEqual(t, lines[1], modified)
+	_, err = audit.Verify(writeLines(lines[0], modified, lines[2], lines[3]))
+	require.ErrorContains(t, err, "record 1")
+
+	// removing a record
+	_, err = audit.Verify(writeLines(lines[0], lines[2], lines[3]))
+	require.ErrorContains(t, err, "record 1")
+
+	// reordering records
+	_, err = audit.Verify(writeLines(lines[0], lines[2], lines[1], lines[3]))
+	require.ErrorContains(t, err, "record 1")
+
+	// no record is appended to a broken chain
+	_, err = audit.Open(writeLines(lines[0], lines[2]))
+	require.Error(t, err)
+}
+
+func TestAuditLogInterruptedAppend(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := audit.Open(path)
+	require.NoError(t, err)
+	appendTestRecords(t, l, 2)
+	require.NoError(t, l.Close())
+
+	complete, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(complete), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	// a process stopped in the middle of appending a third record
+	partial := lines[1][:len(lines[1])/2]
+	require.NoError(t, os.WriteFile(path, append(complete, partial...), 0o600))
+
+	res, err := audit.Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Records)
+	require.Equal(t, int64(len(partial)), res.PartialTailBytes)
+
+	// the partial line is cut off and the chain continues from the last
+	// complete record
+	l, err = audit.Open(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(partial)), l.DiscardedBytes())
+	appendTestRecords(t, l, 1)
+	require.NoError(t, l.Close())
+
+	res, err = audit.Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Records)
+	require.Zero(t, res.PartialTailBytes)
+
+	// a malformed line that is terminated is not an interrupted append
+	require.NoError(t, os.WriteFile(path, append(complete, partial+"\n"...), 0o600))
+	_, err = audit.Verify(path)
+	require.ErrorContains(t, err, "record 2")
+	_, err = audit.Open(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,99 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// PolicyVerdict is the verdict of the signing policy on a delegation
+type PolicyVerdict string
+
+const (
+	// PolicyNone is recorded when no signing policy is enforced
+	PolicyNone     PolicyVerdict = "none"
+	PolicyAccepted PolicyVerdict = "accepted"
+	PolicyRejected PolicyVerdict = "rejected"
+)
+
+// Record is a signing decision of a covenant member
+type Record struct {
+	Seq uint64 `json:"seq"`
+	// Timestamp is the unix time in nanoseconds the record is appended at
+	Timestamp int64 `json:"timestamp"`
+
+	StakingTxHash        string `json:"staking_tx_hash"`
+	SlashingTxHash       string `json:"slashing_tx_hash"`
+	UnbondingTxHash      string `json:"unbonding_tx_hash"`
+	SlashUnbondingTxHash string `json:"slash_unbonding_tx_hash"`
+
+	PolicyVerdict PolicyVerdict `json:"policy_verdict"`
+	PolicyReason  string        `json:"policy_reason,omitempty"`
+
+	// the hex encoded signatures produced, which are empty if the delegation
+	// is not signed
+	SlashAdaptorSigs          []string `json:"slash_adaptor_sigs,omitempty"`
+	UnbondingSig              string   `json:"unbonding_sig,omitempty"`
+	SlashUnbondingAdaptorSigs []string `json:"slash_unbonding_adaptor_sigs,omitempty"`
+	// Error is the signing error if the delegation is accepted but failed to
+	// be signed
+	Error string `json:"error,omitempty"`
+
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// NewRecord creates a record of a signing decision on the given txs
+func NewRecord(stakingTx, slashingTx, unbondingTx, slashUnbondingTx *wire.MsgTx) *Record {
+	return &Record{
+		StakingTxHash:        txHash(stakingTx),
+		SlashingTxHash:       txHash(slashingTx),
+		UnbondingTxHash:      txHash(unbondingTx),
+		SlashUnbondingTxHash: txHash(slashUnbondingTx),
+		PolicyVerdict:        PolicyNone,
+	}
+}
+
+// SetSignatures records the signatures produced
+func (r *Record) SetSignatures(slashAdaptorSigs [][]byte, unbondingSig []byte, slashUnbondingAdaptorSigs [][]byte) {
+	r.SlashAdaptorSigs = hexAll(slashAdaptorSigs)
+	r.UnbondingSig = hex.EncodeToString(unbondingSig)
+	r.SlashUnbondingAdaptorSigs = hexAll(slashUnbondingAdaptorSigs)
+}
+
+// computeHash returns the hex encoded SHA-256 hash of the record without its
+// own hash, which commits to the previous hash
+func (r *Record) computeHash() (string, error) {
+	unhashed := *r
+	unhashed.Hash = ""
+	bz, err := json.Marshal(&unhashed)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode the audit record: %w", err)
+	}
+	hash := sha256.Sum256(bz)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func txHash(tx *wire.MsgTx) string {
+	if tx == nil {
+		return ""
+	}
+
+	return tx.TxHash().String()
+}
+
+func hexAll(bzs [][]byte) []string {
+	if len(bzs) == 0 {
+		return nil
+	}
+	res := make([]string, 0, len(bzs))
+	for _, bz := range bzs {
+		res = append(res, hex.EncodeToString(bz))
+	}
+
+	return res
+}
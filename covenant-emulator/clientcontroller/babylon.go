@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -18,6 +19,7 @@ import (
 	btcstakingtypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
@@ -37,6 +39,10 @@ type BabylonController struct {
 	cfg       *config.BBNConfig
 	btcParams *chaincfg.Params
 	logger    *zap.Logger
+
+	// quit stops forwarding the events of the subscriptions
+	quit      chan struct{}
+	closeOnce sync.Once
 }
 
 func NewBabylonController(
@@ -60,10 +66,11 @@ func NewBabylonController(
 	}
 
 	return &BabylonController{
-		bc,
-		cfg,
-		btcParams,
-		logger,
+		bbnClient: bc,
+		cfg:       cfg,
+		btcParams: btcParams,
+		logger:    logger,
+		quit:      make(chan struct{}),
 	}, nil
 }
 
@@ -190,6 +197,19 @@ func (bc *BabylonController) QueryPendingDelegations(limit uint64, filter Filter
 	return bc.queryDelegationsWithStatus(btcstakingtypes.BTCDelegationStatus_PENDING, limit, filter)
 }
 
+func (bc *BabylonController) QueryPendingDelegation(stakingTxHash chainhash.Hash) (*types.Delegation, error) {
+	res, err := bc.bbnClient.QueryClient.BTCDelegation(stakingTxHash.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query BTC delegation %s: %v", stakingTxHash.String(), err)
+	}
+
+	if res.BtcDelegation.StatusDesc != btcstakingtypes.BTCDelegationStatus_PENDING.String() {
+		return nil, nil
+	}
+
+	return DelegationRespToDelegation(res.BtcDelegation)
+}
+
 func (bc *BabylonController) QueryActiveDelegations(limit uint64) ([]*types.Delegation, error) {
 	return bc.queryDelegationsWithStatus(btcstakingtypes.BTCDelegationStatus_ACTIVE, limit, nil)
 }
@@ -258,6 +278,10 @@ func getContextWithCancel(timeout time.Duration) (context.Context, context.Cance
 }
 
 func (bc *BabylonController) Close() error {
+	bc.closeOnce.Do(func() {
+		close(bc.quit)
+	})
+
 	if !bc.bbnClient.IsRunning() {
 		return nil
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"go.uber.org/zap"
 
 	"github.com/babylonlabs-io/covenant-emulator/config"
@@ -25,6 +26,14 @@ type (
 		// QueryPendingDelegations queries BTC delegations that are in status of pending
 		QueryPendingDelegations(limit uint64, filter FilterFn) ([]*types.Delegation, error)
 
+		// QueryPendingDelegation queries the BTC delegation of the given staking tx hash
+		// it returns nil if the delegation is not in status of pending
+		QueryPendingDelegation(stakingTxHash chainhash.Hash) (*types.Delegation, error)
+
+		// SubscribeDelegationEvents subscribes to the creation and unbonding events of BTC delegations
+		// the returned channel is closed when the subscription drops, after which it can be subscribed again
+		SubscribeDelegationEvents() (<-chan *types.DelegationEvent, error)
+
 		QueryStakingParamsByVersion(version uint32) (*types.StakingParams, error)
 
 		Close() error
@@ -0,0 +1,185 @@
+package clientcontroller
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/covenant-emulator/types"
+)
+
+const (
+	subscriberName = "covenant-emulator"
+
+	// maxEventWaitInterval is the time without new blocks after which the
+	// subscription is considered dropped, as the websocket client does not
+	// detect dead connections by itself
+	maxEventWaitInterval = time.Minute
+
+	// delegationEventsCapacity is the capacity of the channel of delegation
+	// events, which are never dropped when it is full
+	delegationEventsCapacity = 100
+
+	eventBTCDelegationCreated      = "babylon.btcstaking.v1.EventBTCDelegationCreated"
+	eventBTCDelgationUnbondedEarly = "babylon.btcstaking.v1.EventBTCDelgationUnbondedEarly"
+	eventBTCDelegationExpired      = "babylon.btcstaking.v1.EventBTCDelegationExpired"
+)
+
+var (
+	// the expiration events are emitted at the beginning of blocks, so they
+	// are received with the new blocks, which also tell the subscription is
+	// alive
+	newBlockQuery          = "tm.event='NewBlock'"
+	delegationCreatedQuery = fmt.Sprintf("tm.event='Tx' AND %s.staking_tx_hex EXISTS", eventBTCDelegationCreated)
+	unbondedEarlyQuery     = fmt.Sprintf("tm.event='Tx' AND %s.staking_tx_hash EXISTS", eventBTCDelgationUnbondedEarly)
+)
+
+// SubscribeDelegationEvents subscribes to the creation and unbonding events
+// of BTC delegations over the websocket of the Babylon node
+func (bc *BabylonController) SubscribeDelegationEvents() (<-chan *types.DelegationEvent, error) {
+	rpcClient := bc.bbnClient.RPCClient
+	if !rpcClient.IsRunning() {
+		if err := rpcClient.Start(); err != nil {
+			return nil, fmt.Errorf("failed to start the websocket client: %w", err)
+		}
+	}
+
+	ctx, cancel := getContextWithCancel(bc.cfg.Timeout)
+	defer cancel()
+
+	queries := []string{newBlockQuery, delegationCreatedQuery, unbondedEarlyQuery}
+	eventChans := make([]<-chan ctypes.ResultEvent, 0, len(queries))
+	for _, query := range queries {
+		// the events are not dropped by the websocket client if the channel
+		// is unbuffered
+		eventChan, err := rpcClient.Subscribe(ctx, subscriberName, query, 0)
+		if err != nil {
+			bc.unsubscribeAll()
+			return nil, fmt.Errorf("failed to subscribe to %s: %w", query, err)
+		}
+		eventChans = append(eventChans, eventChan)
+	}
+
+	out := make(chan *types.DelegationEvent, delegationEventsCapacity)
+	go bc.forwardDelegationEvents(eventChans[0], eventChans[1], eventChans[2], out)
+
+	return out, nil
+}
+
+// forwardDelegationEvents forwards the delegation events of the subscriptions
+// until no new block is received for maxEventWaitInterval or the controller
+// is closed, after which the out channel is closed
+func (bc *BabylonController) forwardDelegationEvents(
+	newBlocks, created, unbondedEarly <-chan ctypes.ResultEvent,
+	out chan<- *types.DelegationEvent,
+) {
+	defer close(out)
+
+	timeout := time.NewTimer(maxEventWaitInterval)
+	defer timeout.Stop()
+
+	for {
+		var event ctypes.ResultEvent
+		select {
+		case event = <-newBlocks:
+			timeout.Reset(maxEventWaitInterval)
+		case event = <-created:
+		case event = <-unbondedEarly:
+		case <-timeout.C:
+			bc.logger.Warn("no new block is received from the subscription, dropping it",
+				zap.Duration("max_event_wait_interval", maxEventWaitInterval))
+			bc.unsubscribeAndDrain(newBlocks, created, unbondedEarly)
+			return
+		case <-bc.quit:
+			return
+		}
+
+		for _, delEvent := range bc.parseDelegationEvents(event) {
+			select {
+			case out <- delEvent:
+			case <-bc.quit:
+				return
+			}
+		}
+	}
+}
+
+// parseDelegationEvents returns the delegation events contained in the given
+// event of a subscription
+func (bc *BabylonController) parseDelegationEvents(event ctypes.ResultEvent) []*types.DelegationEvent {
+	var delEvents []*types.DelegationEvent
+
+	for _, value := range event.Events[eventBTCDelegationCreated+".staking_tx_hex"] {
+		stakingTx, _, err := bbntypes.NewBTCTxFromHex(unquoteAttribute(value))
+		if err != nil {
+			bc.logger.Error("invalid staking tx in the delegation creation event", zap.String("staking_tx_hex", value), zap.Error(err))
+			continue
+		}
+		delEvents = append(delEvents, &types.DelegationEvent{
+			Type:          types.DelegationCreated,
+			StakingTxHash: stakingTx.TxHash(),
+		})
+	}
+
+	for _, eventType := range []string{eventBTCDelgationUnbondedEarly, eventBTCDelegationExpired} {
+		for _, value := range event.Events[eventType+".staking_tx_hash"] {
+			stakingTxHash, err := chainhash.NewHashFromStr(unquoteAttribute(value))
+			if err != nil {
+				bc.logger.Error("invalid staking tx hash in the delegation event",
+					zap.String("event", eventType), zap.String("staking_tx_hash", value), zap.Error(err))
+				continue
+			}
+			delEvents = append(delEvents, &types.DelegationEvent{
+				Type:          types.DelegationUnbonded,
+				StakingTxHash: *stakingTxHash,
+			})
+		}
+	}
+
+	return delEvents
+}
+
+func (bc *BabylonController) unsubscribeAll() {
+	ctx, cancel := getContextWithCancel(bc.cfg.Timeout)
+	defer cancel()
+
+	if err := bc.bbnClient.RPCClient.UnsubscribeAll(ctx, subscriberName); err != nil {
+		bc.logger.Debug("failed to unsubscribe from the delegation events", zap.Error(err))
+	}
+}
+
+// unsubscribeAndDrain unsubscribes while discarding the events still sent
+// to the subscriptions, as the websocket client blocks on sending them and
+// unsubscribing waits for it
+func (bc *BabylonController) unsubscribeAndDrain(newBlocks, created, unbondedEarly <-chan ctypes.ResultEvent) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		bc.unsubscribeAll()
+	}()
+
+	for {
+		select {
+		case <-newBlocks:
+		case <-created:
+		case <-unbondedEarly:
+		case <-done:
+			return
+		}
+	}
+}
+
+// unquoteAttribute returns the value of an attribute of a typed event, which
+// is JSON encoded
+func unquoteAttribute(value string) string {
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		return unquoted
+	}
+
+	return value
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/covenant-emulator/audit"
+	covcfg "github.com/babylonlabs-io/covenant-emulator/config"
+)
+
+const auditLogFileFlag = "file"
+
+var auditCommand = cli.Command{
+	Name:  "audit",
+	Usage: "Inspect the audit log of the signing decisions.",
+	Subcommands: []cli.Command{
+		verifyAuditCommand,
+	},
+}
+
+var verifyAuditCommand = cli.Command{
+	Name:  "verify",
+	Usage: "Verify the integrity of the hash chain of the audit log.",
+	Description: "Verify that no record of the audit log has been modified, reordered or removed. " +
+		"The hash of the last record commits to the whole log, so comparing it with a copy kept " +
+		"elsewhere also detects the truncation of the log. An incomplete last line left by an " +
+		"interrupted append is reported as partial_tail_bytes and is discarded on the next start.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The home directory for the covenant, whose config sets the audit log file",
+			Value: covcfg.DefaultCovenantDir,
+		},
+		cli.StringFlag{
+			Name:  auditLogFileFlag,
+			Usage: "The path to the audit log file, which overrides the one of the config",
+		},
+	},
+	Action: verifyAudit,
+}
+
+func verifyAudit(ctx *cli.Context) error {
+	path := ctx.String(auditLogFileFlag)
+	if path == "" {
+		homePath := ctx.String(homeFlag)
+		cfg, err := covcfg.LoadConfig(homePath)
+		if err != nil {
+			return fmt.Errorf("failed to load the config from %s: %w", covcfg.ConfigFile(homePath), err)
+		}
+		if cfg.AuditLogFile == "" {
+			return fmt.Errorf("no audit log file is configured in %s", covcfg.ConfigFile(homePath))
+		}
+		path = cfg.AuditLogFile
+	}
+
+	res, err := audit.Verify(path)
+	if err != nil {
+		return fmt.Errorf("the audit log %s is invalid: %w", path, err)
+	}
+
+	printRespJSON(res)
+
+	return nil
+}
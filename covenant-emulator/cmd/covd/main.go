@@ -16,7 +16,7 @@ func main() {
 	app := cli.NewApp()
 	app.Name = "covd"
 	app.Usage = "Covenant Emulator Daemon (covd)."
-	app.Commands = append(app.Commands, startCommand, initCommand, createKeyCommand, showKeyCommand)
+	app.Commands = append(app.Commands, startCommand, initCommand, createKeyCommand, showKeyCommand, auditCommand)
 
 	if err := app.Run(os.Args); err != nil {
 		fatal(err)
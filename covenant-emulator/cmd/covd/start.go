@@ -78,5 +78,10 @@ func start(ctx *cli.Context) error {
 }
 
 func newRemoteSignerFromConfig(cfg *covcfg.Config) (covenant.Signer, error) {
-	return remotesigner.NewRemoteSigner(cfg.RemoteSigner), nil
+	signer, err := remotesigner.NewRemoteSigner(cfg.RemoteSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer, nil
 }
@@ -35,11 +35,14 @@ var (
 )
 
 type Config struct {
-	LogLevel        string        `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
-	QueryInterval   time.Duration `long:"queryinterval" description:"The interval between each query for pending BTC delegations"`
-	DelegationLimit uint64        `long:"delegationlimit" description:"The maximum number of delegations that the Covenant processes each time"`
-	SigsBatchSize   uint64        `long:"sigsbatchsize" description:"The maximum number of signatures to send in a single transaction"`
-	BitcoinNetwork  string        `long:"bitcoinnetwork" description:"Bitcoin network to run on" choice:"mainnet" choice:"regtest" choice:"testnet" choice:"simnet" choice:"signet"`
+	LogLevel          string        `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
+	QueryInterval     time.Duration `long:"queryinterval" description:"The interval between each query for pending BTC delegations"`
+	DelegationLimit   uint64        `long:"delegationlimit" description:"The maximum number of delegations that the Covenant processes each time"`
+	SigsBatchSize     uint64        `long:"sigsbatchsize" description:"The maximum number of signatures to send in a single transaction"`
+	BitcoinNetwork    string        `long:"bitcoinnetwork" description:"Bitcoin network to run on" choice:"mainnet" choice:"regtest" choice:"testnet" choice:"simnet" choice:"signet"`
+	PolicyFile        string        `long:"policyfile" description:"The path to the TOML file of the signing policy enforced before signing each delegation; no policy is enforced if empty"`
+	AuditLogFile      string        `long:"auditlogfile" description:"The path to the hash-chained audit log of the signing decisions; no audit log is kept if empty"`
+	EventSubscription bool          `long:"eventsubscription" description:"Whether to sign BTC delegations as soon as they are created by subscribing to Babylon events; pending delegations are polled every query interval only while the subscription is down"`
 
 	BTCNetParams chaincfg.Params
 
@@ -8,8 +8,13 @@ const (
 )
 
 type RemoteSignerCfg struct {
-	URL     string        `long:"url" description:"URL of the remote signer"`
-	Timeout time.Duration `long:"timeout" description:"client when making requests to the remote signer"`
+	URL               string        `long:"url" description:"URL of the remote signer"`
+	Timeout           time.Duration `long:"timeout" description:"client when making requests to the remote signer"`
+	TLSCertFile       string        `long:"tlscertfile" description:"The PEM encoded client certificate presented to the remote signer over https"`
+	TLSKeyFile        string        `long:"tlskeyfile" description:"The PEM encoded key of the client certificate"`
+	TLSCAFile         string        `long:"tlscafile" description:"The PEM encoded CA certificates the certificate of the remote signer must chain to; the system CAs are used if empty"`
+	PinnedServerCerts []string      `long:"pinnedservercert" description:"The hex encoded SHA-256 fingerprint of an accepted certificate of the remote signer; the certificate is verified by its fingerprint only if no CA file is set"`
+	HMACKeyFile       string        `long:"hmackeyfile" description:"The path to the hex encoded key the requests to the remote signer are signed with; requests are not signed if empty"`
 }
 
 func DefaultRemoteSignerConfig() RemoteSignerCfg {
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/keystore/pkcs11"
+)
+
+const pinKey = "pin"
+
+func init() {
+	pkcs11GenerateKeyCmd.Flags().String(pinKey, "", "the user PIN of the token")
+	rootCmd.AddCommand(pkcs11GenerateKeyCmd)
+}
+
+var pkcs11GenerateKeyCmd = &cobra.Command{
+	Use:   "pkcs11-generate-key",
+	Short: "generates the covenant key in the HSM of the pkcs11 key store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPath, err := cmd.Flags().GetString(configPathKey)
+		if err != nil {
+			return err
+		}
+		pin, err := cmd.Flags().GetString(pinKey)
+		if err != nil {
+			return err
+		}
+
+		cfg, err := config.GetConfig(configPath)
+		if err != nil {
+			return err
+		}
+		parsedConfig, err := cfg.KeyStore.Parse()
+		if err != nil {
+			return err
+		}
+		if parsedConfig.KeyStoreType != config.Pkcs11KeyStore {
+			return fmt.Errorf("the key store of the config is not pkcs11")
+		}
+
+		hr, err := pkcs11.NewPkcs11Retriever(parsedConfig.Pkcs11KeyStore)
+		if err != nil {
+			return err
+		}
+		defer hr.Close()
+
+		if err := hr.Unlock(cmd.Context(), pin); err != nil {
+			return err
+		}
+
+		pk, err := hr.GenerateKey(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Generated key %s with public key: %s\n",
+			parsedConfig.Pkcs11KeyStore.KeyLabel, hex.EncodeToString(schnorr.SerializePubKey(pk)))
+
+		return nil
+	},
+}
@@ -3,13 +3,17 @@ package cmd
 import (
 	"fmt"
 
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
+	"github.com/babylonlabs-io/covenant-emulator/audit"
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/config"
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/keystore/cosmos"
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/keystore/pkcs11"
 	m "github.com/babylonlabs-io/covenant-emulator/covenant-signer/observability/metrics"
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerapp"
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice"
+	"github.com/babylonlabs-io/covenant-emulator/policy"
 )
 
 func init() {
@@ -36,18 +40,54 @@ var runSignerCmd = &cobra.Command{
 		}
 
 		var prk signerapp.PrivKeyRetriever
-		if parsedConfig.KeyStoreConfig.KeyStoreType == config.CosmosKeyStore {
+		switch parsedConfig.KeyStoreConfig.KeyStoreType {
+		case config.CosmosKeyStore:
 			kr, err := cosmos.NewCosmosKeyringRetriever(parsedConfig.KeyStoreConfig.CosmosKeyStore)
 			if err != nil {
 				return err
 			}
 			prk = kr
-		} else {
+		case config.Pkcs11KeyStore:
+			hr, err := pkcs11.NewPkcs11Retriever(parsedConfig.KeyStoreConfig.Pkcs11KeyStore)
+			if err != nil {
+				return err
+			}
+			defer hr.Close()
+			prk = hr
+		default:
 			return fmt.Errorf("unknown key store type")
 		}
 
+		var opts []signerapp.Option
+		if parsedConfig.PolicyConfig.PolicyFile != "" {
+			policyEngine, err := policy.LoadEngine(
+				parsedConfig.PolicyConfig.PolicyFile,
+				parsedConfig.PolicyConfig.BTCNetParams,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to load the signing policy: %w", err)
+			}
+			opts = append(opts, signerapp.WithPolicy(policyEngine))
+		}
+
+		if parsedConfig.AuditConfig.AuditLogFile != "" {
+			auditLog, err := audit.Open(parsedConfig.AuditConfig.AuditLogFile)
+			if err != nil {
+				return fmt.Errorf("failed to open the audit log: %w", err)
+			}
+			if n := auditLog.DiscardedBytes(); n > 0 {
+				log.Warn().
+					Str("file", parsedConfig.AuditConfig.AuditLogFile).
+					Int64("bytes", n).
+					Msg("discarded the incomplete last line of the audit log left by an interrupted append")
+			}
+			defer auditLog.Close()
+			opts = append(opts, signerapp.WithAuditLog(auditLog))
+		}
+
 		app := signerapp.NewSignerApp(
 			prk,
+			opts...,
 		)
 
 		metrics := m.NewCovenantSignerMetrics()
@@ -0,0 +1,23 @@
+package config
+
+// AuditConfig defines the audit log of the signing decisions of the signer
+type AuditConfig struct {
+	// Path to the hash-chained audit log, no audit log is kept if empty
+	AuditLogFile string `mapstructure:"audit-log-file"`
+}
+
+type ParsedAuditConfig struct {
+	AuditLogFile string
+}
+
+func (cfg *AuditConfig) Parse() (*ParsedAuditConfig, error) {
+	return &ParsedAuditConfig{
+		AuditLogFile: cfg.AuditLogFile,
+	}, nil
+}
+
+func DefaultAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		AuditLogFile: "",
+	}
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice/auth"
+)
+
+// AuthConfig defines how the callers of the signer are authenticated
+type AuthConfig struct {
+	// Paths to the PEM encoded certificate and key of the server, which serves
+	// plain HTTP if empty
+	TLSCertFile string `mapstructure:"tls-cert-file"`
+	TLSKeyFile  string `mapstructure:"tls-key-file"`
+	// Path to the PEM encoded CA certificates the client certificates must
+	// chain to, which requires client certificates
+	ClientCAFile string `mapstructure:"client-ca-file"`
+	// Hex encoded SHA-256 fingerprints of the accepted client certificates,
+	// which requires client certificates
+	PinnedClientCerts []string `mapstructure:"pinned-client-certs"`
+	// Path to the hex encoded key the requests must be signed with, requests
+	// are not required to be signed if empty
+	HMACKeyFile string `mapstructure:"hmac-key-file"`
+	// AllowUnauthenticated allows the signer to accept requests from any
+	// caller if neither client certificates nor signed requests are required
+	AllowUnauthenticated bool `mapstructure:"allow-unauthenticated"`
+}
+
+type ParsedAuthConfig struct {
+	// TLSConfig is nil if the server serves plain HTTP
+	TLSConfig *tls.Config
+	// HMACKey is nil if requests are not required to be signed
+	HMACKey []byte
+	// Unauthenticated is set if the callers are not authenticated
+	Unauthenticated bool
+}
+
+func (cfg *AuthConfig) Parse() (*ParsedAuthConfig, error) {
+	parsed := &ParsedAuthConfig{}
+	clientCerts := cfg.ClientCAFile != "" || len(cfg.PinnedClientCerts) > 0
+
+	if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+		if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+			return nil, fmt.Errorf("tls-cert-file and tls-key-file must be set together")
+		}
+		if clientCerts {
+			return nil, fmt.Errorf("client certificates require tls-cert-file and tls-key-file to be set")
+		}
+	} else {
+		tlsConfig, err := auth.ServerTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.ClientCAFile, cfg.PinnedClientCerts)
+		if err != nil {
+			return nil, err
+		}
+		parsed.TLSConfig = tlsConfig
+	}
+
+	if cfg.HMACKeyFile != "" {
+		key, err := auth.LoadHMACKey(cfg.HMACKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		parsed.HMACKey = key
+	}
+
+	if !clientCerts && parsed.HMACKey == nil {
+		if !cfg.AllowUnauthenticated {
+			return nil, fmt.Errorf("the callers are not authenticated: set client-ca-file, pinned-client-certs, " +
+				"or hmac-key-file, or set allow-unauthenticated to accept requests from any caller")
+		}
+		parsed.Unauthenticated = true
+	}
+
+	return parsed, nil
+}
+
+func DefaultAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		PinnedClientCerts: []string{},
+	}
+}
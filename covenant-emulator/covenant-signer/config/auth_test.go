@@ -0,0 +1,38 @@
+package config_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/config"
+)
+
+func TestAuthConfigRequiresAuthentication(t *testing.T) {
+	t.Parallel()
+
+	// the default config does not authenticate the callers
+	cfg := config.DefaultAuthConfig()
+	_, err := cfg.Parse()
+	require.Error(t, err)
+
+	cfg.AllowUnauthenticated = true
+	parsed, err := cfg.Parse()
+	require.NoError(t, err)
+	require.True(t, parsed.Unauthenticated)
+
+	keyFile := filepath.Join(t.TempDir(), "hmac.key")
+	key := hex.EncodeToString(bytes.Repeat([]byte{0x01}, 32))
+	require.NoError(t, os.WriteFile(keyFile, []byte(key), 0600))
+
+	cfg = config.DefaultAuthConfig()
+	cfg.HMACKeyFile = keyFile
+	parsed, err = cfg.Parse()
+	require.NoError(t, err)
+	require.False(t, parsed.Unauthenticated)
+	require.NotNil(t, parsed.HMACKey)
+}
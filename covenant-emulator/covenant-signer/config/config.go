@@ -19,6 +19,9 @@ type Config struct {
 	KeyStore KeyStoreConfig `mapstructure:"keystore"`
 	Server   ServerConfig   `mapstructure:"server-config"`
 	Metrics  MetricsConfig  `mapstructure:"metrics"`
+	Policy   PolicyConfig   `mapstructure:"policy"`
+	Audit    AuditConfig    `mapstructure:"audit"`
+	Auth     AuthConfig     `mapstructure:"auth"`
 }
 
 func DefaultConfig() *Config {
@@ -26,6 +29,9 @@ func DefaultConfig() *Config {
 		KeyStore: *DefaultKeyStoreConfig(),
 		Server:   *DefaultServerConfig(),
 		Metrics:  *DefaultMetricsConfig(),
+		Policy:   *DefaultPolicyConfig(),
+		Audit:    *DefaultAuditConfig(),
+		Auth:     *DefaultAuthConfig(),
 	}
 }
 
@@ -33,6 +39,9 @@ type ParsedConfig struct {
 	KeyStoreConfig *ParsedKeyStoreConfig
 	ServerConfig   *ParsedServerConfig
 	MetricsConfig  *ParsedMetricsConfig
+	PolicyConfig   *ParsedPolicyConfig
+	AuditConfig    *ParsedAuditConfig
+	AuthConfig     *ParsedAuthConfig
 }
 
 func (cfg *Config) Parse() (*ParsedConfig, error) {
@@ -51,10 +60,28 @@ func (cfg *Config) Parse() (*ParsedConfig, error) {
 		return nil, err
 	}
 
+	policyConfig, err := cfg.Policy.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	auditConfig, err := cfg.Audit.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	authConfig, err := cfg.Auth.Parse()
+	if err != nil {
+		return nil, err
+	}
+
 	return &ParsedConfig{
 		KeyStoreConfig: keyStoreConfig,
 		ServerConfig:   serverConfig,
 		MetricsConfig:  metricsConfig,
+		PolicyConfig:   policyConfig,
+		AuditConfig:    auditConfig,
+		AuthConfig:     authConfig,
 	}, nil
 }
 
@@ -75,6 +102,14 @@ keyring-backend = "{{ .KeyStore.CosmosKeyStore.KeyringBackend }}"
 # The name of the key to use
 key-name = "{{ .KeyStore.CosmosKeyStore.KeyName }}"
 
+[keystore.pkcs11]
+# The path to the PKCS#11 module of the HSM
+library-path = "{{ .KeyStore.Pkcs11KeyStore.LibraryPath }}"
+# The label of the token holding the covenant key
+token-label = "{{ .KeyStore.Pkcs11KeyStore.TokenLabel }}"
+# The label of the covenant key in the token
+key-label = "{{ .KeyStore.Pkcs11KeyStore.KeyLabel }}"
+
 [server-config]
 # The address to listen on
 host = "{{ .Server.Host }}"
@@ -99,6 +134,30 @@ max-content-length = {{ .Server.MaxContentLength }}
 host = "{{ .Metrics.Host }}"
 # The prometheus server port
 port = {{ .Metrics.Port }}
+
+[policy]
+# The path to the TOML file of the signing policy, no policy is enforced if empty
+policy-file = "{{ .Policy.PolicyFile }}"
+# The Bitcoin network the addresses of the policy are for
+bitcoin-network = "{{ .Policy.BitcoinNetwork }}"
+
+[audit]
+# The path to the hash-chained audit log of the signing decisions, no audit log is kept if empty
+audit-log-file = "{{ .Audit.AuditLogFile }}"
+
+[auth]
+# The PEM encoded certificate and key of the server, plain HTTP is served if empty
+tls-cert-file = "{{ .Auth.TLSCertFile }}"
+tls-key-file = "{{ .Auth.TLSKeyFile }}"
+# The PEM encoded CA certificates the client certificates must chain to, which requires mutual TLS
+client-ca-file = "{{ .Auth.ClientCAFile }}"
+# The hex encoded SHA-256 fingerprints of the accepted client certificates, which requires mutual TLS
+pinned-client-certs = [{{ range $i, $c := .Auth.PinnedClientCerts }}{{ if $i }}, {{ end }}"{{ $c }}"{{ end }}]
+# The path to the hex encoded key the requests must be signed with using HMAC-SHA256, requests are not required to be signed if empty
+hmac-key-file = "{{ .Auth.HMACKeyFile }}"
+# Whether to accept requests from any caller if neither client certificates nor signed requests are required,
+# the signer refuses to start without authentication otherwise
+allow-unauthenticated = {{ .Auth.AllowUnauthenticated }}
 `
 
 var configTemplate *template.Template
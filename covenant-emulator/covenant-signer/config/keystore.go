@@ -6,12 +6,15 @@ type KeyStoreType int
 
 const (
 	CosmosKeyStore KeyStoreType = iota
+	Pkcs11KeyStore
 )
 
 func KeyStoreToString(c KeyStoreType) (string, error) {
 	switch c {
 	case CosmosKeyStore:
 		return "cosmos", nil
+	case Pkcs11KeyStore:
+		return "pkcs11", nil
 	default:
 		return "", fmt.Errorf("unknown key store type")
 	}
@@ -21,6 +24,8 @@ func KeyStoreFromString(s string) (KeyStoreType, error) {
 	switch s {
 	case "cosmos":
 		return CosmosKeyStore, nil
+	case "pkcs11":
+		return Pkcs11KeyStore, nil
 	default:
 		return -1, fmt.Errorf("unknown key store type")
 	}
@@ -33,9 +38,21 @@ type CosmosKeyStoreConfig struct {
 	KeyName        string `mapstructure:"key-name"`
 }
 
+// Pkcs11KeyStoreConfig defines the HSM holding the covenant key, which is
+// accessed through its PKCS#11 module
+type Pkcs11KeyStoreConfig struct {
+	// Path to the PKCS#11 module of the HSM
+	LibraryPath string `mapstructure:"library-path"`
+	// Label of the token holding the covenant key
+	TokenLabel string `mapstructure:"token-label"`
+	// Label of the covenant key in the token
+	KeyLabel string `mapstructure:"key-label"`
+}
+
 type KeyStoreConfig struct {
 	KeyStoreType   string                `mapstructure:"keystore-type"`
 	CosmosKeyStore *CosmosKeyStoreConfig `mapstructure:"cosmos"`
+	Pkcs11KeyStore *Pkcs11KeyStoreConfig `mapstructure:"pkcs11"`
 }
 
 func DefaultKeyStoreConfig() *KeyStoreConfig {
@@ -47,12 +64,14 @@ func DefaultKeyStoreConfig() *KeyStoreConfig {
 	return &KeyStoreConfig{
 		KeyStoreType:   defaultKeyStoreType,
 		CosmosKeyStore: &CosmosKeyStoreConfig{},
+		Pkcs11KeyStore: &Pkcs11KeyStoreConfig{},
 	}
 }
 
 type ParsedKeyStoreConfig struct {
 	KeyStoreType   KeyStoreType
 	CosmosKeyStore *CosmosKeyStoreConfig
+	Pkcs11KeyStore *Pkcs11KeyStoreConfig
 }
 
 func (cfg *KeyStoreConfig) Parse() (*ParsedKeyStoreConfig, error) {
@@ -61,8 +80,16 @@ func (cfg *KeyStoreConfig) Parse() (*ParsedKeyStoreConfig, error) {
 		return nil, err
 	}
 
+	if keyStoreType == Pkcs11KeyStore {
+		if cfg.Pkcs11KeyStore == nil || cfg.Pkcs11KeyStore.LibraryPath == "" ||
+			cfg.Pkcs11KeyStore.TokenLabel == "" || cfg.Pkcs11KeyStore.KeyLabel == "" {
+			return nil, fmt.Errorf("pkcs11 key store requires the library path, the token label and the key label")
+		}
+	}
+
 	return &ParsedKeyStoreConfig{
 		KeyStoreType:   keyStoreType,
 		CosmosKeyStore: cfg.CosmosKeyStore,
+		Pkcs11KeyStore: cfg.Pkcs11KeyStore,
 	}, nil
 }
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// PolicyConfig defines the signing policy enforced by the signer
+type PolicyConfig struct {
+	// Path to the TOML policy file, no policy is enforced if empty
+	PolicyFile string `mapstructure:"policy-file"`
+	// Bitcoin network the addresses of the policy are for
+	BitcoinNetwork string `mapstructure:"bitcoin-network"`
+}
+
+type ParsedPolicyConfig struct {
+	PolicyFile   string
+	BTCNetParams *chaincfg.Params
+}
+
+func (cfg *PolicyConfig) Parse() (*ParsedPolicyConfig, error) {
+	var btcNetParams *chaincfg.Params
+	switch cfg.BitcoinNetwork {
+	case "mainnet":
+		btcNetParams = &chaincfg.MainNetParams
+	case "testnet":
+		btcNetParams = &chaincfg.TestNet3Params
+	case "regtest":
+		btcNetParams = &chaincfg.RegressionNetParams
+	case "simnet":
+		btcNetParams = &chaincfg.SimNetParams
+	case "signet":
+		btcNetParams = &chaincfg.SigNetParams
+	default:
+		return nil, fmt.Errorf("unsupported Bitcoin network: %s", cfg.BitcoinNetwork)
+	}
+
+	return &ParsedPolicyConfig{
+		PolicyFile:   cfg.PolicyFile,
+		BTCNetParams: btcNetParams,
+	}, nil
+}
+
+func DefaultPolicyConfig() *PolicyConfig {
+	return &PolicyConfig{
+		PolicyFile:     "",
+		BitcoinNetwork: "simnet",
+	}
+}
@@ -99,6 +99,8 @@ func StartManager(
 	)
 
 	met := metrics.NewCovenantSignerMetrics()
+	// the test signer serves plain HTTP to the local caller
+	appConfig.Auth.AllowUnauthenticated = true
 	parsedConfig, err := appConfig.Parse()
 	require.NoError(t, err)
 
@@ -0,0 +1,327 @@
+package pkcs11
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	p11 "github.com/miekg/pkcs11"
+
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerapp"
+)
+
+const (
+	// secretLen is the length in bytes of the covenant secret kept in the HSM
+	secretLen = 32
+)
+
+var (
+	// privKeyMsg is the message whose HMAC under the covenant secret is the
+	// covenant private key
+	privKeyMsg = []byte("covenant-private-key")
+
+	ErrKeyNotExportable = errors.New("the covenant key cannot be exported from the HSM")
+	ErrLocked           = errors.New("the HSM token is locked. Please call Unlock() first")
+	ErrKeyNotFound      = errors.New("the covenant key is not found in the HSM")
+)
+
+var _ signerapp.ScopedPrivKeyRetriever = &Pkcs11Retriever{}
+
+// Pkcs11Retriever keeps the covenant secret in an HSM accessed through its
+// PKCS#11 module. The secret is a 32-byte generic secret labeled with the key
+// label, which is generated in the HSM and cannot be extracted from it. The HSM
+// computes the HMAC of a constant message under the secret, which is the
+// covenant private key.
+//
+// PKCS#11 has no mechanism for BIP-340 Schnorr or adaptor signatures, so the
+// signatures are computed in the process with the private key derived by the
+// HSM for each signing request, which is zeroed right after. The private key is
+// never kept between the requests. Signing inside the token is not feasible
+// through PKCS#11, so the secret is only protected at rest. As the private key
+// is derived from a secret generated in the HSM, an existing covenant key
+// cannot be migrated into it.
+//
+// Unlocking the retriever logs into the token with the passphrase as the user
+// PIN, and locking it logs out, after which the secret cannot be used.
+type Pkcs11Retriever struct {
+	// mu guards the session, which is not safe for concurrent use
+	mu       sync.Mutex
+	ctx      *p11.Ctx
+	session  p11.SessionHandle
+	keyLabel string
+	unlocked bool
+	pubKey   *btcec.PublicKey
+}
+
+// NewPkcs11Retriever loads the PKCS#11 module and opens a session with the
+// token of the given config. The session is locked until Unlock is called.
+func NewPkcs11Retriever(cfg *config.Pkcs11KeyStoreConfig) (*Pkcs11Retriever, error) {
+	ctx := p11.New(cfg.LibraryPath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load the PKCS#11 module %s", cfg.LibraryPath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+
+		return nil, fmt.Errorf("failed to initialize the PKCS#11 module: %w", err)
+	}
+
+	session, err := openSession(ctx, cfg.TokenLabel)
+	if err != nil {
+		_ = ctx.Finalize()
+		ctx.Destroy()
+
+		return nil, err
+	}
+
+	return &Pkcs11Retriever{
+		ctx:      ctx,
+		session:  session,
+		keyLabel: cfg.KeyLabel,
+	}, nil
+}
+
+func openSession(ctx *p11.Ctx, tokenLabel string) (p11.SessionHandle, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the PKCS#11 slots: %w", err)
+	}
+
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get the info of the token in slot %d: %w", slot, err)
+		}
+		// the label is padded with spaces
+		if strings.TrimSpace(info.Label) != tokenLabel {
+			continue
+		}
+
+		session, err := ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+		if err != nil {
+			return 0, fmt.Errorf("failed to open a session with the token %s: %w", tokenLabel, err)
+		}
+
+		return session, nil
+	}
+
+	return 0, fmt.Errorf("the token %s is not found", tokenLabel)
+}
+
+// PrivKey always fails as the covenant secret cannot be exported from the HSM
+func (r *Pkcs11Retriever) PrivKey(_ context.Context) (*btcec.PrivateKey, error) {
+	return nil, ErrKeyNotExportable
+}
+
+// Unlock logs into the token with the given passphrase as the user PIN
+func (r *Pkcs11Retriever) Unlock(_ context.Context, passphrase string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.unlocked {
+		// already unlocked
+		return nil
+	}
+
+	err := r.ctx.Login(r.session, p11.CKU_USER, passphrase)
+	if err != nil && !errors.Is(err, p11.Error(p11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return fmt.Errorf("failed to log into the token: %w", err)
+	}
+	r.unlocked = true
+
+	return nil
+}
+
+// Lock logs out of the token
+func (r *Pkcs11Retriever) Lock(_ context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.unlocked {
+		// already locked
+		return nil
+	}
+
+	err := r.ctx.Logout(r.session)
+	if err != nil && !errors.Is(err, p11.Error(p11.CKR_USER_NOT_LOGGED_IN)) {
+		return fmt.Errorf("failed to log out of the token: %w", err)
+	}
+	r.unlocked = false
+
+	return nil
+}
+
+// PubKey returns the covenant public key, which requires the token to be
+// unlocked the first time
+func (r *Pkcs11Retriever) PubKey(ctx context.Context) (*btcec.PublicKey, error) {
+	r.mu.Lock()
+	pk := r.pubKey
+	r.mu.Unlock()
+	if pk != nil {
+		return pk, nil
+	}
+
+	err := r.WithPrivKey(ctx, func(privKey *btcec.PrivateKey) error {
+		pk = privKey.PubKey()
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.pubKey = pk
+	r.mu.Unlock()
+
+	return pk, nil
+}
+
+// WithPrivKey derives the covenant private key in the HSM, calls fn with it,
+// and zeroes it
+func (r *Pkcs11Retriever) WithPrivKey(_ context.Context, fn func(privKey *btcec.PrivateKey) error) error {
+	digest, err := r.hmac(privKeyMsg)
+	if err != nil {
+		return err
+	}
+
+	var x btcec.ModNScalar
+	overflow := x.SetByteSlice(digest)
+	clear(digest)
+	if overflow || x.IsZero() {
+		// negligible probability
+		return fmt.Errorf("the covenant secret %s derives an invalid private key", r.keyLabel)
+	}
+	privKey := btcec.PrivKeyFromScalar(&x)
+	x.Zero()
+	defer privKey.Zero()
+
+	return fn(privKey)
+}
+
+// GenerateKey generates the covenant secret in the HSM and returns the covenant
+// public key. The token must be unlocked.
+func (r *Pkcs11Retriever) GenerateKey(ctx context.Context) (*btcec.PublicKey, error) {
+	r.mu.Lock()
+	if !r.unlocked {
+		r.mu.Unlock()
+
+		return nil, ErrLocked
+	}
+	_, err := r.findKey()
+	switch {
+	case err == nil:
+		r.mu.Unlock()
+
+		return nil, fmt.Errorf("the key %s already exists in the HSM", r.keyLabel)
+	case !errors.Is(err, ErrKeyNotFound):
+		r.mu.Unlock()
+
+		return nil, err
+	}
+
+	mech := []*p11.Mechanism{p11.NewMechanism(p11.CKM_GENERIC_SECRET_KEY_GEN, nil)}
+	template := append(secretTemplate(r.keyLabel), p11.NewAttribute(p11.CKA_VALUE_LEN, secretLen))
+	_, err = r.ctx.GenerateKey(r.session, mech, template)
+	r.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the key %s in the HSM: %w", r.keyLabel, err)
+	}
+
+	return r.PubKey(ctx)
+}
+
+// Close logs out of the token and unloads the PKCS#11 module
+func (r *Pkcs11Retriever) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs []error
+	if r.unlocked {
+		if err := r.ctx.Logout(r.session); err != nil {
+			errs = append(errs, fmt.Errorf("failed to log out of the token: %w", err))
+		}
+		r.unlocked = false
+	}
+	if err := r.ctx.CloseSession(r.session); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close the session: %w", err))
+	}
+	if err := r.ctx.Finalize(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to finalize the PKCS#11 module: %w", err))
+	}
+	r.ctx.Destroy()
+
+	return errors.Join(errs...)
+}
+
+// hmac computes HMAC-SHA256 over msg in the HSM under the covenant secret
+func (r *Pkcs11Retriever) hmac(msg []byte) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.unlocked {
+		return nil, ErrLocked
+	}
+
+	key, err := r.findKey()
+	if err != nil {
+		return nil, err
+	}
+
+	mech := []*p11.Mechanism{p11.NewMechanism(p11.CKM_SHA256_HMAC, nil)}
+	if err := r.ctx.SignInit(r.session, mech, key); err != nil {
+		return nil, fmt.Errorf("failed to initialize HMAC with the key %s: %w", r.keyLabel, err)
+	}
+	digest, err := r.ctx.Sign(r.session, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute HMAC with the key %s: %w", r.keyLabel, err)
+	}
+
+	return digest, nil
+}
+
+// findKey returns the handle of the covenant secret
+// The caller should hold mu
+func (r *Pkcs11Retriever) findKey() (p11.ObjectHandle, error) {
+	template := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+		p11.NewAttribute(p11.CKA_LABEL, r.keyLabel),
+	}
+	if err := r.ctx.FindObjectsInit(r.session, template); err != nil {
+		return 0, fmt.Errorf("failed to search for the key %s: %w", r.keyLabel, err)
+	}
+	objs, _, err := r.ctx.FindObjects(r.session, 2)
+	if finalErr := r.ctx.FindObjectsFinal(r.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to search for the key %s: %w", r.keyLabel, err)
+	}
+
+	switch len(objs) {
+	case 0:
+		return 0, fmt.Errorf("%w: %s", ErrKeyNotFound, r.keyLabel)
+	case 1:
+		return objs[0], nil
+	default:
+		return 0, fmt.Errorf("multiple keys are labeled %s in the HSM", r.keyLabel)
+	}
+}
+
+// secretTemplate returns the attributes of the covenant secret, which is kept
+// on the token, can only be used for HMAC, and cannot be extracted
+func secretTemplate(label string) []*p11.Attribute {
+	return []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_LABEL, label),
+		p11.NewAttribute(p11.CKA_TOKEN, true),
+		p11.NewAttribute(p11.CKA_PRIVATE, true),
+		p11.NewAttribute(p11.CKA_SENSITIVE, true),
+		p11.NewAttribute(p11.CKA_EXTRACTABLE, false),
+		p11.NewAttribute(p11.CKA_SIGN, true),
+		p11.NewAttribute(p11.CKA_VERIFY, true),
+	}
+}
@@ -0,0 +1,92 @@
+package pkcs11_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/keystore/pkcs11"
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerapp"
+)
+
+// newTestRetriever opens the token set in the environment, which is
+// initialized by scripts/test_softhsm.sh
+func newTestRetriever(t *testing.T) (*pkcs11.Pkcs11Retriever, string) {
+	lib := os.Getenv("COVENANT_SIGNER_TEST_PKCS11_LIB")
+	token := os.Getenv("COVENANT_SIGNER_TEST_PKCS11_TOKEN")
+	pin := os.Getenv("COVENANT_SIGNER_TEST_PKCS11_PIN")
+	if lib == "" || token == "" {
+		t.Skip("COVENANT_SIGNER_TEST_PKCS11_LIB and COVENANT_SIGNER_TEST_PKCS11_TOKEN are not set; run make test-softhsm")
+	}
+
+	r, err := pkcs11.NewPkcs11Retriever(&config.Pkcs11KeyStoreConfig{
+		LibraryPath: lib,
+		TokenLabel:  token,
+		KeyLabel:    fmt.Sprintf("covenant-key-%d", time.Now().UnixNano()),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, r.Close())
+	})
+
+	return r, pin
+}
+
+func TestPkcs11Retriever(t *testing.T) {
+	ctx := context.Background()
+	r, pin := newTestRetriever(t)
+
+	// the key cannot be generated or used while the token is locked
+	_, err := r.GenerateKey(ctx)
+	require.ErrorIs(t, err, pkcs11.ErrLocked)
+	require.Error(t, r.Unlock(ctx, pin+"wrong"))
+
+	require.NoError(t, r.Unlock(ctx, pin))
+	_, err = r.PubKey(ctx)
+	require.ErrorIs(t, err, pkcs11.ErrKeyNotFound)
+
+	pk, err := r.GenerateKey(ctx)
+	require.NoError(t, err)
+	_, err = r.GenerateKey(ctx)
+	require.Error(t, err)
+
+	// the private key is never exported
+	_, err = r.PrivKey(ctx)
+	require.ErrorIs(t, err, pkcs11.ErrKeyNotExportable)
+
+	// the signer app signs with the key of the token
+	app := signerapp.NewSignerApp(r)
+	appPk, err := app.PubKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pk.SerializeCompressed(), appPk.SerializeCompressed())
+
+	msg := sha256.Sum256([]byte("covenant"))
+	var sig *schnorr.Signature
+	err = r.WithPrivKey(ctx, func(privKey *btcec.PrivateKey) error {
+		sig, err = schnorr.Sign(privKey, msg[:])
+		return err
+	})
+	require.NoError(t, err)
+	require.True(t, sig.Verify(msg[:], pk))
+
+	// locking logs out of the token, after which the key cannot be used
+	require.NoError(t, app.Lock(ctx))
+	err = r.WithPrivKey(ctx, func(*btcec.PrivateKey) error { return nil })
+	require.ErrorIs(t, err, pkcs11.ErrLocked)
+
+	// the same key is derived after unlocking again
+	require.NoError(t, app.Unlock(ctx, pin))
+	err = r.WithPrivKey(ctx, func(privKey *btcec.PrivateKey) error {
+		require.Equal(t, pk.SerializeCompressed(), privKey.PubKey().SerializeCompressed())
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -10,6 +10,7 @@ type CovenantSignerMetrics struct {
 	ReceivedSigningRequests    prometheus.Counter
 	SuccessfulSigningRequests  prometheus.Counter
 	FailedSigningRequests      prometheus.Counter
+	RejectedSigningRequests    *prometheus.CounterVec
 	SignerUnlockStatus         prometheus.Gauge
 	SignerFailedUnlockRequests prometheus.Counter
 }
@@ -32,6 +33,10 @@ func NewCovenantSignerMetrics() *CovenantSignerMetrics {
 			Name: "signer_failed_signing_requests",
 			Help: "The total number of times signer responded with an internal error",
 		}),
+		RejectedSigningRequests: registerer.NewCounterVec(prometheus.CounterOpts{
+			Name: "signer_rejected_signing_requests",
+			Help: "The total number of signing requests rejected by the signing policy",
+		}, []string{"reason"}),
 		SignerUnlockStatus: registerer.NewGauge(prometheus.GaugeOpts{
 			Name: "signer_unlock_status",
 			Help: "The status indicating if the signer is unlocked or locked. 1 for unlocked, 0 for locked",
@@ -57,6 +62,10 @@ func (m *CovenantSignerMetrics) IncFailedSigningRequests() {
 	m.FailedSigningRequests.Inc()
 }
 
+func (m *CovenantSignerMetrics) IncRejectedSigningRequests(reason string) {
+	m.RejectedSigningRequests.WithLabelValues(reason).Inc()
+}
+
 func (m *CovenantSignerMetrics) SetSignerUnlocked() {
 	m.SignerUnlockStatus.Set(1)
 }
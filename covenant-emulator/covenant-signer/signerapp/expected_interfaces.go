@@ -13,3 +13,12 @@ type PrivKeyRetriever interface {
 	Unlock(ctx context.Context, passphrase string) error
 	Lock(ctx context.Context) error
 }
+
+// ScopedPrivKeyRetriever is implemented by the key stores that do not keep the
+// private key in memory, such as HSMs. The signer app only signs with the key
+// within WithPrivKey, after which the key is zeroed, so PrivKey may fail.
+type ScopedPrivKeyRetriever interface {
+	PrivKeyRetriever
+	PubKey(ctx context.Context) (*btcec.PublicKey, error)
+	WithPrivKey(ctx context.Context, fn func(privKey *btcec.PrivateKey) error) error
+}
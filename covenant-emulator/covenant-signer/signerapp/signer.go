@@ -9,6 +9,9 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonlabs-io/covenant-emulator/audit"
+	"github.com/babylonlabs-io/covenant-emulator/policy"
 )
 
 type ParsedSigningRequest struct {
@@ -31,47 +34,184 @@ type ParsedSigningResponse struct {
 
 type SignerApp struct {
 	pkr PrivKeyRetriever
+	// policy is the signing policy enforced on every request, or nil
+	policy *policy.Engine
+	// auditLog records every signing decision, or is nil
+	auditLog *audit.Log
+}
+
+// Option configures the optional safeguards of a signer app
+type Option func(*SignerApp)
+
+// WithPolicy makes the signer app reject the signing requests violating the
+// given policy with a *policy.RejectionError
+func WithPolicy(policyEngine *policy.Engine) Option {
+	return func(s *SignerApp) {
+		s.policy = policyEngine
+	}
+}
+
+// WithAuditLog makes the signer app record every signing decision in the
+// given audit log. No signature is returned unless it is recorded.
+func WithAuditLog(auditLog *audit.Log) Option {
+	return func(s *SignerApp) {
+		s.auditLog = auditLog
+	}
 }
 
 func NewSignerApp(
 	pkr PrivKeyRetriever,
+	opts ...Option,
 ) *SignerApp {
-	return &SignerApp{
+	s := &SignerApp{
 		pkr: pkr,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
+
 func (s *SignerApp) SignTransactions(
 	ctx context.Context,
 	req *ParsedSigningRequest,
 ) (*ParsedSigningResponse, error) {
-	privKey, err := s.pkr.PrivKey(ctx)
+	auditRecord := audit.NewRecord(req.StakingTx, req.SlashingTx, req.UnbondingTx, req.SlashUnbondingTx)
+	reservation, err := s.enforcePolicy(req)
+	if err != nil {
+		auditRecord.PolicyVerdict = audit.PolicyRejected
+		auditRecord.PolicyReason = err.Error()
+		if auditErr := s.appendAuditRecord(auditRecord); auditErr != nil {
+			return nil, auditErr
+		}
 
+		return nil, err
+	}
+	if s.policy != nil {
+		auditRecord.PolicyVerdict = audit.PolicyAccepted
+	}
+
+	// the rate cap slot is only consumed by the signatures that are handed out
+	defer reservation.Release()
+
+	resp, err := s.signTransactions(ctx, req)
 	if err != nil {
+		auditRecord.Error = err.Error()
+		if auditErr := s.appendAuditRecord(auditRecord); auditErr != nil {
+			return nil, auditErr
+		}
+
 		return nil, err
 	}
 
-	slashSigs := make([][]byte, 0, len(req.FpEncKeys))
-	slashUnbondingSigs := make([][]byte, 0, len(req.FpEncKeys))
-	for _, fpEncKey := range req.FpEncKeys {
-		slashSig, slashUnbondingSig, err := slashUnbondSig(privKey, req, fpEncKey)
+	auditRecord.SetSignatures(resp.SlashAdaptorSigs, resp.UnbondingSig.Serialize(), resp.SlashUnbondingAdaptorSigs)
+	if err := s.appendAuditRecord(auditRecord); err != nil {
+		return nil, err
+	}
+	reservation.Commit()
+
+	return resp, nil
+}
+
+func (s *SignerApp) signTransactions(
+	ctx context.Context,
+	req *ParsedSigningRequest,
+) (*ParsedSigningResponse, error) {
+	var resp *ParsedSigningResponse
+	err := s.withPrivKey(ctx, func(privKey *btcec.PrivateKey) error {
+		slashSigs := make([][]byte, 0, len(req.FpEncKeys))
+		slashUnbondingSigs := make([][]byte, 0, len(req.FpEncKeys))
+		for _, fpEncKey := range req.FpEncKeys {
+			slashSig, slashUnbondingSig, err := slashUnbondSig(privKey, req, fpEncKey)
+			if err != nil {
+				return err
+			}
+
+			slashSigs = append(slashSigs, slashSig.MustMarshal())
+			slashUnbondingSigs = append(slashUnbondingSigs, slashUnbondingSig.MustMarshal())
+		}
+
+		unbondingSig, err := unbondSig(privKey, req)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		slashSigs = append(slashSigs, slashSig.MustMarshal())
-		slashUnbondingSigs = append(slashUnbondingSigs, slashUnbondingSig.MustMarshal())
-	}
+		resp = &ParsedSigningResponse{
+			SlashAdaptorSigs:          slashSigs,
+			UnbondingSig:              unbondingSig,
+			SlashUnbondingAdaptorSigs: slashUnbondingSigs,
+		}
 
-	unbondingSig, err := unbondSig(privKey, req)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &ParsedSigningResponse{
-		SlashAdaptorSigs:          slashSigs,
-		UnbondingSig:              unbondingSig,
-		SlashUnbondingAdaptorSigs: slashUnbondingSigs,
-	}, nil
+	return resp, nil
+}
+
+// withPrivKey calls fn with the private key, which is only available within fn
+// if the key store is a ScopedPrivKeyRetriever
+func (s *SignerApp) withPrivKey(ctx context.Context, fn func(privKey *btcec.PrivateKey) error) error {
+	if scoped, ok := s.pkr.(ScopedPrivKeyRetriever); ok {
+		return scoped.WithPrivKey(ctx, fn)
+	}
+
+	privKey, err := s.pkr.PrivKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	return fn(privKey)
+}
+
+// appendAuditRecord appends the record of a signing decision to the audit log,
+// if any
+func (s *SignerApp) appendAuditRecord(r *audit.Record) error {
+	if s.auditLog == nil {
+		return nil
+	}
+
+	if err := s.auditLog.Append(r); err != nil {
+		return fmt.Errorf("failed to record the signing decision in the audit log: %w", err)
+	}
+
+	return nil
+}
+
+// enforcePolicy evaluates the signing request against the signing policy, if
+// any, and returns the reservation of the rate cap slot of the request
+func (s *SignerApp) enforcePolicy(req *ParsedSigningRequest) (*policy.Reservation, error) {
+	if s.policy == nil {
+		return nil, nil
+	}
+
+	stakerPk, err := policy.StakerPkFromSlashingScript(req.SlashingScript)
+	if err != nil {
+		return nil, &policy.RejectionError{Reason: policy.ReasonInvalidRequest, Msg: err.Error()}
+	}
+
+	fpPks := make([]*btcec.PublicKey, 0, len(req.FpEncKeys))
+	for _, fpEncKey := range req.FpEncKeys {
+		fpPks = append(fpPks, fpEncKey.ToBTCPK())
+	}
+
+	policyReq, err := policy.NewRequest(
+		req.StakingTx,
+		req.StakingOutputIdx,
+		req.SlashingTx,
+		req.UnbondingTx,
+		req.SlashUnbondingTx,
+		stakerPk,
+		fpPks,
+	)
+	if err != nil {
+		return nil, &policy.RejectionError{Reason: policy.ReasonInvalidRequest, Msg: err.Error()}
+	}
+
+	return s.policy.Evaluate(policyReq)
 }
 
 func (s *SignerApp) Unlock(ctx context.Context, passphrase string) error {
@@ -83,6 +223,10 @@ func (s *SignerApp) Lock(ctx context.Context) error {
 }
 
 func (s *SignerApp) PubKey(ctx context.Context) (*btcec.PublicKey, error) {
+	if scoped, ok := s.pkr.(ScopedPrivKeyRetriever); ok {
+		return scoped.PubKey(ctx)
+	}
+
 	privKey, err := s.pkr.PrivKey(ctx)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,195 @@
+package auth_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice/auth"
+)
+
+func TestHMACSignature(t *testing.T) {
+	t.Parallel()
+
+	key := bytes.Repeat([]byte{0x01}, auth.MinHMACKeyLen)
+	body := []byte(`{"passphrase":"secret"}`)
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "http://127.0.0.1:9791/v1/unlock", bytes.NewReader(body))
+		require.NoError(t, err)
+		return req
+	}
+
+	// the verifications of the rejected requests use a fresh cache, so that
+	// they do not fail for the nonce only
+	verify := func(req *http.Request, body, key []byte, now time.Time) error {
+		return auth.VerifyRequest(req, body, key, auth.NewNonceCache(), now)
+	}
+
+	req := newRequest()
+	require.ErrorIs(t, verify(req, body, key, time.Now()), auth.ErrMissingSignature)
+
+	require.NoError(t, auth.SignRequest(req, body, key))
+	require.NoError(t, verify(req, body, key, time.Now()))
+
+	// the body, path, nonce and key are covered by the signature
+	require.ErrorIs(t, verify(req, []byte(`{}`), key, time.Now()), auth.ErrInvalidSignature)
+	wrongKey := bytes.Repeat([]byte{0x02}, auth.MinHMACKeyLen)
+	require.ErrorIs(t, verify(req, body, wrongKey, time.Now()), auth.ErrInvalidSignature)
+	otherPath := newRequest()
+	otherPath.URL.Path = "/v1/lock"
+	otherPath.Header = req.Header
+	require.ErrorIs(t, verify(otherPath, body, key, time.Now()), auth.ErrInvalidSignature)
+	otherNonce := newRequest()
+	otherNonce.Header = req.Header.Clone()
+	otherNonce.Header.Set(auth.HeaderNonce, hex.EncodeToString(make([]byte, 16)))
+	require.ErrorIs(t, verify(otherNonce, body, key, time.Now()), auth.ErrInvalidSignature)
+
+	// signed requests expire
+	later := time.Now().Add(auth.MaxClockSkew + 2*time.Second)
+	require.ErrorIs(t, verify(req, body, key, later), auth.ErrExpiredSignature)
+}
+
+func TestHMACReplay(t *testing.T) {
+	t.Parallel()
+
+	key := bytes.Repeat([]byte{0x01}, auth.MinHMACKeyLen)
+	body := []byte(`{"passphrase":"secret"}`)
+	nonces := auth.NewNonceCache()
+	newSignedRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "http://127.0.0.1:9791/v1/unlock", bytes.NewReader(body))
+		require.NoError(t, err)
+		require.NoError(t, auth.SignRequest(req, body, key))
+		return req
+	}
+
+	req := newSignedRequest()
+	now := time.Now()
+	require.NoError(t, auth.VerifyRequest(req, body, key, nonces, now))
+
+	// a captured request is rejected within the time window and after it
+	require.ErrorIs(t, auth.VerifyRequest(req, body, key, nonces, now), auth.ErrReplayedRequest)
+	require.ErrorIs(t, auth.VerifyRequest(req, body, key, nonces, now.Add(auth.MaxClockSkew/2)),
+		auth.ErrReplayedRequest)
+	require.ErrorIs(t, auth.VerifyRequest(req, body, key, nonces, now.Add(auth.MaxClockSkew+2*time.Second)),
+		auth.ErrExpiredSignature)
+
+	// the same request signed again is accepted
+	require.NoError(t, auth.VerifyRequest(newSignedRequest(), body, key, nonces, now))
+}
+
+func TestLoadHMACKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "hmac.key")
+	key := bytes.Repeat([]byte{0xab}, auth.MinHMACKeyLen)
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(key)+"\n"), 0o600))
+	loaded, err := auth.LoadHMACKey(path)
+	require.NoError(t, err)
+	require.Equal(t, key, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(key[:16])), 0o600))
+	_, err = auth.LoadHMACKey(path)
+	require.Error(t, err)
+}
+
+// writeSelfSignedCert writes a self-signed certificate for 127.0.0.1 and its
+// key to the given directory and returns their paths and the fingerprint of
+// the certificate
+func writeSelfSignedCert(t *testing.T, dir, name string) (string, string, string) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &sk.PublicKey, sk)
+	require.NoError(t, err)
+	skDer, err := x509.MarshalECPrivateKey(sk)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: skDer}), 0o600))
+
+	return certFile, keyFile, auth.Fingerprint(der)
+}
+
+func TestMutualTLSWithPinnedCerts(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	serverCert, serverKey, serverFp := writeSelfSignedCert(t, dir, "server")
+	clientCert, clientKey, clientFp := writeSelfSignedCert(t, dir, "client")
+	otherCert, otherKey, otherFp := writeSelfSignedCert(t, dir, "other")
+
+	serverTLS, err := auth.ServerTLSConfig(serverCert, serverKey, "", []string{clientFp})
+	require.NoError(t, err)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	srv.TLS = serverTLS
+	srv.StartTLS()
+	defer srv.Close()
+
+	get := func(clientTLS *tls.Config) error {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}, Timeout: 5 * time.Second}
+		res, err := client.Get(srv.URL)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, "ok", string(body))
+
+		return nil
+	}
+
+	// the pinned client reaches the pinned server
+	clientTLS, err := auth.ClientTLSConfig(clientCert, clientKey, "", []string{serverFp})
+	require.NoError(t, err)
+	require.NoError(t, get(clientTLS))
+
+	// a client with an unpinned certificate is rejected
+	clientTLS, err = auth.ClientTLSConfig(otherCert, otherKey, "", []string{serverFp})
+	require.NoError(t, err)
+	require.Error(t, get(clientTLS))
+
+	// a client without certificate is rejected
+	clientTLS, err = auth.ClientTLSConfig("", "", "", []string{serverFp})
+	require.NoError(t, err)
+	require.Error(t, get(clientTLS))
+
+	// the client rejects a server whose certificate is not pinned
+	clientTLS, err = auth.ClientTLSConfig(clientCert, clientKey, "", []string{otherFp})
+	require.NoError(t, err)
+	require.ErrorIs(t, get(clientTLS), auth.ErrCertNotPinned)
+
+	_, err = auth.ParseFingerprints([]string{"not-a-fingerprint"})
+	require.Error(t, err)
+}
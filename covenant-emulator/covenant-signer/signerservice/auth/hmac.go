@@ -0,0 +1,172 @@
+// Package auth implements the authentication of the requests to the covenant
+// signer, which are sent over TLS with pinned certificates and can be signed
+// with a key shared by the signer and its clients.
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// HeaderTimestamp is the header of the unix time in seconds a request is
+	// signed at
+	HeaderTimestamp = "X-Covenant-Timestamp"
+	// HeaderNonce is the header of the hex encoded random nonce of a request,
+	// which makes every signed request unique
+	HeaderNonce = "X-Covenant-Nonce"
+	// HeaderSignature is the header of the hex encoded HMAC-SHA256 signature
+	// of a request
+	HeaderSignature = "X-Covenant-Signature"
+
+	// MaxClockSkew is the maximum difference between the time a request is
+	// signed at and the time it is verified at, which bounds the time the
+	// nonce of a request has to be remembered for
+	MaxClockSkew = time.Minute
+
+	// nonceLen is the length in bytes of the nonce of a request
+	nonceLen = 16
+
+	// MinHMACKeyLen is the minimum length in bytes of the HMAC key
+	MinHMACKeyLen = 32
+)
+
+var (
+	ErrMissingSignature = errors.New("the request is not signed")
+	ErrInvalidSignature = errors.New("the signature of the request is invalid")
+	ErrExpiredSignature = errors.New("the request is signed at a time too far from now")
+	ErrReplayedRequest  = errors.New("the nonce of the request has already been used")
+)
+
+// LoadHMACKey reads the hex encoded HMAC key from the given file
+func LoadHMACKey(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the HMAC key file %s: %w", path, err)
+	}
+
+	key, err := hex.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil {
+		return nil, fmt.Errorf("the HMAC key in %s is not hex encoded: %w", path, err)
+	}
+	if len(key) < MinHMACKeyLen {
+		return nil, fmt.Errorf("the HMAC key in %s is %d bytes long, expected at least %d bytes",
+			path, len(key), MinHMACKeyLen)
+	}
+
+	return key, nil
+}
+
+// SignRequest signs the method, URI and body of the given request with the
+// key at the current time, under a new random nonce
+func SignRequest(r *http.Request, body []byte, key []byte) error {
+	nonceBytes := make([]byte, nonceLen)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return fmt.Errorf("failed to generate the nonce of the request: %w", err)
+	}
+	nonce := hex.EncodeToString(nonceBytes)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	r.Header.Set(HeaderTimestamp, timestamp)
+	r.Header.Set(HeaderNonce, nonce)
+	r.Header.Set(HeaderSignature, hex.EncodeToString(computeMAC(key, timestamp, nonce, r.Method, r.URL.RequestURI(), body)))
+
+	return nil
+}
+
+// VerifyRequest checks that the given request is signed with the key at a
+// time within MaxClockSkew of now, and that its nonce is not in the given
+// cache. The nonce is then added to the cache, so a captured request cannot
+// be replayed.
+func VerifyRequest(r *http.Request, body []byte, key []byte, nonces *NonceCache, now time.Time) error {
+	timestamp := r.Header.Get(HeaderTimestamp)
+	nonce := r.Header.Get(HeaderNonce)
+	sigHex := r.Header.Get(HeaderSignature)
+	if timestamp == "" || nonce == "" || sigHex == "" {
+		return ErrMissingSignature
+	}
+
+	sig, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	// the timestamp and the nonce are covered by the signature, so they are
+	// checked only after the signature
+	if !hmac.Equal(sig, computeMAC(key, timestamp, nonce, r.Method, r.URL.RequestURI(), body)) {
+		return ErrInvalidSignature
+	}
+	if len(nonce) != 2*nonceLen {
+		return ErrInvalidSignature
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	skew := now.Sub(time.Unix(signedAt, 0))
+	if skew > MaxClockSkew || skew < -MaxClockSkew {
+		return ErrExpiredSignature
+	}
+
+	if !nonces.add(nonce, time.Unix(signedAt, 0), now) {
+		return ErrReplayedRequest
+	}
+
+	return nil
+}
+
+// NonceCache keeps the nonces of the verified requests until their timestamps
+// are too old to be accepted, after which VerifyRequest rejects a replay on
+// its own. It is safe for concurrent use.
+type NonceCache struct {
+	mu sync.Mutex
+	// expiries are the times after which the nonces can be forgotten
+	expiries map[string]time.Time
+}
+
+// NewNonceCache creates an empty nonce cache
+func NewNonceCache() *NonceCache {
+	return &NonceCache{
+		expiries: make(map[string]time.Time),
+	}
+}
+
+// add records the nonce of a request signed at the given time, and returns
+// false if it is already recorded
+func (c *NonceCache) add(nonce string, signedAt, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for n, expiry := range c.expiries {
+		if now.After(expiry) {
+			delete(c.expiries, n)
+		}
+	}
+
+	if _, ok := c.expiries[nonce]; ok {
+		return false
+	}
+	c.expiries[nonce] = signedAt.Add(MaxClockSkew)
+
+	return true
+}
+
+// computeMAC computes HMAC-SHA256 over the timestamp, nonce, method, URI and
+// body of a request, separated by new lines
+func computeMAC(key []byte, timestamp, nonce, method, uri string, body []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp + "\n" + nonce + "\n" + method + "\n" + uri + "\n"))
+	mac.Write(body)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var ErrCertNotPinned = errors.New("the peer certificate is not pinned")
+
+// Fingerprint returns the hex encoded SHA-256 hash of the DER encoded
+// certificate, by which certificates are pinned
+func Fingerprint(der []byte) string {
+	hash := sha256.Sum256(der)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// ParseFingerprints decodes the given hex encoded SHA-256 certificate
+// fingerprints, which may be separated by colons
+func ParseFingerprints(fingerprints []string) ([][]byte, error) {
+	pins := make([][]byte, 0, len(fingerprints))
+	for _, fp := range fingerprints {
+		pin, err := hex.DecodeString(strings.ReplaceAll(fp, ":", ""))
+		if err != nil || len(pin) != sha256.Size {
+			return nil, fmt.Errorf("invalid certificate fingerprint %s, expected a hex encoded SHA-256 hash", fp)
+		}
+		pins = append(pins, pin)
+	}
+
+	return pins, nil
+}
+
+// verifyPinnedCert returns a tls.Config.VerifyPeerCertificate callback
+// accepting only the leaf certificates of the given fingerprints
+func verifyPinnedCert(pins [][]byte) func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return ErrCertNotPinned
+		}
+		hash := sha256.Sum256(rawCerts[0])
+		for _, pin := range pins {
+			if bytes.Equal(hash[:], pin) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w: %s", ErrCertNotPinned, hex.EncodeToString(hash[:]))
+	}
+}
+
+// LoadCertPool reads the PEM encoded CA certificates from the given file
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CA file %s: %w", path, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM encoded certificate is found in the CA file %s", path)
+	}
+
+	return pool, nil
+}
+
+// ServerTLSConfig returns the TLS config of the signer serving the given
+// certificate. Client certificates are required if a client CA or pinned
+// client certificates are given. If both are given, client certificates must
+// both chain to the CA and be pinned.
+func ServerTLSConfig(certFile, keyFile, clientCAFile string, pinnedClientCerts []string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the server certificate: %w", err)
+	}
+
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.NoClientCert,
+	}
+
+	if clientCAFile != "" {
+		pool, err := LoadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	if len(pinnedClientCerts) > 0 {
+		pins, err := ParseFingerprints(pinnedClientCerts)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.ClientAuth == tls.NoClientCert {
+			// the pinned certificates may be self-signed, they are verified
+			// by their fingerprints only
+			cfg.ClientAuth = tls.RequireAnyClientCert
+		}
+		cfg.VerifyPeerCertificate = verifyPinnedCert(pins)
+	}
+
+	return cfg, nil
+}
+
+// ClientTLSConfig returns the TLS config of a client of the signer. The
+// client presents the given certificate if any. The server certificate must
+// chain to the given CA, or to the system CAs if no CA is given, unless
+// pinned server certificates are given without a CA, in which case the server
+// certificate is verified by its fingerprint only.
+func ClientTLSConfig(certFile, keyFile, caFile string, pinnedServerCerts []string) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if caFile != "" {
+		pool, err := LoadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+
+	if len(pinnedServerCerts) > 0 {
+		pins, err := ParseFingerprints(pinnedServerCerts)
+		if err != nil {
+			return nil, err
+		}
+		if caFile == "" {
+			// #nosec G402 -- the chain verification is replaced by the
+			// pinning, which is enforced by VerifyPeerCertificate
+			cfg.InsecureSkipVerify = true
+		}
+		cfg.VerifyPeerCertificate = verifyPinnedCert(pins)
+	}
+
+	return cfg, nil
+}
@@ -3,6 +3,7 @@ package signerservice
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice/auth"
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice/handlers"
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice/types"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -21,11 +23,66 @@ const (
 	maxResponseSize = 1 << 20 // 1MB
 )
 
+type clientOptions struct {
+	transport *http.Transport
+	hmacKey   []byte
+}
+
+// ClientOption configures how the requests to the signer are authenticated
+type ClientOption func(*clientOptions)
+
+// WithTLSConfig sends the requests with the given TLS config, which is used
+// for https URLs. The connections are reused by the requests sent with the
+// same option.
+func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return func(o *clientOptions) {
+		o.transport = transport
+	}
+}
+
+// WithHMACKey signs the requests with the given key
+func WithHMACKey(key []byte) ClientOption {
+	return func(o *clientOptions) {
+		o.hmacKey = key
+	}
+}
+
+func newClientOptions(opts []ClientOption) *clientOptions {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+func (o *clientOptions) httpClient(timeout time.Duration) *http.Client {
+	client := &http.Client{Timeout: timeout}
+	if o.transport != nil {
+		client.Transport = o.transport
+	}
+
+	return client
+}
+
+// sign signs the request with the HMAC key if any
+func (o *clientOptions) sign(req *http.Request, body []byte) error {
+	if o.hmacKey == nil {
+		return nil
+	}
+
+	return auth.SignRequest(req, body, o.hmacKey)
+}
+
 func RequestCovenantSignaure(
 	ctx context.Context,
 	signerUrl string,
 	timeout time.Duration,
 	preq *signerapp.ParsedSigningRequest,
+	opts ...ClientOption,
 ) (*signerapp.ParsedSigningResponse, error) {
 
 	req, err := types.ToSignTransactionRequest(preq)
@@ -51,7 +108,11 @@ func RequestCovenantSignaure(
 	// use json
 	httpRequest.Header.Set("Content-Type", "application/json")
 
-	client := http.Client{Timeout: timeout}
+	o := newClientOptions(opts)
+	if err := o.sign(httpRequest, marshalled); err != nil {
+		return nil, err
+	}
+	client := o.httpClient(timeout)
 	// send the request
 	res, err := client.Do(httpRequest)
 
@@ -82,7 +143,7 @@ func RequestCovenantSignaure(
 	return types.ToParsedSigningResponse(&response.Data)
 }
 
-func GetPublicKey(ctx context.Context, signerUrl string, timeout time.Duration) (*btcec.PublicKey, error) {
+func GetPublicKey(ctx context.Context, signerUrl string, timeout time.Duration, opts ...ClientOption) (*btcec.PublicKey, error) {
 	route := fmt.Sprintf("%s/v1/public-key", signerUrl)
 
 	httpRequest, err := http.NewRequestWithContext(ctx, "GET", route, nil)
@@ -91,7 +152,11 @@ func GetPublicKey(ctx context.Context, signerUrl string, timeout time.Duration)
 		return nil, err
 	}
 
-	client := http.Client{Timeout: timeout}
+	o := newClientOptions(opts)
+	if err := o.sign(httpRequest, nil); err != nil {
+		return nil, err
+	}
+	client := o.httpClient(timeout)
 	res, err := client.Do(httpRequest)
 
 	if err != nil {
@@ -125,7 +190,7 @@ func GetPublicKey(ctx context.Context, signerUrl string, timeout time.Duration)
 	return btcec.ParsePubKey(pubKey)
 }
 
-func Unlock(ctx context.Context, signerUrl string, timeout time.Duration, passphrase string) error {
+func Unlock(ctx context.Context, signerUrl string, timeout time.Duration, passphrase string, opts ...ClientOption) error {
 	route := fmt.Sprintf("%s/v1/unlock", signerUrl)
 
 	req := &types.UnlockRequest{
@@ -146,7 +211,11 @@ func Unlock(ctx context.Context, signerUrl string, timeout time.Duration, passph
 	// use json
 	httpRequest.Header.Set("Content-Type", "application/json")
 
-	client := http.Client{Timeout: timeout}
+	o := newClientOptions(opts)
+	if err := o.sign(httpRequest, marshalled); err != nil {
+		return err
+	}
+	client := o.httpClient(timeout)
 	// send the request
 	res, err := client.Do(httpRequest)
 
@@ -172,7 +241,7 @@ func Unlock(ctx context.Context, signerUrl string, timeout time.Duration, passph
 	return nil
 }
 
-func Lock(ctx context.Context, signerUrl string, timeout time.Duration) error {
+func Lock(ctx context.Context, signerUrl string, timeout time.Duration, opts ...ClientOption) error {
 	route := fmt.Sprintf("%s/v1/lock", signerUrl)
 	httpRequest, err := http.NewRequestWithContext(ctx, "POST", route, bytes.NewReader([]byte{}))
 
@@ -183,7 +252,11 @@ func Lock(ctx context.Context, signerUrl string, timeout time.Duration) error {
 	// use json
 	httpRequest.Header.Set("Content-Type", "application/json")
 
-	client := http.Client{Timeout: timeout}
+	o := newClientOptions(opts)
+	if err := o.sign(httpRequest, nil); err != nil {
+		return err
+	}
+	client := o.httpClient(timeout)
 	// send the request
 	res, err := client.Do(httpRequest)
 
@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/rs/zerolog/log"
+
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice/types"
+	"github.com/babylonlabs-io/covenant-emulator/policy"
 )
 
 func (h *Handler) SignTransactions(request *http.Request) (*Result, *types.Error) {
@@ -28,6 +31,17 @@ func (h *Handler) SignTransactions(request *http.Request) (*Result, *types.Error
 		parsedRequest,
 	)
 
+	if reason, rejected := policy.RejectionReason(err); rejected {
+		log.Ctx(request.Context()).Warn().
+			Str("staking_tx_hash", parsedRequest.StakingTx.TxHash().String()).
+			Str("reason", string(reason)).
+			Err(err).
+			Msg("signing request rejected by the signing policy")
+		h.m.IncRejectedSigningRequests(string(reason))
+
+		return nil, types.NewErrorWithMsg(http.StatusForbidden, types.Forbidden, err.Error())
+	}
+
 	if err != nil {
 		h.m.IncFailedSigningRequests()
 
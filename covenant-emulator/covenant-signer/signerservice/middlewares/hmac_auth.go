@@ -0,0 +1,40 @@
+package middlewares
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice/auth"
+)
+
+// HMACAuthMiddleware rejects the requests that are not signed with the given
+// key, and the replays of the signed ones. It must be used after
+// ContentLengthMiddleware as it reads the whole body.
+func HMACAuthMiddleware(key []byte) func(http.Handler) http.Handler {
+	nonces := auth.NewNonceCache()
+	f := func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "Bad Request", http.StatusBadRequest)
+				return
+			}
+
+			if err := auth.VerifyRequest(r, body, key, nonces, time.Now()); err != nil {
+				log.Ctx(r.Context()).Warn().Err(err).Str("remoteAddr", r.RemoteAddr).Msg("unauthenticated request")
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			h.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+	return f
+}
@@ -41,9 +41,12 @@ func New(
 	r.Use(middlewares.TracingMiddleware)
 	r.Use(middlewares.LoggingMiddleware)
 	r.Use(middlewares.ContentLengthMiddleware(int64(cfg.ServerConfig.MaxContentLength)))
-	// TODO: TLS configuration if server is to be exposed over the internet, if it supposed to
-	// be behind some reverse proxy like nginx or cloudflare, then it's not needed.
-	// Probably it needs to be configurable
+	if cfg.AuthConfig.HMACKey != nil {
+		r.Use(middlewares.HMACAuthMiddleware(cfg.AuthConfig.HMACKey))
+	}
+	if cfg.AuthConfig.Unauthenticated {
+		log.Warn().Msg("the callers are not authenticated, any caller that can reach the server can request signatures")
+	}
 
 	srv := &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", cfg.ServerConfig.Host, cfg.ServerConfig.Port),
@@ -51,6 +54,8 @@ func New(
 		ReadTimeout:  cfg.ServerConfig.ReadTimeout,
 		IdleTimeout:  cfg.ServerConfig.IdleTimeout,
 		Handler:      r,
+		// nil if the server serves plain HTTP
+		TLSConfig: cfg.AuthConfig.TLSConfig,
 	}
 
 	h, err := handlers.NewHandler(ctx, signer, metrics)
@@ -67,6 +72,12 @@ func New(
 }
 
 func (s *SigningServer) Start() error {
+	if s.httpServer.TLSConfig != nil {
+		log.Info().Msgf("Starting TLS server on %s", s.httpServer.Addr)
+		// the certificate is set in the TLS config
+		return s.httpServer.ListenAndServeTLS("", "")
+	}
+
 	log.Info().Msgf("Starting server on %s", s.httpServer.Addr)
 	return s.httpServer.ListenAndServe()
 }
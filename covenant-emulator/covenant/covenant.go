@@ -16,11 +16,14 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"go.uber.org/zap"
 
+	"github.com/babylonlabs-io/covenant-emulator/audit"
 	"github.com/babylonlabs-io/covenant-emulator/clientcontroller"
 	covcfg "github.com/babylonlabs-io/covenant-emulator/config"
+	"github.com/babylonlabs-io/covenant-emulator/policy"
 	"github.com/babylonlabs-io/covenant-emulator/types"
 )
 
@@ -48,6 +51,24 @@ type CovenantEmulator struct {
 	logger *zap.Logger
 
 	paramCache ParamsGetter
+
+	// policy is the signing policy enforced on every delegation, or nil if
+	// no policy file is configured
+	policy *policy.Engine
+	// auditLog records every signing decision, or is nil if no audit log file
+	// is configured
+	auditLog *audit.Log
+
+	// queuedDels are the delegations received from the event subscription
+	// whose signatures are not submitted yet, with the number of failed
+	// attempts. It is only accessed by the signature submission loop.
+	queuedDels map[chainhash.Hash]uint
+
+	rejectedMu sync.Mutex
+	// rejectedDels are the delegations rejected by the signing policy with the
+	// reason, so that a delegation that stays pending is reported and audited
+	// only once per reason
+	rejectedDels map[chainhash.Hash]policy.Reason
 }
 
 func NewCovenantEmulator(
@@ -61,6 +82,28 @@ func NewCovenantEmulator(
 		return nil, fmt.Errorf("failed to get signer pub key: %w", err)
 	}
 
+	var policyEngine *policy.Engine
+	if config.PolicyFile != "" {
+		policyEngine, err = policy.LoadEngine(config.PolicyFile, &config.BTCNetParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the signing policy: %w", err)
+		}
+	}
+
+	var auditLog *audit.Log
+	if config.AuditLogFile != "" {
+		auditLog, err = audit.Open(config.AuditLogFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open the audit log: %w", err)
+		}
+		if n := auditLog.DiscardedBytes(); n > 0 {
+			logger.Warn("discarded the incomplete last line of the audit log left by an interrupted append",
+				zap.String("file", config.AuditLogFile),
+				zap.Int64("bytes", n),
+			)
+		}
+	}
+
 	return &CovenantEmulator{
 		cc:         cc,
 		signer:     signer,
@@ -69,6 +112,11 @@ func NewCovenantEmulator(
 		pk:         pk,
 		quit:       make(chan struct{}),
 		paramCache: NewCacheVersionedParams(cc, logger),
+		policy:     policyEngine,
+		auditLog:   auditLog,
+		queuedDels: make(map[chainhash.Hash]uint),
+
+		rejectedDels: make(map[chainhash.Hash]policy.Reason),
 	}, nil
 }
 
@@ -89,6 +137,9 @@ func (ce *CovenantEmulator) AddCovenantSignatures(btcDels []*types.Delegation) (
 	}
 
 	covenantSigs := make([]*types.CovenantSigs, 0, len(btcDels))
+	// the rate cap slots of the signed delegations are committed only if the
+	// signatures are submitted
+	reservations := make([]*policy.Reservation, 0, len(btcDels))
 	for _, btcDel := range btcDels {
 		// 0. nil checks
 		if btcDel == nil {
@@ -187,22 +238,50 @@ func (ce *CovenantEmulator) AddCovenantSignatures(btcDels []*types.Delegation) (
 			continue
 		}
 
-		// 8. Generate Signing Request
+		// 8. enforce the signing policy
+		auditRecord := audit.NewRecord(stakingTx, slashingTx, unbondingTx, slashUnbondingTx)
+		stakingTxHash := stakingTx.TxHash()
+		reservation, err := ce.enforcePolicy(btcDel, stakingTx, slashingTx, unbondingTx, slashUnbondingTx)
+		if err != nil {
+			reason, _ := policy.RejectionReason(err)
+			if ce.markRejected(stakingTxHash, reason) {
+				ce.logger.Warn("delegation rejected by the signing policy",
+					zap.String("staker_pk", stakerPkHex),
+					zap.String("staking_tx_hash", stakingTxHash.String()),
+					zap.String("reason", string(reason)),
+					zap.Error(err),
+				)
+				ce.recordMetricsPolicyRejectedDelegations(reason)
+
+				auditRecord.PolicyVerdict = audit.PolicyRejected
+				auditRecord.PolicyReason = err.Error()
+				_ = ce.appendAuditRecord(auditRecord)
+			}
+			continue
+		}
+		ce.unmarkRejected(stakingTxHash)
+		if ce.policy != nil {
+			auditRecord.PolicyVerdict = audit.PolicyAccepted
+		}
+
+		// 9. Generate Signing Request
 		// Finality providers encryption keys
 		// pk script paths for Slash, unbond and unbonding slashing
 		fpsEncKeys, err := fpEncKeysFromDel(btcDel)
 		if err != nil {
 			ce.logger.Error("failed to encript the finality provider keys of the btc delegation", zap.String("staker_pk", stakerPkHex), zap.Error(err))
+			reservation.Release()
 			continue
 		}
 
 		slashingPkScriptPath, stakingTxUnbondingPkScriptPath, unbondingTxSlashingPkScriptPath, err := pkScriptPaths(btcDel, params, &ce.config.BTCNetParams, unbondingTx)
 		if err != nil {
 			ce.logger.Error("failed to generate pk script path", zap.Error(err))
+			reservation.Release()
 			continue
 		}
 
-		// 9. sign covenant transactions
+		// 10. sign covenant transactions
 		resp, err := ce.SignTransactions(SigningRequest{
 			StakingTx:                       stakingTx,
 			SlashingTx:                      slashingTx,
@@ -216,24 +295,42 @@ func (ce *CovenantEmulator) AddCovenantSignatures(btcDels []*types.Delegation) (
 		})
 		if err != nil {
 			ce.logger.Error("failed to sign transactions", zap.Error(err))
+
+			auditRecord.Error = err.Error()
+			_ = ce.appendAuditRecord(auditRecord)
+			reservation.Release()
+			continue
+		}
+
+		// signatures that cannot be audited are not submitted
+		auditRecord.SetSignatures(resp.SlashSigs, resp.UnbondingSig.Serialize(), resp.SlashUnbondingSigs)
+		if err := ce.appendAuditRecord(auditRecord); err != nil {
+			reservation.Release()
 			continue
 		}
 
 		covenantSigs = append(covenantSigs, &types.CovenantSigs{
 			PublicKey:             ce.pk,
-			StakingTxHash:         stakingTx.TxHash(),
+			StakingTxHash:         stakingTxHash,
 			SlashingSigs:          resp.SlashSigs,
 			UnbondingSig:          resp.UnbondingSig,
 			SlashingUnbondingSigs: resp.SlashUnbondingSigs,
 		})
+		reservations = append(reservations, reservation)
 	}
 
-	// 10. submit covenant sigs
+	// 11. submit covenant sigs
 	res, err := ce.cc.SubmitCovenantSigs(covenantSigs)
 	if err != nil {
+		for _, reservation := range reservations {
+			reservation.Release()
+		}
 		ce.recordMetricsFailedSignDelegations(len(covenantSigs))
 		return nil, err
 	}
+	for _, reservation := range reservations {
+		reservation.Commit()
+	}
 
 	// record metrics
 	submittedTime := time.Now()
@@ -262,6 +359,73 @@ func (ce *CovenantEmulator) SignTransactions(signingReq SigningRequest) (*Signat
 	return resp, nil
 }
 
+// enforcePolicy evaluates the delegation against the signing policy, if any,
+// and returns the reservation of the rate cap slot of the delegation
+func (ce *CovenantEmulator) enforcePolicy(
+	btcDel *types.Delegation,
+	stakingTx, slashingTx, unbondingTx, slashUnbondingTx *wire.MsgTx,
+) (*policy.Reservation, error) {
+	if ce.policy == nil {
+		return nil, nil
+	}
+
+	req, err := policy.NewRequest(
+		stakingTx,
+		btcDel.StakingOutputIdx,
+		slashingTx,
+		unbondingTx,
+		slashUnbondingTx,
+		btcDel.BtcPk,
+		btcDel.FpBtcPks,
+	)
+	if err != nil {
+		return nil, &policy.RejectionError{Reason: policy.ReasonInvalidRequest, Msg: err.Error()}
+	}
+
+	return ce.policy.Evaluate(req)
+}
+
+// markRejected records the policy rejection of the delegation and returns
+// false if the delegation was already rejected for the same reason
+func (ce *CovenantEmulator) markRejected(stakingTxHash chainhash.Hash, reason policy.Reason) bool {
+	ce.rejectedMu.Lock()
+	defer ce.rejectedMu.Unlock()
+
+	if prev, ok := ce.rejectedDels[stakingTxHash]; ok && prev == reason {
+		return false
+	}
+	ce.rejectedDels[stakingTxHash] = reason
+
+	return true
+}
+
+// unmarkRejected forgets the policy rejection of a delegation that is accepted,
+// e.g., once the rate cap allows it
+func (ce *CovenantEmulator) unmarkRejected(stakingTxHash chainhash.Hash) {
+	ce.rejectedMu.Lock()
+	defer ce.rejectedMu.Unlock()
+
+	delete(ce.rejectedDels, stakingTxHash)
+}
+
+// appendAuditRecord appends the record of a signing decision to the audit log,
+// if any
+func (ce *CovenantEmulator) appendAuditRecord(r *audit.Record) error {
+	if ce.auditLog == nil {
+		return nil
+	}
+
+	if err := ce.auditLog.Append(r); err != nil {
+		ce.logger.Error("failed to append to the audit log",
+			zap.String("staking_tx_hash", r.StakingTxHash),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
 func fpEncKeysFromDel(btcDel *types.Delegation) ([]*asig.EncryptionKey, error) {
 	fpsEncKeys := make([]*asig.EncryptionKey, 0, len(btcDel.FpBtcPks))
 	for _, fpPk := range btcDel.FpBtcPks {
@@ -504,46 +668,51 @@ func AcceptDelegationToSign(
 }
 
 // covenantSigSubmissionLoop is the reactor to submit Covenant signature for BTC delegations
+// In the event subscription mode, the delegations are signed as soon as their creation events
+// are received, and pending delegations are polled only while the subscription is down
 func (ce *CovenantEmulator) covenantSigSubmissionLoop() {
 	defer ce.wg.Done()
 
 	interval := ce.config.QueryInterval
-	limit := ce.config.DelegationLimit
 	covenantSigTicker := time.NewTicker(interval)
 
 	ce.logger.Info("starting signature submission loop",
-		zap.Float64("interval seconds", interval.Seconds()))
+		zap.Float64("interval seconds", interval.Seconds()),
+		zap.Bool("event subscription", ce.config.EventSubscription))
+
+	// events is nil while pending delegations are polled
+	var events <-chan *types.DelegationEvent
+	if ce.config.EventSubscription {
+		events = ce.subscribeDelegationEvents()
+		// the delegations created before the subscription are only found by polling
+		ce.signPendingDelegations()
+	}
 
 	for {
 		select {
-		case <-covenantSigTicker.C:
-			// 1. Get all pending delegations
-			dels, err := ce.cc.QueryPendingDelegations(limit, ce.acceptDelegationToSign)
-			if err != nil {
-				ce.logger.Debug("failed to get pending delegations", zap.Error(err))
+		case event, ok := <-events:
+			if !ok {
+				ce.logger.Warn("the subscription to delegation events dropped, polling pending delegations")
+				ce.recordMetricsEventSubscriptionUp(false)
+				events = nil
+				// the queued delegations are found by polling
+				clear(ce.queuedDels)
 				continue
 			}
+			ce.handleDelegationEvents(event, events)
 
-			pendingDels := len(dels)
-			// record delegation metrics
-			ce.recordMetricsCurrentPendingDelegations(pendingDels)
-
-			if pendingDels == 0 {
-				ce.logger.Debug("no pending delegations are found")
+		case <-covenantSigTicker.C:
+			if events != nil {
+				ce.signQueuedDelegations()
 				continue
 			}
 
-			// 2. Split delegations into batches for submission
-			batches := ce.delegationsToBatches(dels)
-			for _, delBatch := range batches {
-				_, err := ce.AddCovenantSignatures(delBatch)
-				if err != nil {
-					ce.logger.Error(
-						"failed to submit covenant signatures for BTC delegations",
-						zap.Error(err),
-					)
-				}
+			if ce.config.EventSubscription {
+				// polling after subscribing again catches up with the delegations
+				// created while the subscription was down
+				events = ce.subscribeDelegationEvents()
 			}
+			ce.signPendingDelegations()
 
 		case <-ce.quit:
 			ce.logger.Debug("exiting covenant signature submission loop")
@@ -553,6 +722,38 @@ func (ce *CovenantEmulator) covenantSigSubmissionLoop() {
 
 }
 
+// signPendingDelegations queries the pending delegations and submits their
+// covenant signatures in batches
+func (ce *CovenantEmulator) signPendingDelegations() {
+	// 1. Get all pending delegations
+	dels, err := ce.cc.QueryPendingDelegations(ce.config.DelegationLimit, ce.acceptDelegationToSign)
+	if err != nil {
+		ce.logger.Debug("failed to get pending delegations", zap.Error(err))
+		return
+	}
+
+	pendingDels := len(dels)
+	// record delegation metrics
+	ce.recordMetricsCurrentPendingDelegations(pendingDels)
+
+	if pendingDels == 0 {
+		ce.logger.Debug("no pending delegations are found")
+		return
+	}
+
+	// 2. Split delegations into batches for submission
+	batches := ce.delegationsToBatches(dels)
+	for _, delBatch := range batches {
+		_, err := ce.AddCovenantSignatures(delBatch)
+		if err != nil {
+			ce.logger.Error(
+				"failed to submit covenant signatures for BTC delegations",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
 func (ce *CovenantEmulator) metricsUpdateLoop() {
 	defer ce.wg.Done()
 
@@ -581,6 +782,18 @@ func (ce *CovenantEmulator) recordMetricsTotalSignDelegationsSubmitted(n int) {
 	totalSignDelegationsSubmitted.WithLabelValues(ce.PublicKeyStr()).Add(float64(n))
 }
 
+func (ce *CovenantEmulator) recordMetricsPolicyRejectedDelegations(reason policy.Reason) {
+	policyRejectedDelegations.WithLabelValues(ce.PublicKeyStr(), string(reason)).Inc()
+}
+
+func (ce *CovenantEmulator) recordMetricsEventSubscriptionUp(up bool) {
+	value := float64(0)
+	if up {
+		value = 1
+	}
+	eventSubscriptionUp.WithLabelValues(ce.PublicKeyStr()).Set(value)
+}
+
 func (ce *CovenantEmulator) recordMetricsCurrentPendingDelegations(n int) {
 	currentPendingDelegations.WithLabelValues(ce.PublicKeyStr()).Set(float64(n))
 }
@@ -606,6 +819,10 @@ func (ce *CovenantEmulator) Stop() error {
 		close(ce.quit)
 		ce.wg.Wait()
 
+		if ce.auditLog != nil {
+			stopErr = ce.auditLog.Close()
+		}
+
 		ce.logger.Debug("Covenant Emulator successfully stopped")
 	})
 	return stopErr
@@ -2,6 +2,7 @@ package covenant_test
 
 import (
 	"context"
+	crand "crypto/rand"
 	"encoding/hex"
 	"fmt"
 	"math/rand"
@@ -18,7 +19,9 @@ import (
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -32,6 +35,7 @@ import (
 	"github.com/babylonlabs-io/covenant-emulator/keyring"
 	"github.com/babylonlabs-io/covenant-emulator/remotesigner"
 	"github.com/babylonlabs-io/covenant-emulator/testutil"
+	"github.com/babylonlabs-io/covenant-emulator/testutil/mocks"
 	"github.com/babylonlabs-io/covenant-emulator/types"
 )
 
@@ -70,6 +74,8 @@ func FuzzAddCovenantSig(f *testing.F) {
 	)
 
 	met := signerMetrics.NewCovenantSignerMetrics()
+	// the test signer serves plain HTTP to the local caller
+	signerConfig.Auth.AllowUnauthenticated = true
 	parsedConfig, err := signerConfig.Parse()
 	require.NoError(f, err)
 
@@ -81,7 +87,8 @@ func FuzzAddCovenantSig(f *testing.F) {
 	)
 	require.NoError(f, err)
 
-	signer := remotesigner.NewRemoteSigner(covenantConfig.RemoteSigner)
+	signer, err := remotesigner.NewRemoteSigner(covenantConfig.RemoteSigner)
+	require.NoError(f, err)
 
 	go func() {
 		_ = server.Start()
@@ -381,6 +388,171 @@ func TestIsKeyInCommittee(t *testing.T) {
 	require.EqualError(t, err, expErr.Error())
 }
 
+// newEventTestEmulator creates a covenant emulator subscribing to delegation
+// events and the mocked client controller it uses. The delegations returned by
+// the tests have no undelegation, so they are accepted to be signed but
+// skipped before signing, and an empty batch of signatures is submitted.
+func newEventTestEmulator(t *testing.T, queryInterval time.Duration) (*covenant.CovenantEmulator, *mocks.MockClientController, *types.Delegation) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	covKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	params := testutil.GenRandomParams(r, t)
+	params.CovenantPks = []*btcec.PublicKey{covKey.PubKey()}
+	mockClientController := testutil.PrepareMockedClientController(t, params)
+
+	covenantConfig := covcfg.DefaultConfig()
+	covenantConfig.EventSubscription = true
+	covenantConfig.QueryInterval = queryInterval
+
+	ce, err := covenant.NewCovenantEmulator(&covenantConfig, mockClientController, zap.NewNop(), NewMockSigner(covKey.PubKey()))
+	require.NoError(t, err)
+
+	return ce, mockClientController, &types.Delegation{}
+}
+
+func genStakingTxHash(t *testing.T) chainhash.Hash {
+	var h chainhash.Hash
+	_, err := crand.Read(h[:])
+	require.NoError(t, err)
+
+	return h
+}
+
+func TestDelegationEvents(t *testing.T) {
+	ce, mockClientController, del := newEventTestEmulator(t, 10*time.Millisecond)
+
+	created := genStakingTxHash(t)
+	unbonded := genStakingTxHash(t)
+	// the events received together are applied before signing
+	events := make(chan *types.DelegationEvent, 5)
+	events <- &types.DelegationEvent{Type: types.DelegationCreated, StakingTxHash: created}
+	events <- &types.DelegationEvent{Type: types.DelegationCreated, StakingTxHash: unbonded}
+	events <- &types.DelegationEvent{Type: types.DelegationCreated, StakingTxHash: created}
+	events <- &types.DelegationEvent{Type: types.DelegationUnbonded, StakingTxHash: unbonded}
+	events <- &types.DelegationEvent{Type: types.DelegationUnbonded, StakingTxHash: genStakingTxHash(t)}
+
+	submitted := make(chan struct{})
+	mockClientController.EXPECT().SubscribeDelegationEvents().Return((<-chan *types.DelegationEvent)(events), nil).Times(1)
+	// the delegations created before the subscription are polled once
+	mockClientController.EXPECT().QueryPendingDelegations(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	// the duplicated creation is queued once, and the unbonded delegation is
+	// never signed
+	mockClientController.EXPECT().QueryPendingDelegation(created).Return(del, nil).Times(1)
+	mockClientController.EXPECT().SubmitCovenantSigs(gomock.Any()).
+		DoAndReturn(func([]*types.CovenantSigs) (*types.TxResponse, error) {
+			close(submitted)
+			return &types.TxResponse{}, nil
+		}).Times(1)
+
+	require.NoError(t, ce.Start())
+	requireClosed(t, submitted)
+	// the signed delegation is no longer queued
+	time.Sleep(5 * ce.Config().QueryInterval)
+	require.NoError(t, ce.Stop())
+}
+
+func TestQueuedDelegationsRetry(t *testing.T) {
+	ce, mockClientController, del := newEventTestEmulator(t, 10*time.Millisecond)
+
+	failing := genStakingTxHash(t)
+	notPending := genStakingTxHash(t)
+	events := make(chan *types.DelegationEvent, 2)
+	events <- &types.DelegationEvent{Type: types.DelegationCreated, StakingTxHash: failing}
+	events <- &types.DelegationEvent{Type: types.DelegationCreated, StakingTxHash: notPending}
+
+	dropped := make(chan struct{})
+	attempts := 0
+	mockClientController.EXPECT().SubscribeDelegationEvents().Return((<-chan *types.DelegationEvent)(events), nil).Times(1)
+	mockClientController.EXPECT().QueryPendingDelegations(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	// a delegation that is no longer pending is dropped at once
+	mockClientController.EXPECT().QueryPendingDelegation(notPending).Return(nil, nil).Times(1)
+	// a delegation whose signatures fail to be submitted is retried every
+	// query interval, and dropped after RtyAttNum attempts
+	mockClientController.EXPECT().QueryPendingDelegation(failing).Return(del, nil).Times(int(covenant.RtyAttNum))
+	mockClientController.EXPECT().SubmitCovenantSigs(gomock.Any()).
+		DoAndReturn(func([]*types.CovenantSigs) (*types.TxResponse, error) {
+			attempts++
+			if attempts == int(covenant.RtyAttNum) {
+				close(dropped)
+			}
+			return nil, fmt.Errorf("failed to submit")
+		}).Times(int(covenant.RtyAttNum))
+
+	require.NoError(t, ce.Start())
+	requireClosed(t, dropped)
+	// the dropped delegation is not retried anymore
+	time.Sleep(5 * ce.Config().QueryInterval)
+	require.NoError(t, ce.Stop())
+}
+
+func TestDelegationEventsResubscription(t *testing.T) {
+	ce, mockClientController, del := newEventTestEmulator(t, 100*time.Millisecond)
+
+	// the subscription drops after a delegation failed to be signed once
+	dropped := genStakingTxHash(t)
+	firstEvents := make(chan *types.DelegationEvent, 1)
+	firstEvents <- &types.DelegationEvent{Type: types.DelegationCreated, StakingTxHash: dropped}
+	close(firstEvents)
+
+	created := genStakingTxHash(t)
+	secondEvents := make(chan *types.DelegationEvent, 1)
+	secondEvents <- &types.DelegationEvent{Type: types.DelegationCreated, StakingTxHash: created}
+
+	submitted := make(chan struct{})
+	gomock.InOrder(
+		mockClientController.EXPECT().SubscribeDelegationEvents().Return((<-chan *types.DelegationEvent)(firstEvents), nil),
+		mockClientController.EXPECT().QueryPendingDelegations(gomock.Any(), gomock.Any()).Return(nil, nil),
+		// the queued delegation is dropped with the subscription, as it is
+		// found by polling
+		mockClientController.EXPECT().QueryPendingDelegation(dropped).Return(nil, fmt.Errorf("failed to query")),
+		// the pending delegations are polled while subscribing again fails
+		mockClientController.EXPECT().SubscribeDelegationEvents().Return(nil, fmt.Errorf("failed to subscribe")),
+		mockClientController.EXPECT().QueryPendingDelegations(gomock.Any(), gomock.Any()).Return(nil, nil),
+		// polling once more after subscribing again catches up with the
+		// delegations created while the subscription was down
+		mockClientController.EXPECT().SubscribeDelegationEvents().Return((<-chan *types.DelegationEvent)(secondEvents), nil),
+		mockClientController.EXPECT().QueryPendingDelegations(gomock.Any(), gomock.Any()).Return(nil, nil),
+		// the events of the new subscription are handled
+		mockClientController.EXPECT().QueryPendingDelegation(created).Return(del, nil),
+		mockClientController.EXPECT().SubmitCovenantSigs(gomock.Any()).
+			DoAndReturn(func([]*types.CovenantSigs) (*types.TxResponse, error) {
+				close(submitted)
+				return &types.TxResponse{}, nil
+			}),
+	)
+
+	require.NoError(t, ce.Start())
+	requireClosed(t, submitted)
+	require.NoError(t, ce.Stop())
+}
+
+func requireClosed(t *testing.T, ch <-chan struct{}) {
+	select {
+	case <-ch:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out")
+	}
+}
+
+type MockSigner struct {
+	pk *btcec.PublicKey
+}
+
+func NewMockSigner(pk *btcec.PublicKey) *MockSigner {
+	return &MockSigner{
+		pk: pk,
+	}
+}
+
+func (m *MockSigner) SignTransactions(covenant.SigningRequest) (*covenant.SignaturesResponse, error) {
+	return nil, fmt.Errorf("the mock signer does not sign")
+}
+
+func (m *MockSigner) PubKey() (*btcec.PublicKey, error) {
+	return m.pk, nil
+}
+
 type MockParamGetter struct {
 	paramsByVersion map[uint32]*types.StakingParams
 }
@@ -0,0 +1,154 @@
+package covenant
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/covenant-emulator/policy"
+	"github.com/babylonlabs-io/covenant-emulator/types"
+)
+
+// subscribeDelegationEvents subscribes to the delegation events and returns
+// nil if it fails, in which case pending delegations are polled
+func (ce *CovenantEmulator) subscribeDelegationEvents() <-chan *types.DelegationEvent {
+	events, err := ce.cc.SubscribeDelegationEvents()
+	if err != nil {
+		ce.logger.Warn("failed to subscribe to delegation events, polling pending delegations", zap.Error(err))
+		ce.recordMetricsEventSubscriptionUp(false)
+
+		return nil
+	}
+
+	ce.logger.Info("subscribed to delegation events")
+	ce.recordMetricsEventSubscriptionUp(true)
+
+	return events
+}
+
+// handleDelegationEvents applies the given event and the events already
+// received after it, and signs the queued delegations in batches
+func (ce *CovenantEmulator) handleDelegationEvents(event *types.DelegationEvent, events <-chan *types.DelegationEvent) {
+	ce.applyDelegationEvent(event)
+
+	// the events of the delegations created in the same block are received
+	// together, so they are signed in the same batch
+drain:
+	for uint64(len(ce.queuedDels)) < ce.config.SigsBatchSize {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				// the drop is handled by the submission loop
+				break drain
+			}
+			ce.applyDelegationEvent(event)
+		default:
+			break drain
+		}
+	}
+
+	ce.signQueuedDelegations()
+}
+
+func (ce *CovenantEmulator) applyDelegationEvent(event *types.DelegationEvent) {
+	switch event.Type {
+	case types.DelegationCreated:
+		if _, ok := ce.queuedDels[event.StakingTxHash]; !ok {
+			ce.queuedDels[event.StakingTxHash] = 0
+		}
+	case types.DelegationUnbonded:
+		// an unbonded delegation no longer needs covenant signatures
+		if _, ok := ce.queuedDels[event.StakingTxHash]; ok {
+			ce.logger.Debug("dropping the unbonded delegation",
+				zap.String("staking_tx_hash", event.StakingTxHash.String()))
+			delete(ce.queuedDels, event.StakingTxHash)
+		}
+	}
+}
+
+// signQueuedDelegations submits the covenant signatures of the queued
+// delegations. The delegations whose signatures fail to be submitted stay
+// queued and are retried every query interval. The delegations held back by
+// the rate cap of the signing policy stay queued without counting an attempt,
+// until the cap allows them to be signed.
+func (ce *CovenantEmulator) signQueuedDelegations() {
+	if len(ce.queuedDels) == 0 {
+		return
+	}
+
+	dels := make([]*types.Delegation, 0, len(ce.queuedDels))
+	stakingTxHashes := make(map[*types.Delegation]chainhash.Hash, len(ce.queuedDels))
+	for stakingTxHash := range ce.queuedDels {
+		del, err := ce.cc.QueryPendingDelegation(stakingTxHash)
+		if err != nil {
+			ce.logger.Debug("failed to query the delegation",
+				zap.String("staking_tx_hash", stakingTxHash.String()), zap.Error(err))
+			ce.requeueDelegation(stakingTxHash)
+			continue
+		}
+		if del == nil {
+			// no longer pending
+			delete(ce.queuedDels, stakingTxHash)
+			continue
+		}
+
+		accept, err := ce.acceptDelegationToSign(del)
+		if err != nil {
+			ce.logger.Debug("failed to check the delegation",
+				zap.String("staking_tx_hash", stakingTxHash.String()), zap.Error(err))
+			ce.requeueDelegation(stakingTxHash)
+			continue
+		}
+		if !accept {
+			delete(ce.queuedDels, stakingTxHash)
+			continue
+		}
+
+		dels = append(dels, del)
+		stakingTxHashes[del] = stakingTxHash
+	}
+
+	for _, delBatch := range ce.delegationsToBatches(dels) {
+		_, err := ce.AddCovenantSignatures(delBatch)
+		if err != nil {
+			ce.logger.Error(
+				"failed to submit covenant signatures for BTC delegations",
+				zap.Error(err),
+			)
+		}
+		for _, del := range delBatch {
+			stakingTxHash := stakingTxHashes[del]
+			switch {
+			case ce.rateCapped(stakingTxHash):
+				continue
+			case err != nil:
+				ce.requeueDelegation(stakingTxHash)
+			default:
+				delete(ce.queuedDels, stakingTxHash)
+			}
+		}
+	}
+}
+
+// rateCapped returns true if the delegation was last rejected by the rate cap
+// of the signing policy
+func (ce *CovenantEmulator) rateCapped(stakingTxHash chainhash.Hash) bool {
+	ce.rejectedMu.Lock()
+	defer ce.rejectedMu.Unlock()
+
+	return ce.rejectedDels[stakingTxHash] == policy.ReasonMaxSignaturesPerHour
+}
+
+// requeueDelegation counts a failed attempt on the queued delegation, which
+// is dropped after RtyAttNum attempts
+func (ce *CovenantEmulator) requeueDelegation(stakingTxHash chainhash.Hash) {
+	ce.queuedDels[stakingTxHash]++
+	if ce.queuedDels[stakingTxHash] < RtyAttNum {
+		return
+	}
+
+	ce.logger.Error("dropping the delegation after failing to sign it",
+		zap.String("staking_tx_hash", stakingTxHash.String()),
+		zap.Uint("attempts", RtyAttNum),
+	)
+	delete(ce.queuedDels, stakingTxHash)
+}
@@ -0,0 +1,76 @@
+package covenant
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	covcfg "github.com/babylonlabs-io/covenant-emulator/config"
+	"github.com/babylonlabs-io/covenant-emulator/policy"
+	"github.com/babylonlabs-io/covenant-emulator/testutil"
+	"github.com/babylonlabs-io/covenant-emulator/types"
+)
+
+// pubKeySigner only knows its public key
+type pubKeySigner struct {
+	pk *btcec.PublicKey
+}
+
+func (s *pubKeySigner) SignTransactions(SigningRequest) (*SignaturesResponse, error) {
+	return nil, fmt.Errorf("the signer does not sign")
+}
+
+func (s *pubKeySigner) PubKey() (*btcec.PublicKey, error) {
+	return s.pk, nil
+}
+
+func TestRateCappedDelegationsStayQueued(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	covKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	params := testutil.GenRandomParams(r, t)
+	params.CovenantPks = []*btcec.PublicKey{covKey.PubKey()}
+	mockClientController := testutil.PrepareMockedClientController(t, params)
+
+	covenantConfig := covcfg.DefaultConfig()
+	covenantConfig.EventSubscription = true
+	ce, err := NewCovenantEmulator(&covenantConfig, mockClientController, zap.NewNop(), &pubKeySigner{pk: covKey.PubKey()})
+	require.NoError(t, err)
+
+	var capped chainhash.Hash
+	_, err = r.Read(capped[:])
+	require.NoError(t, err)
+	ce.queuedDels[capped] = 0
+	ce.markRejected(capped, policy.ReasonMaxSignaturesPerHour)
+
+	mockClientController.EXPECT().QueryPendingDelegation(capped).Return(&types.Delegation{}, nil).AnyTimes()
+
+	// the failed submissions of the batch do not count against the capped
+	// delegation
+	mockClientController.EXPECT().SubmitCovenantSigs(gomock.Any()).
+		Return(nil, fmt.Errorf("failed to submit")).Times(int(RtyAttNum))
+	for i := 0; i < int(RtyAttNum); i++ {
+		ce.signQueuedDelegations()
+	}
+	require.Contains(t, ce.queuedDels, capped)
+	require.Zero(t, ce.queuedDels[capped])
+
+	// nor is the capped delegation dropped by a successful submission
+	mockClientController.EXPECT().SubmitCovenantSigs(gomock.Any()).Return(&types.TxResponse{}, nil).Times(1)
+	ce.signQueuedDelegations()
+	require.Contains(t, ce.queuedDels, capped)
+
+	// once the cap allows the delegation, it is signed and no longer queued
+	ce.unmarkRejected(capped)
+	mockClientController.EXPECT().SubmitCovenantSigs(gomock.Any()).Return(&types.TxResponse{}, nil).Times(1)
+	ce.signQueuedDelegations()
+	require.NotContains(t, ce.queuedDels, capped)
+}
@@ -72,6 +72,13 @@ var (
 		},
 		[]string{"covenant_pk"},
 	)
+	policyRejectedDelegations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ce_total_policy_rejected_delegations",
+			Help: "Total number of delegations rejected by the signing policy",
+		},
+		[]string{"covenant_pk", "reason"},
+	)
 	currentPendingDelegations = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "ce_current_pending_delegations",
@@ -79,6 +86,13 @@ var (
 		},
 		[]string{"covenant_pk"},
 	)
+	eventSubscriptionUp = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ce_event_subscription_up",
+			Help: "Whether the subscription to delegation events is up (1) or pending delegations are polled (0)",
+		},
+		[]string{"covenant_pk"},
+	)
 	secondsSinceLastSubmission = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "ce_seconds_since_last_submission",
 		Help: "Seconds since last submission of signatures",
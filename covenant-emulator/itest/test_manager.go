@@ -106,6 +106,8 @@ func StartManager(t *testing.T) *TestManager {
 	)
 
 	met := signerMetrics.NewCovenantSignerMetrics()
+	// the test signer serves plain HTTP to the local caller
+	signerConfig.Auth.AllowUnauthenticated = true
 	parsedConfig, err := signerConfig.Parse()
 	require.NoError(t, err)
 
@@ -121,7 +123,8 @@ func StartManager(t *testing.T) *TestManager {
 	)
 	require.NoError(t, err)
 
-	signer := remotesigner.NewRemoteSigner(covenantConfig.RemoteSigner)
+	signer, err := remotesigner.NewRemoteSigner(covenantConfig.RemoteSigner)
+	require.NoError(t, err)
 	covPubKey := keyInfo.PublicKey
 
 	go func() {
@@ -0,0 +1,206 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// Reason is the reason a delegation is rejected by the signing policy, used
+// as a metric label
+type Reason string
+
+const (
+	ReasonMaxStakingAmount     Reason = "max_staking_amount"
+	ReasonFinalityProvider     Reason = "finality_provider_not_allowed"
+	ReasonMinSlashingFeeRate   Reason = "min_slashing_fee_rate"
+	ReasonBlockedStaker        Reason = "blocked_staker"
+	ReasonMaxSignaturesPerHour Reason = "max_signatures_per_hour"
+	ReasonInvalidRequest       Reason = "invalid_request"
+)
+
+const rateWindow = time.Hour
+
+// RejectionError is returned when a delegation violates the signing policy
+type RejectionError struct {
+	Reason Reason
+	Msg    string
+}
+
+func (e *RejectionError) Error() string {
+	return fmt.Sprintf("rejected by the signing policy (%s): %s", e.Reason, e.Msg)
+}
+
+func reject(reason Reason, format string, args ...any) error {
+	return &RejectionError{Reason: reason, Msg: fmt.Sprintf(format, args...)}
+}
+
+// RejectionReason returns the reason of the policy rejection wrapped in the
+// given error, and false if it is not a policy rejection
+func RejectionReason(err error) (Reason, bool) {
+	var rejErr *RejectionError
+	if errors.As(err, &rejErr) {
+		return rejErr.Reason, true
+	}
+
+	return "", false
+}
+
+// Engine evaluates delegations against a signing policy. It keeps the times of
+// the signed delegations to enforce the rate cap, so a single engine should
+// be shared by all the signing paths of a process.
+type Engine struct {
+	policy *parsedPolicy
+	now    func() time.Time
+
+	mu sync.Mutex
+	// signedAt are the times of the delegations signed within the rate window
+	signedAt []time.Time
+	// reserved is the number of accepted delegations that are not signed or
+	// released yet
+	reserved int
+}
+
+// Reservation holds a slot of the rate cap for an accepted delegation. The
+// slot is counted towards the cap only once the signing is committed, and it
+// is freed if the signing is released. A nil reservation is a no-op.
+type Reservation struct {
+	engine *Engine
+	done   bool
+}
+
+// Commit counts the signing towards the rate cap. It must be called once the
+// signatures are successfully produced and handed out.
+func (r *Reservation) Commit() {
+	if r == nil {
+		return
+	}
+
+	e := r.engine
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if r.done {
+		return
+	}
+	r.done = true
+	e.reserved--
+	e.signedAt = append(e.signedAt, e.now())
+}
+
+// Release frees the slot without counting the signing, e.g., because signing
+// or submitting the signatures failed
+func (r *Reservation) Release() {
+	if r == nil {
+		return
+	}
+
+	e := r.engine
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if r.done {
+		return
+	}
+	r.done = true
+	e.reserved--
+}
+
+// NewEngine creates an engine enforcing the given policy. The addresses of the
+// policy are decoded for the given BTC network.
+func NewEngine(p *Policy, btcNet *chaincfg.Params) (*Engine, error) {
+	parsed, err := p.parse(btcNet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Engine{
+		policy: parsed,
+		now:    time.Now,
+	}, nil
+}
+
+// LoadEngine creates an engine enforcing the policy in the given file
+func LoadEngine(path string, btcNet *chaincfg.Params) (*Engine, error) {
+	p, err := LoadPolicy(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEngine(p, btcNet)
+}
+
+// Evaluate returns a *RejectionError if the delegation violates the policy.
+// Otherwise, the delegation is accepted and holds a slot of the rate cap until
+// the returned reservation is committed or released.
+func (e *Engine) Evaluate(req *Request) (*Reservation, error) {
+	p := e.policy
+
+	if p.maxStakingAmount > 0 && req.StakingAmount > p.maxStakingAmount {
+		return nil, reject(ReasonMaxStakingAmount, "staking amount %d exceeds the maximum %d",
+			req.StakingAmount, p.maxStakingAmount)
+	}
+
+	if p.allowedFps != nil {
+		for _, fpPk := range req.FpBtcPks {
+			if _, ok := p.allowedFps[pkKey(fpPk)]; !ok {
+				return nil, reject(ReasonFinalityProvider, "finality provider %x is not allowed",
+					schnorr.SerializePubKey(fpPk))
+			}
+		}
+	}
+
+	if p.minSlashingFeeRate > 0 && req.SlashingFeeRate < p.minSlashingFeeRate {
+		return nil, reject(ReasonMinSlashingFeeRate, "slashing fee rate %d sat/vbyte is lower than the minimum %d",
+			req.SlashingFeeRate, p.minSlashingFeeRate)
+	}
+
+	if len(p.blockedStakerScripts) > 0 {
+		if req.StakerPk == nil {
+			return nil, reject(ReasonInvalidRequest, "unknown staker")
+		}
+		scripts, err := stakerScripts(req.StakerPk)
+		if err != nil {
+			return nil, reject(ReasonInvalidRequest, "invalid staker key: %v", err)
+		}
+		for _, script := range scripts {
+			if addr, ok := p.blockedStakerScripts[string(script)]; ok {
+				return nil, reject(ReasonBlockedStaker, "staker address %s is blocked", addr)
+			}
+		}
+	}
+
+	return e.reserveRate()
+}
+
+// reserveRate holds a slot of the rate cap, unless the cap is reached by the
+// delegations signed within the last hour and the ones being signed
+func (e *Engine) reserveRate() (*Reservation, error) {
+	if e.policy.maxSignaturesPerHour == 0 {
+		return nil, nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	windowStart := now.Add(-rateWindow)
+	i := 0
+	for i < len(e.signedAt) && !e.signedAt[i].After(windowStart) {
+		i++
+	}
+	e.signedAt = e.signedAt[i:]
+
+	if len(e.signedAt)+e.reserved >= int(e.policy.maxSignaturesPerHour) {
+		return nil, reject(ReasonMaxSignaturesPerHour,
+			"%d delegations are already signed within the last hour and %d are being signed",
+			len(e.signedAt), e.reserved)
+	}
+	e.reserved++
+
+	return &Reservation{engine: e}, nil
+}
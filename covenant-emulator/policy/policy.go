@@ -0,0 +1,115 @@
+// Package policy implements the signing policies that a covenant member
+// enforces before co-signing the slashing and unbonding txs of a BTC delegation.
+package policy
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/spf13/viper"
+)
+
+// Policy is the declarative signing policy loaded from a policy file. A zero
+// value of a rule disables it.
+type Policy struct {
+	// MaxStakingAmount is the maximum value of the staking output in satoshi
+	MaxStakingAmount int64 `mapstructure:"max-staking-amount"`
+	// AllowedFinalityProviders are the BIP-340 hex encoded BTC public keys of
+	// the finality providers that delegations may delegate to
+	AllowedFinalityProviders []string `mapstructure:"allowed-finality-providers"`
+	// MinSlashingFeeRate is the minimum fee rate of the slashing txs in sat/vbyte
+	MinSlashingFeeRate int64 `mapstructure:"min-slashing-fee-rate"`
+	// BlockedStakerAddresses are the BTC addresses of the stakers whose
+	// delegations are not signed
+	BlockedStakerAddresses []string `mapstructure:"blocked-staker-addresses"`
+	// MaxSignaturesPerHour is the maximum number of delegations signed within
+	// any hour
+	MaxSignaturesPerHour uint32 `mapstructure:"max-signatures-per-hour"`
+}
+
+// LoadPolicy reads the policy from the given TOML file
+func LoadPolicy(path string) (*Policy, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read the policy file %s: %w", path, err)
+	}
+
+	var p Policy
+	if err := v.Unmarshal(&p); err != nil {
+		return nil, fmt.Errorf("failed to parse the policy file %s: %w", path, err)
+	}
+
+	return &p, nil
+}
+
+// parsedPolicy is the policy with its keys and addresses decoded
+type parsedPolicy struct {
+	maxStakingAmount     btcutil.Amount
+	allowedFps           map[string]struct{}
+	minSlashingFeeRate   int64
+	blockedStakerScripts map[string]string
+	maxSignaturesPerHour uint32
+}
+
+func (p *Policy) parse(btcNet *chaincfg.Params) (*parsedPolicy, error) {
+	if p.MaxStakingAmount < 0 {
+		return nil, fmt.Errorf("max staking amount must not be negative")
+	}
+
+	if p.MinSlashingFeeRate < 0 {
+		return nil, fmt.Errorf("min slashing fee rate must not be negative")
+	}
+
+	var allowedFps map[string]struct{}
+	if len(p.AllowedFinalityProviders) > 0 {
+		allowedFps = make(map[string]struct{}, len(p.AllowedFinalityProviders))
+		for _, pkHex := range p.AllowedFinalityProviders {
+			pkBytes, err := hex.DecodeString(pkHex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed finality provider %s: %w", pkHex, err)
+			}
+			pk, err := schnorr.ParsePubKey(pkBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed finality provider %s: %w", pkHex, err)
+			}
+			allowedFps[pkKey(pk)] = struct{}{}
+		}
+	}
+
+	// the addresses are matched by their pk scripts
+	blockedStakerScripts := make(map[string]string, len(p.BlockedStakerAddresses))
+	for _, addrStr := range p.BlockedStakerAddresses {
+		addr, err := btcutil.DecodeAddress(addrStr, btcNet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blocked staker address %s: %w", addrStr, err)
+		}
+		if !addr.IsForNet(btcNet) {
+			return nil, fmt.Errorf("blocked staker address %s is not for the %s network", addrStr, btcNet.Name)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blocked staker address %s: %w", addrStr, err)
+		}
+		blockedStakerScripts[string(script)] = addrStr
+	}
+
+	return &parsedPolicy{
+		maxStakingAmount:     btcutil.Amount(p.MaxStakingAmount),
+		allowedFps:           allowedFps,
+		minSlashingFeeRate:   p.MinSlashingFeeRate,
+		blockedStakerScripts: blockedStakerScripts,
+		maxSignaturesPerHour: p.MaxSignaturesPerHour,
+	}, nil
+}
+
+func pkKey(pk *btcec.PublicKey) string {
+	return string(schnorr.SerializePubKey(pk))
+}
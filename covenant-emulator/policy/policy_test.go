@@ -0,0 +1,178 @@
+package policy
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+var net = &chaincfg.SimNetParams
+
+func genPk(t *testing.T) *btcec.PublicKey {
+	sk, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return sk.PubKey()
+}
+
+// newTestRequest builds a request of a delegation staking the given amount
+// whose slashing txs pay the given fee
+func newTestRequest(t *testing.T, amount, slashingFee int64, stakerPk *btcec.PublicKey, fpPks []*btcec.PublicKey) *Request {
+	stakingTx := wire.NewMsgTx(2)
+	stakingTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	stakingTx.AddTxOut(wire.NewTxOut(amount, make([]byte, 34)))
+
+	spendTx := func(value int64) *wire.MsgTx {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+		tx.AddTxOut(wire.NewTxOut(value, make([]byte, 34)))
+		return tx
+	}
+	slashingTx := spendTx(amount - slashingFee)
+	unbondingTx := spendTx(amount - 1000)
+	slashUnbondingTx := spendTx(amount - 1000 - slashingFee)
+
+	req, err := NewRequest(stakingTx, 0, slashingTx, unbondingTx, slashUnbondingTx, stakerPk, fpPks)
+	require.NoError(t, err)
+
+	return req
+}
+
+func requireRejected(t *testing.T, err error, reason Reason) {
+	t.Helper()
+	r, ok := RejectionReason(err)
+	require.True(t, ok, "expected a policy rejection, got %v", err)
+	require.Equal(t, reason, r)
+}
+
+func TestEngineRules(t *testing.T) {
+	t.Parallel()
+
+	stakerPk := genPk(t)
+	fpPk := genPk(t)
+	otherFpPk := genPk(t)
+
+	// the BIP-86 address of the staker
+	blockedAddr, err := btcutil.NewAddressTaproot(
+		schnorr.SerializePubKey(txscript.ComputeTaprootKeyNoScript(stakerPk)), net)
+	require.NoError(t, err)
+
+	engine, err := NewEngine(&Policy{
+		MaxStakingAmount:         100_000,
+		AllowedFinalityProviders: []string{hex.EncodeToString(schnorr.SerializePubKey(fpPk))},
+		MinSlashingFeeRate:       10,
+		BlockedStakerAddresses:   []string{blockedAddr.EncodeAddress()},
+	}, net)
+	require.NoError(t, err)
+
+	// a tx with one input and one 34-byte script output is 94 vbytes without witness
+	validFee := int64(94 * 10)
+
+	_, err = engine.Evaluate(newTestRequest(t, 100_000, validFee, genPk(t), []*btcec.PublicKey{fpPk}))
+	require.NoError(t, err)
+
+	_, err = engine.Evaluate(newTestRequest(t, 100_001, validFee, genPk(t), []*btcec.PublicKey{fpPk}))
+	requireRejected(t, err, ReasonMaxStakingAmount)
+
+	_, err = engine.Evaluate(newTestRequest(t, 100_000, validFee, genPk(t), []*btcec.PublicKey{fpPk, otherFpPk}))
+	requireRejected(t, err, ReasonFinalityProvider)
+
+	_, err = engine.Evaluate(newTestRequest(t, 100_000, validFee-1, genPk(t), []*btcec.PublicKey{fpPk}))
+	requireRejected(t, err, ReasonMinSlashingFeeRate)
+
+	_, err = engine.Evaluate(newTestRequest(t, 100_000, validFee, stakerPk, []*btcec.PublicKey{fpPk}))
+	requireRejected(t, err, ReasonBlockedStaker)
+}
+
+func TestEngineRateCap(t *testing.T) {
+	t.Parallel()
+
+	engine, err := NewEngine(&Policy{MaxSignaturesPerHour: 2}, net)
+	require.NoError(t, err)
+	now := time.Now()
+	engine.now = func() time.Time { return now }
+
+	req := newTestRequest(t, 100_000, 1000, genPk(t), nil)
+
+	first, err := engine.Evaluate(req)
+	require.NoError(t, err)
+	first.Commit()
+	now = now.Add(30 * time.Minute)
+
+	// a pending signing holds its slot until it is committed or released
+	second, err := engine.Evaluate(req)
+	require.NoError(t, err)
+	_, err = engine.Evaluate(req)
+	requireRejected(t, err, ReasonMaxSignaturesPerHour)
+
+	// a failed signing frees its slot, so retrying does not consume the cap
+	second.Release()
+	second.Release()
+	second, err = engine.Evaluate(req)
+	require.NoError(t, err)
+	second.Commit()
+	second.Commit()
+	_, err = engine.Evaluate(req)
+	requireRejected(t, err, ReasonMaxSignaturesPerHour)
+
+	// the first signing leaves the window
+	now = now.Add(31 * time.Minute)
+	third, err := engine.Evaluate(req)
+	require.NoError(t, err)
+	third.Commit()
+	_, err = engine.Evaluate(req)
+	requireRejected(t, err, ReasonMaxSignaturesPerHour)
+}
+
+func TestStakerPkFromSlashingScript(t *testing.T) {
+	t.Parallel()
+
+	stakerPk := genPk(t)
+	script, err := txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(stakerPk)).
+		AddOp(txscript.OP_CHECKSIGVERIFY).
+		AddData(schnorr.SerializePubKey(genPk(t))).
+		AddOp(txscript.OP_CHECKSIGVERIFY).
+		Script()
+	require.NoError(t, err)
+
+	pk, err := StakerPkFromSlashingScript(script)
+	require.NoError(t, err)
+	require.Equal(t, schnorr.SerializePubKey(stakerPk), schnorr.SerializePubKey(pk))
+
+	_, err = StakerPkFromSlashingScript(script[:10])
+	require.Error(t, err)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "policy.toml")
+	err := os.WriteFile(path, []byte(`
+max-staking-amount = 5000
+min-slashing-fee-rate = 3
+max-signatures-per-hour = 10
+allowed-finality-providers = []
+blocked-staker-addresses = []
+`), 0o600)
+	require.NoError(t, err)
+
+	p, err := LoadPolicy(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(5000), p.MaxStakingAmount)
+	require.Equal(t, int64(3), p.MinSlashingFeeRate)
+	require.Equal(t, uint32(10), p.MaxSignaturesPerHour)
+
+	_, err = NewEngine(&Policy{BlockedStakerAddresses: []string{"not-an-address"}}, net)
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// Request is a delegation to be co-signed, as seen by the signing policy
+type Request struct {
+	StakingTxHash chainhash.Hash
+	// StakingAmount is the value of the staking output
+	StakingAmount btcutil.Amount
+	StakerPk      *btcec.PublicKey
+	FpBtcPks      []*btcec.PublicKey
+	// SlashingFeeRate is the lower fee rate of the slashing tx and the
+	// unbonding slashing tx in sat/vbyte, over their sizes without the witness
+	// as they are not signed yet
+	SlashingFeeRate int64
+}
+
+// NewRequest builds the policy request of a delegation from the txs to be
+// co-signed, which are expected to be verified against each other beforehand
+func NewRequest(
+	stakingTx *wire.MsgTx,
+	stakingOutputIdx uint32,
+	slashingTx *wire.MsgTx,
+	unbondingTx *wire.MsgTx,
+	slashUnbondingTx *wire.MsgTx,
+	stakerPk *btcec.PublicKey,
+	fpBtcPks []*btcec.PublicKey,
+) (*Request, error) {
+	if int(stakingOutputIdx) >= len(stakingTx.TxOut) {
+		return nil, fmt.Errorf("staking output index %d out of range", stakingOutputIdx)
+	}
+	if len(unbondingTx.TxOut) == 0 {
+		return nil, fmt.Errorf("unbonding tx has no outputs")
+	}
+
+	stakingAmount := btcutil.Amount(stakingTx.TxOut[stakingOutputIdx].Value)
+	slashingFeeRate := feeRate(slashingTx, stakingAmount)
+	unbondingSlashingFeeRate := feeRate(slashUnbondingTx, btcutil.Amount(unbondingTx.TxOut[0].Value))
+
+	return &Request{
+		StakingTxHash:   stakingTx.TxHash(),
+		StakingAmount:   stakingAmount,
+		StakerPk:        stakerPk,
+		FpBtcPks:        fpBtcPks,
+		SlashingFeeRate: min(slashingFeeRate, unbondingSlashingFeeRate),
+	}, nil
+}
+
+// feeRate returns the fee rate in sat/vbyte of a tx spending an output of the
+// given value
+func feeRate(tx *wire.MsgTx, inputValue btcutil.Amount) int64 {
+	fee := int64(inputValue)
+	for _, out := range tx.TxOut {
+		fee -= out.Value
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	vsize := (weight + blockchain.WitnessScaleFactor - 1) / blockchain.WitnessScaleFactor
+	if vsize == 0 {
+		return 0
+	}
+
+	return fee / vsize
+}
+
+// StakerPkFromSlashingScript returns the staker public key from the slashing
+// path script of a staking output, which starts with the staker signature
+// check `<staker_pk> OP_CHECKSIGVERIFY`
+func StakerPkFromSlashingScript(script []byte) (*btcec.PublicKey, error) {
+	if len(script) < schnorr.PubKeyBytesLen+2 ||
+		script[0] != txscript.OP_DATA_32 ||
+		script[schnorr.PubKeyBytesLen+1] != txscript.OP_CHECKSIGVERIFY {
+		return nil, fmt.Errorf("the slashing script does not start with a staker signature check")
+	}
+
+	return schnorr.ParsePubKey(script[1 : schnorr.PubKeyBytesLen+1])
+}
+
+// stakerScripts returns the pk scripts that the staker may receive BTC to with
+// their key, i.e. the BIP-86 taproot output and the P2WPKH outputs of both
+// parities of the x-only key
+func stakerScripts(stakerPk *btcec.PublicKey) ([][]byte, error) {
+	taprootKey := txscript.ComputeTaprootKeyNoScript(stakerPk)
+	p2tr, err := txscript.PayToTaprootScript(taprootKey)
+	if err != nil {
+		return nil, err
+	}
+	scripts := [][]byte{p2tr}
+
+	xOnly := schnorr.SerializePubKey(stakerPk)
+	for _, prefix := range []byte{0x02, 0x03} {
+		compressed := append([]byte{prefix}, xOnly...)
+		p2wpkh, err := txscript.NewScriptBuilder().
+			AddOp(txscript.OP_0).
+			AddData(btcutil.Hash160(compressed)).
+			Script()
+		if err != nil {
+			return nil, err
+		}
+		scripts = append(scripts, p2wpkh)
+	}
+
+	return scripts, nil
+}
@@ -2,11 +2,14 @@ package remotesigner
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/babylonlabs-io/covenant-emulator/config"
 	"github.com/babylonlabs-io/covenant-emulator/covenant"
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerapp"
 	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice"
+	"github.com/babylonlabs-io/covenant-emulator/covenant-signer/signerservice/auth"
 	"github.com/btcsuite/btcd/btcec/v2"
 )
 
@@ -35,17 +38,52 @@ func signerResponseToCovenantResponse(resp *signerapp.ParsedSigningResponse) *co
 }
 
 type RemoteSigner struct {
-	cfg *config.RemoteSignerCfg
+	cfg  *config.RemoteSignerCfg
+	opts []signerservice.ClientOption
 }
 
-func NewRemoteSigner(cfg *config.RemoteSignerCfg) RemoteSigner {
+func NewRemoteSigner(cfg *config.RemoteSignerCfg) (RemoteSigner, error) {
+	opts, err := ClientOptions(cfg)
+	if err != nil {
+		return RemoteSigner{}, err
+	}
+
 	return RemoteSigner{
-		cfg: cfg,
+		cfg:  cfg,
+		opts: opts,
+	}, nil
+}
+
+// ClientOptions returns the options authenticating the requests to the
+// remote signer of the given config
+func ClientOptions(cfg *config.RemoteSignerCfg) ([]signerservice.ClientOption, error) {
+	var opts []signerservice.ClientOption
+
+	if !strings.HasPrefix(cfg.URL, "https://") {
+		if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" || cfg.TLSCAFile != "" || len(cfg.PinnedServerCerts) > 0 {
+			return nil, fmt.Errorf("the TLS options of the remote signer require an https URL, got %s", cfg.URL)
+		}
+	} else {
+		tlsConfig, err := auth.ClientTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSCAFile, cfg.PinnedServerCerts)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, signerservice.WithTLSConfig(tlsConfig))
+	}
+
+	if cfg.HMACKeyFile != "" {
+		key, err := auth.LoadHMACKey(cfg.HMACKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, signerservice.WithHMACKey(key))
 	}
+
+	return opts, nil
 }
 
 func (rs RemoteSigner) PubKey() (*btcec.PublicKey, error) {
-	return signerservice.GetPublicKey(context.Background(), rs.cfg.URL, rs.cfg.Timeout)
+	return signerservice.GetPublicKey(context.Background(), rs.cfg.URL, rs.cfg.Timeout, rs.opts...)
 }
 
 func (rs RemoteSigner) SignTransactions(req covenant.SigningRequest) (*covenant.SignaturesResponse, error) {
@@ -54,6 +92,7 @@ func (rs RemoteSigner) SignTransactions(req covenant.SigningRequest) (*covenant.
 		rs.cfg.URL,
 		rs.cfg.Timeout,
 		covenantRequestToSignerRequest(req),
+		rs.opts...,
 	)
 
 	if err != nil {
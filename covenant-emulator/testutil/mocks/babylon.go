@@ -9,6 +9,7 @@ import (
 
 	clientcontroller "github.com/babylonlabs-io/covenant-emulator/clientcontroller"
 	types "github.com/babylonlabs-io/covenant-emulator/types"
+	chainhash "github.com/btcsuite/btcd/chaincfg/chainhash"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -49,6 +50,21 @@ func (mr *MockClientControllerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClientController)(nil).Close))
 }
 
+// QueryPendingDelegation mocks base method.
+func (m *MockClientController) QueryPendingDelegation(stakingTxHash chainhash.Hash) (*types.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryPendingDelegation", stakingTxHash)
+	ret0, _ := ret[0].(*types.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryPendingDelegation indicates an expected call of QueryPendingDelegation.
+func (mr *MockClientControllerMockRecorder) QueryPendingDelegation(stakingTxHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryPendingDelegation", reflect.TypeOf((*MockClientController)(nil).QueryPendingDelegation), stakingTxHash)
+}
+
 // QueryPendingDelegations mocks base method.
 func (m *MockClientController) QueryPendingDelegations(limit uint64, filter clientcontroller.FilterFn) ([]*types.Delegation, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +95,21 @@ func (mr *MockClientControllerMockRecorder) QueryStakingParamsByVersion(version
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStakingParamsByVersion", reflect.TypeOf((*MockClientController)(nil).QueryStakingParamsByVersion), version)
 }
 
+// SubscribeDelegationEvents mocks base method.
+func (m *MockClientController) SubscribeDelegationEvents() (<-chan *types.DelegationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeDelegationEvents")
+	ret0, _ := ret[0].(<-chan *types.DelegationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeDelegationEvents indicates an expected call of SubscribeDelegationEvents.
+func (mr *MockClientControllerMockRecorder) SubscribeDelegationEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeDelegationEvents", reflect.TypeOf((*MockClientController)(nil).SubscribeDelegationEvents))
+}
+
 // SubmitCovenantSigs mocks base method.
 func (m *MockClientController) SubmitCovenantSigs(covSigMsgs []*types.CovenantSigs) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,21 @@
+package types
+
+import "github.com/btcsuite/btcd/chaincfg/chainhash"
+
+type DelegationEventType int
+
+const (
+	// DelegationCreated is emitted when a BTC delegation is created on Babylon
+	DelegationCreated DelegationEventType = iota
+	// DelegationUnbonded is emitted when a BTC delegation is unbonded early
+	// or expires, after which it no longer needs covenant signatures
+	DelegationUnbonded
+)
+
+// DelegationEvent is an event of a BTC delegation received from the
+// subscription to Babylon
+type DelegationEvent struct {
+	Type DelegationEventType
+	// StakingTxHash identifies the BTC delegation
+	StakingTxHash chainhash.Hash
+}
@@ -16,6 +16,7 @@ import (
 	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	btcstakingtypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
 	finalitytypes "github.com/babylonlabs-io/babylon/x/finality/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -336,6 +337,16 @@ func (bc *BabylonController) QueryLastCommittedPublicRand(fpPk *btcec.PublicKey,
 	return res.PubRandCommitMap, nil
 }
 
+// QueryLastFinalizedEpoch returns the last epoch whose checkpoint is finalized on BTC
+func (bc *BabylonController) QueryLastFinalizedEpoch() (uint64, error) {
+	res, err := bc.bbnClient.QueryClient.LatestEpochFromStatus(ckpttypes.Finalized)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query the last finalized epoch: %w", err)
+	}
+
+	return res.RawCheckpoint.EpochNum, nil
+}
+
 func (bc *BabylonController) QueryBlocks(startHeight, endHeight uint64, limit uint32) ([]*types.BlockInfo, error) {
 	if endHeight < startHeight {
 		return nil, fmt.Errorf("the startHeight %v should not be higher than the endHeight %v", startHeight, endHeight)
@@ -363,8 +374,9 @@ func (bc *BabylonController) queryLatestBlocks(startKey []byte, count uint64, st
 
 	for _, b := range res.Blocks {
 		ib := &types.BlockInfo{
-			Height: b.Height,
-			Hash:   b.AppHash,
+			Height:    b.Height,
+			Hash:      b.AppHash,
+			Finalized: b.Finalized,
 		}
 		blocks = append(blocks, ib)
 	}
@@ -409,6 +421,20 @@ func (bc *BabylonController) QueryFinalityActivationBlockHeight() (uint64, error
 	return res.Params.FinalityActivationHeight, nil
 }
 
+func (bc *BabylonController) QueryJailingParams() (int64, float64, error) {
+	res, err := bc.bbnClient.QueryClient.FinalityParams()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to query finality params to get the jailing params: %w", err)
+	}
+
+	minSignedPerWindow, err := res.Params.MinSignedPerWindow.Float64()
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid min signed per window %s: %w", res.Params.MinSignedPerWindow, err)
+	}
+
+	return res.Params.SignedBlocksWindow, minSignedPerWindow, nil
+}
+
 func (bc *BabylonController) QueryBestBlock() (*types.BlockInfo, error) {
 	blocks, err := bc.queryLatestBlocks(nil, 1, finalitytypes.QueriedBlockStatus_ANY, true)
 	if err != nil || len(blocks) != 1 {
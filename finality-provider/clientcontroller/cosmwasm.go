@@ -0,0 +1,519 @@
+package clientcontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/babylonlabs-io/babylon/client/babylonclient"
+	bbnclient "github.com/babylonlabs-io/babylon/client/client"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	btcstakingtypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	finalitytypes "github.com/babylonlabs-io/babylon/x/finality/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+var _ ClientController = &CosmwasmController{}
+
+// CosmwasmController is the client controller of a CosmWasm-based consumer chain.
+// It submits finality signatures and public randomness commits to the finality
+// contract of the consumer chain, and queries the finality contract for the
+// state of the finality providers and the indexed blocks. The finality providers
+// are registered on Babylon, so the registration is not supported.
+type CosmwasmController struct {
+	cwClient *bbnclient.Client
+	cfg      *fpcfg.CosmwasmConfig
+	bbnCfg   *fpcfg.BBNConfig
+	logger   *zap.Logger
+}
+
+func NewCosmwasmController(
+	cfg *fpcfg.CosmwasmConfig,
+	bbnCfg *fpcfg.BBNConfig,
+	logger *zap.Logger,
+) (*CosmwasmController, error) {
+	cosmosCfg := fpcfg.CosmwasmConfigToBabylonConfig(cfg, bbnCfg)
+	cwClient, err := bbnclient.New(
+		&cosmosCfg,
+		logger,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the client of consumer chain %s: %w", cfg.ChainID, err)
+	}
+
+	return &CosmwasmController{
+		cwClient: cwClient,
+		cfg:      cfg,
+		bbnCfg:   bbnCfg,
+		logger:   logger.With(zap.String("consumer_chain_id", cfg.ChainID)),
+	}, nil
+}
+
+func (wc *CosmwasmController) Start() error {
+	// makes sure that the key in config really exists and is a valid bech32 addr
+	// to allow using mustGetTxSigner
+	if _, err := wc.cwClient.GetAddr(); err != nil {
+		return fmt.Errorf("failed to get addr: %w", err)
+	}
+
+	return nil
+}
+
+func (wc *CosmwasmController) mustGetTxSigner() string {
+	keyName := wc.cfg.Key
+	if keyName == "" {
+		keyName = wc.bbnCfg.Key
+	}
+
+	keyRec, err := wc.cwClient.GetKeyring().Key(keyName)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to get key address: %s", err))
+	}
+
+	addr, err := keyRec.GetAddress()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to get key address: %s", err))
+	}
+
+	return sdk.MustBech32ifyAddressBytes(wc.cfg.AccountPrefix, addr)
+}
+
+// executeContract sends the given execute messages of the finality contract in a tx
+func (wc *CosmwasmController) executeContract(execMsgs ...any) (*babylonclient.RelayerTxResponse, error) {
+	signer := wc.mustGetTxSigner()
+	msgs := make([]sdk.Msg, 0, len(execMsgs))
+	for _, execMsg := range execMsgs {
+		msg, err := newExecuteContractMsg(signer, wc.cfg.FinalityContractAddress, execMsg)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return wc.cwClient.ReliablySendMsgs(
+		context.Background(),
+		msgs,
+		emptyErrs,
+		emptyErrs,
+	)
+}
+
+// querySmartContract queries the finality contract with the given query message,
+// and decodes the JSON response into res
+func (wc *CosmwasmController) querySmartContract(queryMsg any, res any) error {
+	queryBz, err := json.Marshal(queryMsg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the contract query: %w", err)
+	}
+
+	ctx, cancel := getContextWithCancel(wc.bbnCfg.Timeout)
+	defer cancel()
+
+	clientCtx := client.Context{Client: wc.cwClient.RPCClient}
+	queryClient := wasmtypes.NewQueryClient(clientCtx)
+	resp, err := queryClient.SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+		Address:   wc.cfg.FinalityContractAddress,
+		QueryData: queryBz,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Data, res); err != nil {
+		return fmt.Errorf("failed to unmarshal the contract response: %w", err)
+	}
+
+	return nil
+}
+
+// RegisterFinalityProvider is not supported, as the finality providers of the
+// consumer chains are registered on Babylon
+func (wc *CosmwasmController) RegisterFinalityProvider(
+	_ *btcec.PublicKey,
+	_ []byte,
+	_ *sdkmath.LegacyDec,
+	_ []byte,
+) (*types.TxResponse, error) {
+	return nil, fmt.Errorf("registering finality providers on consumer chain %s is not supported, register it on Babylon instead", wc.cfg.ChainID)
+}
+
+// EditFinalityProvider is not supported, as the finality providers of the
+// consumer chains are registered on Babylon
+func (wc *CosmwasmController) EditFinalityProvider(_ *btcec.PublicKey, _ *sdkmath.LegacyDec, _ []byte) (*btcstakingtypes.MsgEditFinalityProvider, error) {
+	return nil, fmt.Errorf("editing finality providers on consumer chain %s is not supported, edit it on Babylon instead", wc.cfg.ChainID)
+}
+
+// CommitPubRandList commits a list of Schnorr public randomness to the finality contract
+// it returns tx hash and error
+func (wc *CosmwasmController) CommitPubRandList(
+	fpPk *btcec.PublicKey,
+	startHeight uint64,
+	numPubRand uint64,
+	commitment []byte,
+	sig *schnorr.Signature,
+) (*types.TxResponse, error) {
+	msg := newCommitPubRandMsg(fpPk, startHeight, numPubRand, commitment, sig)
+
+	res, err := wc.executeContract(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TxResponse{TxHash: res.TxHash, Events: res.Events}, nil
+}
+
+// SubmitFinalitySig submits the finality signature to the finality contract
+func (wc *CosmwasmController) SubmitFinalitySig(
+	fpPk *btcec.PublicKey,
+	block *types.BlockInfo,
+	pubRand *btcec.FieldVal,
+	proof []byte,
+	sig *btcec.ModNScalar,
+) (*types.TxResponse, error) {
+	return wc.SubmitBatchFinalitySigs(
+		fpPk, []*types.BlockInfo{block}, []*btcec.FieldVal{pubRand},
+		[][]byte{proof}, []*btcec.ModNScalar{sig},
+	)
+}
+
+// SubmitBatchFinalitySigs submits a batch of finality signatures to the finality contract
+func (wc *CosmwasmController) SubmitBatchFinalitySigs(
+	fpPk *btcec.PublicKey,
+	blocks []*types.BlockInfo,
+	pubRandList []*btcec.FieldVal,
+	proofList [][]byte,
+	sigs []*btcec.ModNScalar,
+) (*types.TxResponse, error) {
+	if len(blocks) != len(sigs) {
+		return nil, fmt.Errorf("the number of blocks %v should match the number of finality signatures %v", len(blocks), len(sigs))
+	}
+
+	msgs := make([]any, 0, len(blocks))
+	for i, b := range blocks {
+		msg, err := newSubmitFinalitySigMsg(fpPk, b, pubRandList[i], proofList[i], sigs[i])
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	res, err := wc.executeContract(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return &types.TxResponse{}, nil
+	}
+
+	return &types.TxResponse{TxHash: res.TxHash, Events: res.Events}, nil
+}
+
+// UnjailFinalityProvider sends an unjail message to the finality contract
+func (wc *CosmwasmController) UnjailFinalityProvider(fpPk *btcec.PublicKey) (*types.TxResponse, error) {
+	msg := contractExecuteMsg{
+		Unjail: &unjailMsg{FpPubkeyHex: bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()},
+	}
+
+	res, err := wc.executeContract(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TxResponse{TxHash: res.TxHash, Events: res.Events}, nil
+}
+
+// QueryFinalityProvider is not supported, as the finality providers of the
+// consumer chains are registered on Babylon
+func (wc *CosmwasmController) QueryFinalityProvider(_ *btcec.PublicKey) (*btcstakingtypes.QueryFinalityProviderResponse, error) {
+	return nil, fmt.Errorf("querying finality providers on consumer chain %s is not supported, query Babylon instead", wc.cfg.ChainID)
+}
+
+func (wc *CosmwasmController) queryFinalityProviderInfo(fpPk *btcec.PublicKey) (*finalityProviderInfoResponse, error) {
+	fpPkHex := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()
+	query := contractQueryMsg{
+		FinalityProvider: &finalityProviderQuery{BtcPkHex: fpPkHex},
+	}
+
+	var res finalityProviderInfoResponse
+	if err := wc.querySmartContract(query, &res); err != nil {
+		return nil, fmt.Errorf("failed to query the finality provider %s: %w", fpPkHex, err)
+	}
+
+	return &res, nil
+}
+
+// QueryFinalityProviderSlashedOrJailed - returns if the fp has been slashed, jailed, err
+func (wc *CosmwasmController) QueryFinalityProviderSlashedOrJailed(fpPk *btcec.PublicKey) (bool, bool, error) {
+	res, err := wc.queryFinalityProviderInfo(fpPk)
+	if err != nil {
+		return false, false, err
+	}
+
+	return res.SlashedHeight > 0, res.Jailed, nil
+}
+
+// QueryFinalityProviderVotingPower queries the voting power of the finality provider at a given height
+func (wc *CosmwasmController) QueryFinalityProviderVotingPower(fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error) {
+	query := contractQueryMsg{
+		FinalityProviderPower: &finalityProviderPowerQuery{
+			BtcPkHex: bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
+			Height:   blockHeight,
+		},
+	}
+
+	var res finalityProviderPowerResponse
+	if err := wc.querySmartContract(query, &res); err != nil {
+		return 0, fmt.Errorf("failed to query the voting power at height %d: %w", blockHeight, err)
+	}
+
+	return res.Power, nil
+}
+
+// QueryFinalityProviderHighestVotedHeight queries the highest voted height of the given finality provider
+func (wc *CosmwasmController) QueryFinalityProviderHighestVotedHeight(fpPk *btcec.PublicKey) (uint64, error) {
+	res, err := wc.queryFinalityProviderInfo(fpPk)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.HighestVotedHeight, nil
+}
+
+func (wc *CosmwasmController) QueryLatestFinalizedBlocks(count uint64) ([]*types.BlockInfo, error) {
+	finalized := true
+
+	return wc.queryLatestBlocks(nil, count, &finalized, true)
+}
+
+// QueryLastCommittedPublicRand returns the last public randomness commitments
+func (wc *CosmwasmController) QueryLastCommittedPublicRand(fpPk *btcec.PublicKey, count uint64) (map[uint64]*finalitytypes.PubRandCommitResponse, error) {
+	query := contractQueryMsg{
+		LastPubRandCommit: &lastPubRandCommitQuery{
+			BtcPkHex: bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
+			Limit:    count,
+		},
+	}
+
+	var res []*pubRandCommitResponse
+	if err := wc.querySmartContract(query, &res); err != nil {
+		return nil, fmt.Errorf("failed to query committed public randomness: %w", err)
+	}
+
+	commitMap := make(map[uint64]*finalitytypes.PubRandCommitResponse, len(res))
+	for _, c := range res {
+		commitMap[c.StartHeight] = &finalitytypes.PubRandCommitResponse{
+			NumPubRand: c.NumPubRand,
+			Commitment: c.Commitment,
+		}
+	}
+
+	return commitMap, nil
+}
+
+// QueryLastFinalizedEpoch returns zero as the finality contract does not keep
+// the epochs of the commits, i.e., the public randomness committed to it can
+// be used right away
+func (wc *CosmwasmController) QueryLastFinalizedEpoch() (uint64, error) {
+	return 0, nil
+}
+
+func (wc *CosmwasmController) QueryBlocks(startHeight, endHeight uint64, limit uint32) ([]*types.BlockInfo, error) {
+	if endHeight < startHeight {
+		return nil, fmt.Errorf("the startHeight %v should not be higher than the endHeight %v", startHeight, endHeight)
+	}
+	count := endHeight - startHeight + 1
+	if count > uint64(limit) {
+		count = uint64(limit)
+	}
+
+	// the blocks query of the contract starts after the given height
+	var startAfter *uint64
+	if startHeight > 0 {
+		h := startHeight - 1
+		startAfter = &h
+	}
+
+	return wc.queryLatestBlocks(startAfter, count, nil, false)
+}
+
+func (wc *CosmwasmController) queryLatestBlocks(startAfter *uint64, count uint64, finalized *bool, reverse bool) ([]*types.BlockInfo, error) {
+	query := contractQueryMsg{
+		Blocks: &blocksQuery{
+			StartAfter: startAfter,
+			Limit:      &count,
+			Finalized:  finalized,
+			Reverse:    &reverse,
+		},
+	}
+
+	var res blocksResponse
+	if err := wc.querySmartContract(query, &res); err != nil {
+		return nil, fmt.Errorf("failed to query indexed blocks: %w", err)
+	}
+
+	blocks := make([]*types.BlockInfo, 0, len(res.Blocks))
+	for _, b := range res.Blocks {
+		blocks = append(blocks, b.toBlockInfo())
+	}
+
+	return blocks, nil
+}
+
+func (wc *CosmwasmController) QueryBlock(height uint64) (*types.BlockInfo, error) {
+	query := contractQueryMsg{
+		Block: &blockQuery{Height: height},
+	}
+
+	var res indexedBlockResponse
+	if err := wc.querySmartContract(query, &res); err != nil {
+		return nil, fmt.Errorf("failed to query indexed block at height %v: %w", height, err)
+	}
+
+	return res.toBlockInfo(), nil
+}
+
+func (wc *CosmwasmController) QueryActivatedHeight() (uint64, error) {
+	query := contractQueryMsg{
+		ActivatedHeight: &struct{}{},
+	}
+
+	var res activatedHeightResponse
+	if err := wc.querySmartContract(query, &res); err != nil {
+		return 0, fmt.Errorf("failed to query activated height: %w", err)
+	}
+
+	if res.Height == 0 {
+		return 0, fmt.Errorf("consumer chain %s has not been activated yet", wc.cfg.ChainID)
+	}
+
+	return res.Height, nil
+}
+
+// QueryJailingParams is not supported, as the finality contract does not jail
+// finality providers for missing blocks
+func (wc *CosmwasmController) QueryJailingParams() (int64, float64, error) {
+	return 0, 0, fmt.Errorf("querying the jailing params on consumer chain %s is not supported, query Babylon instead", wc.cfg.ChainID)
+}
+
+func (wc *CosmwasmController) QueryFinalityActivationBlockHeight() (uint64, error) {
+	query := contractQueryMsg{
+		FinalityActivationHeight: &struct{}{},
+	}
+
+	var res activatedHeightResponse
+	if err := wc.querySmartContract(query, &res); err != nil {
+		return 0, fmt.Errorf("failed to query finality activation block height: %w", err)
+	}
+
+	return res.Height, nil
+}
+
+func (wc *CosmwasmController) QueryBestBlock() (*types.BlockInfo, error) {
+	blocks, err := wc.queryLatestBlocks(nil, 1, nil, true)
+	if err != nil || len(blocks) != 1 {
+		// try query comet block if the index block query is not available
+		return wc.queryCometBestBlock()
+	}
+
+	return blocks[0], nil
+}
+
+func (wc *CosmwasmController) queryCometBestBlock() (*types.BlockInfo, error) {
+	ctx, cancel := getContextWithCancel(wc.bbnCfg.Timeout)
+	// this will return 20 items at max in the descending order (highest first)
+	chainInfo, err := wc.cwClient.RPCClient.BlockchainInfo(ctx, 0, 0)
+	defer cancel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	headerHeightInt64 := chainInfo.BlockMetas[0].Header.Height
+	if headerHeightInt64 < 0 {
+		return nil, fmt.Errorf("block height %v should be positive", headerHeightInt64)
+	}
+
+	return &types.BlockInfo{
+		Height: uint64(headerHeightInt64),
+		Hash:   chainInfo.BlockMetas[0].Header.AppHash,
+	}, nil
+}
+
+func (wc *CosmwasmController) Close() error {
+	if !wc.cwClient.IsRunning() {
+		return nil
+	}
+
+	return wc.cwClient.Stop()
+}
+
+func newExecuteContractMsg(sender, contract string, execMsg any) (*wasmtypes.MsgExecuteContract, error) {
+	msgBz, err := json.Marshal(execMsg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the contract message: %w", err)
+	}
+
+	return &wasmtypes.MsgExecuteContract{
+		Sender:   sender,
+		Contract: contract,
+		Msg:      msgBz,
+	}, nil
+}
+
+func newCommitPubRandMsg(
+	fpPk *btcec.PublicKey,
+	startHeight uint64,
+	numPubRand uint64,
+	commitment []byte,
+	sig *schnorr.Signature,
+) contractExecuteMsg {
+	return contractExecuteMsg{
+		CommitPublicRandomness: &commitPublicRandomnessMsg{
+			FpPubkeyHex: bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
+			StartHeight: startHeight,
+			NumPubRand:  numPubRand,
+			Commitment:  commitment,
+			Signature:   sig.Serialize(),
+		},
+	}
+}
+
+func newSubmitFinalitySigMsg(
+	fpPk *btcec.PublicKey,
+	block *types.BlockInfo,
+	pubRand *btcec.FieldVal,
+	proofBz []byte,
+	sig *btcec.ModNScalar,
+) (contractExecuteMsg, error) {
+	cmtProof := cmtcrypto.Proof{}
+	if err := cmtProof.Unmarshal(proofBz); err != nil {
+		return contractExecuteMsg{}, err
+	}
+
+	return contractExecuteMsg{
+		SubmitFinalitySignature: &submitFinalitySignatureMsg{
+			FpPubkeyHex: bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
+			Height:      block.Height,
+			PubRand:     *bbntypes.NewSchnorrPubRandFromFieldVal(pubRand),
+			Proof: proof{
+				Total:    cmtProof.Total,
+				Index:    cmtProof.Index,
+				LeafHash: cmtProof.LeafHash,
+				Aunts:    cmtProof.Aunts,
+			},
+			BlockHash: block.Hash,
+			Signature: *bbntypes.NewSchnorrEOTSSigFromModNScalar(sig),
+		},
+	}, nil
+}
@@ -0,0 +1,116 @@
+package clientcontroller
+
+import (
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// The messages of the finality contract of the CosmWasm-based consumer chains,
+// as specified in docs/consumer-chains.md. Byte fields are base64 encoded.
+
+type contractExecuteMsg struct {
+	CommitPublicRandomness  *commitPublicRandomnessMsg  `json:"commit_public_randomness,omitempty"`
+	SubmitFinalitySignature *submitFinalitySignatureMsg `json:"submit_finality_signature,omitempty"`
+	Unjail                  *unjailMsg                  `json:"unjail,omitempty"`
+}
+
+type commitPublicRandomnessMsg struct {
+	FpPubkeyHex string `json:"fp_pubkey_hex"`
+	StartHeight uint64 `json:"start_height"`
+	NumPubRand  uint64 `json:"num_pub_rand"`
+	Commitment  []byte `json:"commitment"`
+	Signature   []byte `json:"signature"`
+}
+
+type submitFinalitySignatureMsg struct {
+	FpPubkeyHex string `json:"fp_pubkey_hex"`
+	Height      uint64 `json:"height"`
+	PubRand     []byte `json:"pub_rand"`
+	Proof       proof  `json:"proof"`
+	BlockHash   []byte `json:"block_hash"`
+	Signature   []byte `json:"signature"`
+}
+
+// proof is the Merkle proof of the public randomness against its commitment
+type proof struct {
+	Total    int64    `json:"total"`
+	Index    int64    `json:"index"`
+	LeafHash []byte   `json:"leaf_hash"`
+	Aunts    [][]byte `json:"aunts"`
+}
+
+type unjailMsg struct {
+	FpPubkeyHex string `json:"fp_pubkey_hex"`
+}
+
+type contractQueryMsg struct {
+	FinalityProvider         *finalityProviderQuery      `json:"finality_provider,omitempty"`
+	FinalityProviderPower    *finalityProviderPowerQuery `json:"finality_provider_power,omitempty"`
+	LastPubRandCommit        *lastPubRandCommitQuery     `json:"last_pub_rand_commit,omitempty"`
+	Block                    *blockQuery                 `json:"block,omitempty"`
+	Blocks                   *blocksQuery                `json:"blocks,omitempty"`
+	ActivatedHeight          *struct{}                   `json:"activated_height,omitempty"`
+	FinalityActivationHeight *struct{}                   `json:"finality_activation_height,omitempty"`
+}
+
+type finalityProviderQuery struct {
+	BtcPkHex string `json:"btc_pk_hex"`
+}
+
+type finalityProviderPowerQuery struct {
+	BtcPkHex string `json:"btc_pk_hex"`
+	Height   uint64 `json:"height"`
+}
+
+type lastPubRandCommitQuery struct {
+	BtcPkHex string `json:"btc_pk_hex"`
+	Limit    uint64 `json:"limit"`
+}
+
+type blockQuery struct {
+	Height uint64 `json:"height"`
+}
+
+type blocksQuery struct {
+	StartAfter *uint64 `json:"start_after,omitempty"`
+	Limit      *uint64 `json:"limit,omitempty"`
+	Finalized  *bool   `json:"finalized,omitempty"`
+	Reverse    *bool   `json:"reverse,omitempty"`
+}
+
+type finalityProviderInfoResponse struct {
+	SlashedHeight      uint64 `json:"slashed_height"`
+	Jailed             bool   `json:"jailed"`
+	HighestVotedHeight uint64 `json:"highest_voted_height"`
+}
+
+type finalityProviderPowerResponse struct {
+	Power uint64 `json:"power"`
+}
+
+type pubRandCommitResponse struct {
+	StartHeight uint64 `json:"start_height"`
+	NumPubRand  uint64 `json:"num_pub_rand"`
+	Commitment  []byte `json:"commitment"`
+}
+
+type indexedBlockResponse struct {
+	Height    uint64 `json:"height"`
+	AppHash   []byte `json:"app_hash"`
+	Finalized bool   `json:"finalized"`
+}
+
+func (b *indexedBlockResponse) toBlockInfo() *types.BlockInfo {
+	return &types.BlockInfo{
+		Height:    b.Height,
+		Hash:      b.AppHash,
+		Finalized: b.Finalized,
+	}
+}
+
+type blocksResponse struct {
+	Blocks []*indexedBlockResponse `json:"blocks"`
+}
+
+type activatedHeightResponse struct {
+	Height uint64 `json:"height"`
+}
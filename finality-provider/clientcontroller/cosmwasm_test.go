@@ -0,0 +1,86 @@
+package clientcontroller
+
+import (
+	"encoding/json"
+	"testing"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+func TestCosmwasmContractMsgs(t *testing.T) {
+	t.Parallel()
+	sk, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	fpPk := sk.PubKey()
+	fpPkHex := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()
+
+	t.Run("commit public randomness", func(t *testing.T) {
+		t.Parallel()
+		sig, err := schnorr.Sign(sk, make([]byte, 32))
+		require.NoError(t, err)
+
+		msg := newCommitPubRandMsg(fpPk, 100, 10, []byte{1, 2}, sig)
+		execMsg, err := newExecuteContractMsg("sender", "contract", msg)
+		require.NoError(t, err)
+		require.Equal(t, "sender", execMsg.Sender)
+		require.Equal(t, "contract", execMsg.Contract)
+
+		var decoded map[string]map[string]any
+		require.NoError(t, json.Unmarshal(execMsg.Msg, &decoded))
+		require.Len(t, decoded, 1)
+		commit := decoded["commit_public_randomness"]
+		require.Equal(t, fpPkHex, commit["fp_pubkey_hex"])
+		require.EqualValues(t, 100, commit["start_height"])
+		require.EqualValues(t, 10, commit["num_pub_rand"])
+		require.Equal(t, "AQI=", commit["commitment"])
+	})
+
+	t.Run("submit finality signature", func(t *testing.T) {
+		t.Parallel()
+		proof := cmtcrypto.Proof{Total: 10, Index: 3, LeafHash: []byte{3}, Aunts: [][]byte{{4}, {5}}}
+		proofBz, err := proof.Marshal()
+		require.NoError(t, err)
+
+		var pubRand btcec.FieldVal
+		pubRand.SetInt(7)
+		var sig btcec.ModNScalar
+		sig.SetInt(9)
+
+		block := &types.BlockInfo{Height: 101, Hash: []byte{6}}
+		msg, err := newSubmitFinalitySigMsg(fpPk, block, &pubRand, proofBz, &sig)
+		require.NoError(t, err)
+
+		bz, err := json.Marshal(msg)
+		require.NoError(t, err)
+
+		var decoded struct {
+			SubmitFinalitySignature *submitFinalitySignatureMsg `json:"submit_finality_signature"`
+			Unjail                  *unjailMsg                  `json:"unjail"`
+		}
+		require.NoError(t, json.Unmarshal(bz, &decoded))
+		require.Nil(t, decoded.Unjail)
+		submit := decoded.SubmitFinalitySignature
+		require.Equal(t, fpPkHex, submit.FpPubkeyHex)
+		require.Equal(t, block.Height, submit.Height)
+		require.Equal(t, block.Hash, submit.BlockHash)
+		require.Equal(t, proof.Total, submit.Proof.Total)
+		require.Equal(t, proof.Index, submit.Proof.Index)
+		require.Equal(t, proof.Aunts, submit.Proof.Aunts)
+		require.Len(t, submit.PubRand, 32)
+		require.Len(t, submit.Signature, 32)
+	})
+
+	t.Run("invalid proof", func(t *testing.T) {
+		t.Parallel()
+		var pubRand btcec.FieldVal
+		var sig btcec.ModNScalar
+		_, err := newSubmitFinalitySigMsg(fpPk, &types.BlockInfo{}, &pubRand, []byte{0xff}, &sig)
+		require.Error(t, err)
+	})
+}
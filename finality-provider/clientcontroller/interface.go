@@ -71,6 +71,11 @@ type ClientController interface {
 	// QueryLastCommittedPublicRand returns the last committed public randomness
 	QueryLastCommittedPublicRand(fpPk *btcec.PublicKey, count uint64) (map[uint64]*finalitytypes.PubRandCommitResponse, error)
 
+	// QueryLastFinalizedEpoch returns the last epoch that is finalized by BTC
+	// timestamping. The public randomness committed in the epochs up to it is
+	// BTC-timestamped and can be used for voting
+	QueryLastFinalizedEpoch() (uint64, error)
+
 	// QueryBlock queries the block at the given height
 	QueryBlock(height uint64) (*types.BlockInfo, error)
 
@@ -91,6 +96,11 @@ type ClientController interface {
 	// the value zero should be returned.
 	QueryFinalityActivationBlockHeight() (uint64, error)
 
+	// QueryJailingParams returns the number of blocks of the rolling window the
+	// missed blocks are counted over, and the fraction of them a finality provider
+	// has to vote for to not be jailed
+	QueryJailingParams() (signedBlocksWindow int64, minSignedPerWindow float64, err error)
+
 	Close() error
 }
 
@@ -66,3 +66,25 @@ func Expected(err error) error {
 func IsExpected(err error) bool {
 	return errors.Is(err, ExpectedError{})
 }
+
+// these errors indicate that a transaction exceeds the gas or size limits of the
+// chain, so its messages should be split into smaller transactions
+var batchTooLargeErrors = []string{
+	"out of gas",
+	"exceeds block max gas",
+	"exceeds max gas",
+	"tx too large",
+	"Tx too large",
+}
+
+// IsBatchTooLarge returns true when the error indicates that the transaction
+// exceeds the gas or size limits of the chain
+func IsBatchTooLarge(err error) bool {
+	for _, e := range batchTooLargeErrors {
+		if strings.Contains(err.Error(), e) {
+			return true
+		}
+	}
+
+	return false
+}
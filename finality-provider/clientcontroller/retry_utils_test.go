@@ -14,3 +14,11 @@ func TestExpectedErr(t *testing.T) {
 	wrappedErr := fmt.Errorf("expected: %w", expectedErr)
 	require.True(t, IsExpected(wrappedErr))
 }
+
+func TestIsBatchTooLarge(t *testing.T) {
+	t.Parallel()
+	require.True(t, IsBatchTooLarge(fmt.Errorf("failed to send: tx gas limit 120000000 exceeds block max gas 100000000")))
+	require.True(t, IsBatchTooLarge(fmt.Errorf("out of gas in location: ReadFlat; gasWanted: 200000, gasUsed: 200736")))
+	require.True(t, IsBatchTooLarge(fmt.Errorf("Tx too large. Max size is 1048576, but got 1100000")))
+	require.False(t, IsBatchTooLarge(fmt.Errorf("account sequence mismatch")))
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 )
 
-var _ eotsmanager.EOTSManager = &EOTSManagerGRpcClient{}
+var (
+	_ eotsmanager.EOTSManager          = &EOTSManagerGRpcClient{}
+	_ eotsmanager.ThresholdParticipant = &EOTSManagerGRpcClient{}
+)
 
 type EOTSManagerGRpcClient struct {
 	client proto.EOTSManagerClient
@@ -162,6 +165,96 @@ func (c *EOTSManagerGRpcClient) SignSchnorrSig(uid, msg []byte, passphrase strin
 	return sig, nil
 }
 
+func (c *EOTSManagerGRpcClient) PubRandShareList(uid, chainID []byte, startHeight uint64, num uint32, passphrase string) (*types.PubRandShareList, error) {
+	req := &proto.CreateRandomnessPairListRequest{
+		Uid:         uid,
+		ChainId:     chainID,
+		StartHeight: startHeight,
+		Num:         num,
+		Passphrase:  passphrase,
+	}
+	res, err := c.client.PubRandShareList(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	pubRandShares := make([]*btcec.PublicKey, 0, len(res.PubRandShareList))
+	for _, r := range res.PubRandShareList {
+		pubRandShare, err := btcec.ParsePubKey(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public randomness share: %w", err)
+		}
+		pubRandShares = append(pubRandShares, pubRandShare)
+	}
+
+	return &types.PubRandShareList{
+		Index:         res.Index,
+		Threshold:     res.Threshold,
+		PubRandShares: pubRandShares,
+	}, nil
+}
+
+func (c *EOTSManagerGRpcClient) SignEOTSShare(uid, chainID, msg []byte, height uint64, pubRand *btcec.PublicKey, passphrase string) (*btcec.ModNScalar, error) {
+	req := &proto.SignEOTSShareRequest{
+		Uid:        uid,
+		ChainId:    chainID,
+		Msg:        msg,
+		Height:     height,
+		PubRand:    pubRand.SerializeCompressed(),
+		Passphrase: passphrase,
+	}
+	res, err := c.client.SignEOTSShare(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var s btcec.ModNScalar
+	s.SetByteSlice(res.Sig)
+
+	return &s, nil
+}
+
+func (c *EOTSManagerGRpcClient) SchnorrNonceShare(uid, msg []byte, passphrase string) (*types.PubRandShareList, error) {
+	req := &proto.SignSchnorrSigRequest{Uid: uid, Msg: msg, Passphrase: passphrase}
+	res, err := c.client.SchnorrNonceShare(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	pubNonceShares := make([]*btcec.PublicKey, 0, len(res.PubRandShareList))
+	for _, r := range res.PubRandShareList {
+		pubNonceShare, err := btcec.ParsePubKey(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public nonce share: %w", err)
+		}
+		pubNonceShares = append(pubNonceShares, pubNonceShare)
+	}
+
+	return &types.PubRandShareList{
+		Index:         res.Index,
+		Threshold:     res.Threshold,
+		PubRandShares: pubNonceShares,
+	}, nil
+}
+
+func (c *EOTSManagerGRpcClient) SignSchnorrSigShare(uid, msg []byte, pubNonce *btcec.PublicKey, passphrase string) (*btcec.ModNScalar, error) {
+	req := &proto.SignSchnorrSigShareRequest{
+		Uid:        uid,
+		Msg:        msg,
+		PubNonce:   pubNonce.SerializeCompressed(),
+		Passphrase: passphrase,
+	}
+	res, err := c.client.SignSchnorrSigShare(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var s btcec.ModNScalar
+	s.SetByteSlice(res.Sig)
+
+	return &s, nil
+}
+
 func (c *EOTSManagerGRpcClient) Close() error {
 	return c.conn.Close()
 }
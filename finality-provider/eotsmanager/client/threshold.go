@@ -0,0 +1,269 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/threshold"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+var _ eotsmanager.EOTSManager = &ThresholdEOTSManagerClient{}
+
+// ErrUnsupportedByThresholdKey is returned by the operations needing the whole
+// EOTS private key, which no EOTS manager holds in threshold mode
+var ErrUnsupportedByThresholdKey = errors.New("operation not supported with a threshold EOTS key")
+
+// ThresholdParticipantClient is a connection to an EOTS manager holding a share
+// of threshold EOTS keys
+type ThresholdParticipantClient interface {
+	eotsmanager.ThresholdParticipant
+	Close() error
+}
+
+// ThresholdEOTSManagerClient is the coordinator of the EOTS managers holding the
+// shares of threshold EOTS keys. It combines the public randomness and partial
+// signatures of a threshold of them into the public randomness and signatures of
+// the threshold EOTS keys, so that none of them holds a whole EOTS key.
+type ThresholdEOTSManagerClient struct {
+	participants []ThresholdParticipantClient
+}
+
+func NewThresholdEOTSManagerClient(participants []ThresholdParticipantClient) (*ThresholdEOTSManagerClient, error) {
+	if len(participants) == 0 {
+		return nil, fmt.Errorf("no threshold EOTS manager to coordinate")
+	}
+
+	return &ThresholdEOTSManagerClient{participants: participants}, nil
+}
+
+// NewThresholdEOTSManagerGRpcClient connects to the EOTS managers holding the
+// shares of threshold EOTS keys at the given addresses
+func NewThresholdEOTSManagerGRpcClient(remoteAddrs []string) (*ThresholdEOTSManagerClient, error) {
+	participants := make([]ThresholdParticipantClient, 0, len(remoteAddrs))
+	for _, addr := range remoteAddrs {
+		participant, err := NewEOTSManagerGRpcClient(addr)
+		if err != nil {
+			for _, p := range participants {
+				_ = p.Close()
+			}
+
+			return nil, err
+		}
+		participants = append(participants, participant)
+	}
+
+	return NewThresholdEOTSManagerClient(participants)
+}
+
+// NewEOTSManagerClient connects to the threshold EOTS managers at thresholdAddrs
+// if any, otherwise to the EOTS manager at remoteAddr
+func NewEOTSManagerClient(remoteAddr string, thresholdAddrs []string) (eotsmanager.EOTSManager, error) {
+	if len(thresholdAddrs) > 0 {
+		return NewThresholdEOTSManagerGRpcClient(thresholdAddrs)
+	}
+
+	return NewEOTSManagerGRpcClient(remoteAddr)
+}
+
+func (c *ThresholdEOTSManagerClient) CreateKey(_, _, _ string) ([]byte, error) {
+	return nil, fmt.Errorf("%w: split a key with eotsd threshold split instead", ErrUnsupportedByThresholdKey)
+}
+
+func (c *ThresholdEOTSManagerClient) CreateRandomnessPairList(uid, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error) {
+	pubRandList, _, _, err := c.pubRandList(uid, chainID, startHeight, num, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	pubRandFieldValList := make([]*btcec.FieldVal, 0, len(pubRandList))
+	for _, pubRand := range pubRandList {
+		pubRandFieldValList = append(pubRandFieldValList, threshold.PubRandFieldVal(pubRand))
+	}
+
+	return pubRandFieldValList, nil
+}
+
+func (c *ThresholdEOTSManagerClient) KeyRecord(_ []byte, _ string) (*types.KeyRecord, error) {
+	return nil, ErrUnsupportedByThresholdKey
+}
+
+// SignEOTS signs an EOTS with a threshold of the shares of the EOTS key. Each of
+// them refuses to sign another message at the same height.
+func (c *ThresholdEOTSManagerClient) SignEOTS(uid, chainID, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
+	pubRandList, signers, thresh, err := c.pubRandList(uid, chainID, height, 1, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	pubRand := pubRandList[0]
+
+	sig, err := combinePartialSigs(signers, thresh, func(p ThresholdParticipantClient) (*btcec.ModNScalar, error) {
+		return p.SignEOTSShare(uid, chainID, msg, height, pubRand, passphrase)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := schnorr.ParsePubKey(uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := eots.Verify(pk, threshold.PubRandFieldVal(pubRand), msg, sig); err != nil {
+		return nil, fmt.Errorf("invalid EOTS signature combined from the partial signatures: %w", err)
+	}
+
+	return sig, nil
+}
+
+func (c *ThresholdEOTSManagerClient) UnsafeSignEOTS(_, _, _ []byte, _ uint64, _ string) (*btcec.ModNScalar, error) {
+	return nil, ErrUnsupportedByThresholdKey
+}
+
+// SignSchnorrSig signs a Schnorr signature with a threshold of the shares of the
+// EOTS key, e.g., to commit public randomness
+func (c *ThresholdEOTSManagerClient) SignSchnorrSig(uid, msg []byte, passphrase string) (*schnorr.Signature, error) {
+	if len(msg) != chainhash.HashSize {
+		return nil, fmt.Errorf("wrong size for message (got %v, want %v)", len(msg), chainhash.HashSize)
+	}
+
+	pubNonceShares, signers, thresh, err := c.collectPubRandShares(1, func(p ThresholdParticipantClient) (*types.PubRandShareList, error) {
+		return p.SchnorrNonceShare(uid, msg, passphrase)
+	})
+	if err != nil {
+		return nil, err
+	}
+	pubNonce, err := threshold.CombinePubRand(pubNonceShares[0], thresh)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := combinePartialSigs(signers, thresh, func(p ThresholdParticipantClient) (*btcec.ModNScalar, error) {
+		return p.SignSchnorrSigShare(uid, msg, pubNonce, passphrase)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := schnorr.ParsePubKey(uid)
+	if err != nil {
+		return nil, err
+	}
+	sig := threshold.SchnorrSignature(pubNonce, s)
+	if !sig.Verify(msg, pk) {
+		return nil, fmt.Errorf("invalid Schnorr signature combined from the partial signatures")
+	}
+
+	return sig, nil
+}
+
+func (c *ThresholdEOTSManagerClient) SaveEOTSKeyName(_ *btcec.PublicKey, _ string) error {
+	return fmt.Errorf("%w: import the key shares with eotsd threshold import instead", ErrUnsupportedByThresholdKey)
+}
+
+func (c *ThresholdEOTSManagerClient) Close() error {
+	var errs []error
+	for _, p := range c.participants {
+		errs = append(errs, p.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+// pubRandList returns the public randomness of the given heights combined from
+// the shares of a threshold of participants, as well as these participants by
+// index and the threshold
+func (c *ThresholdEOTSManagerClient) pubRandList(uid, chainID []byte, startHeight uint64, num uint32, passphrase string) (
+	[]*btcec.PublicKey, map[uint32]ThresholdParticipantClient, uint32, error) {
+	pubRandShares, signers, thresh, err := c.collectPubRandShares(num, func(p ThresholdParticipantClient) (*types.PubRandShareList, error) {
+		return p.PubRandShareList(uid, chainID, startHeight, num, passphrase)
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	pubRandList := make([]*btcec.PublicKey, 0, num)
+	for i, shares := range pubRandShares {
+		pubRand, err := threshold.CombinePubRand(shares, thresh)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("failed to combine the public randomness at height %d: %w", startHeight+uint64(i), err)
+		}
+		pubRandList = append(pubRandList, pubRand)
+	}
+
+	return pubRandList, signers, thresh, nil
+}
+
+// collectPubRandShares returns the shares of num public randomness of a threshold
+// of participants, keyed by their index, as well as the participants by index
+// and the threshold
+func (c *ThresholdEOTSManagerClient) collectPubRandShares(
+	num uint32,
+	request func(p ThresholdParticipantClient) (*types.PubRandShareList, error),
+) ([]map[uint32]*btcec.PublicKey, map[uint32]ThresholdParticipantClient, uint32, error) {
+	shares := make([]map[uint32]*btcec.PublicKey, num)
+	for i := range shares {
+		shares[i] = make(map[uint32]*btcec.PublicKey)
+	}
+	signers := make(map[uint32]ThresholdParticipantClient)
+
+	var (
+		thresh uint32
+		errs   []error
+	)
+	for _, p := range c.participants {
+		shareList, err := request(p)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		if uint32(len(shareList.PubRandShares)) != num {
+			errs = append(errs, fmt.Errorf("participant %d returned %d public randomness shares, expected %d",
+				shareList.Index, len(shareList.PubRandShares), num))
+
+			continue
+		}
+		if _, exists := signers[shareList.Index]; exists {
+			return nil, nil, 0, fmt.Errorf("participants hold the same share %d", shareList.Index)
+		}
+		if thresh != 0 && shareList.Threshold != thresh {
+			return nil, nil, 0, fmt.Errorf("participants disagree on the threshold: %d and %d", thresh, shareList.Threshold)
+		}
+		thresh = shareList.Threshold
+
+		for i, share := range shareList.PubRandShares {
+			shares[i][shareList.Index] = share
+		}
+		signers[shareList.Index] = p
+		if uint32(len(signers)) == thresh {
+			return shares, signers, thresh, nil
+		}
+	}
+
+	return nil, nil, 0, fmt.Errorf("not enough threshold EOTS managers responded: %w", errors.Join(errs...))
+}
+
+// combinePartialSigs combines the partial signatures of the given threshold of
+// participants, keyed by their index
+func combinePartialSigs(
+	signers map[uint32]ThresholdParticipantClient,
+	thresh uint32,
+	sign func(p ThresholdParticipantClient) (*btcec.ModNScalar, error),
+) (*btcec.ModNScalar, error) {
+	partialSigs := make(map[uint32]*btcec.ModNScalar, len(signers))
+	for index, p := range signers {
+		partialSig, err := sign(p)
+		if err != nil {
+			return nil, fmt.Errorf("threshold EOTS manager with share %d failed to sign: %w", index, err)
+		}
+		partialSigs[index] = partialSig
+	}
+
+	return threshold.CombinePartialSigs(partialSigs, thresh)
+}
@@ -0,0 +1,120 @@
+package client_test
+
+import (
+	"errors"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/threshold"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+var passphrase = "testpass"
+
+// unavailableParticipant is a threshold EOTS manager that is down
+type unavailableParticipant struct {
+	client.ThresholdParticipantClient
+}
+
+func (p *unavailableParticipant) PubRandShareList(_, _ []byte, _ uint64, _ uint32, _ string) (*types.PubRandShareList, error) {
+	return nil, errors.New("unavailable")
+}
+
+func (p *unavailableParticipant) SchnorrNonceShare(_, _ []byte, _ string) (*types.PubRandShareList, error) {
+	return nil, errors.New("unavailable")
+}
+
+func newLocalEOTSManager(t *testing.T) *eotsmanager.LocalEOTSManager {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+	dbBackend, err := eotsCfg.DatabaseConfig.GetDBBackend()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		dbBackend.Close()
+	})
+
+	lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+	require.NoError(t, err)
+
+	return lm
+}
+
+// FuzzThresholdEOTSManagerClient tests that a threshold of the EOTS managers holding
+// the shares of an EOTS key sign valid EOTS and Schnorr signatures, and refuse to
+// sign another message at the same height
+func FuzzThresholdEOTSManagerClient(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		shares, err := threshold.SplitKey(sk, 2, 3, r)
+		require.NoError(t, err)
+
+		participants := make([]client.ThresholdParticipantClient, 0, len(shares))
+		for _, share := range shares {
+			lm := newLocalEOTSManager(t)
+			eotsPk, err := lm.ImportThresholdKeyShare(testutil.GenRandomHexStr(r, 4), passphrase, share)
+			require.NoError(t, err)
+			require.Equal(t, schnorrPk(sk.PubKey()), eotsPk.MustMarshal())
+
+			// the share cannot sign alone
+			_, err = lm.SignEOTS(eotsPk.MustMarshal(), []byte("chain"), []byte("msg"), 1, passphrase)
+			require.ErrorIs(t, err, types.ErrThresholdKeyShare)
+
+			participants = append(participants, lm)
+		}
+		// one of the participants is down
+		down := r.Intn(len(participants))
+		participants[down] = &unavailableParticipant{participants[down]}
+
+		coordinator, err := client.NewThresholdEOTSManagerClient(participants)
+		require.NoError(t, err)
+		defer coordinator.Close()
+
+		uid := schnorrPk(sk.PubKey())
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := uint64(r.Int63n(100000))
+		num := uint32(r.Intn(10)) + 1
+		pubRandList, err := coordinator.CreateRandomnessPairList(uid, chainID, startHeight, num, passphrase)
+		require.NoError(t, err)
+		require.Len(t, pubRandList, int(num))
+
+		height := startHeight + uint64(r.Intn(int(num)))
+		pubRand := pubRandList[height-startHeight]
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig, err := coordinator.SignEOTS(uid, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.NoError(t, eots.Verify(sk.PubKey(), pubRand, msg, sig))
+
+		// signing the same message again returns the same signature
+		sig2, err := coordinator.SignEOTS(uid, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.True(t, sig.Equals(sig2))
+
+		// signing another message at the same height is refused
+		_, err = coordinator.SignEOTS(uid, chainID, datagen.GenRandomByteArray(r, 32), height, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		hash := datagen.GenRandomByteArray(r, 32)
+		schnorrSig, err := coordinator.SignSchnorrSig(uid, hash, passphrase)
+		require.NoError(t, err)
+		require.True(t, schnorrSig.Verify(hash, sk.PubKey()))
+	})
+}
+
+func schnorrPk(pk *btcec.PublicKey) []byte {
+	return pk.SerializeCompressed()[1:]
+}
@@ -0,0 +1,124 @@
+package daemon
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/pkcs11"
+	"github.com/babylonlabs-io/finality-provider/log"
+)
+
+// HSMKeyOutput is the EOTS key created in the HSM
+type HSMKeyOutput struct {
+	KeyName   string `json:"key_name"`
+	PubKeyHex string `json:"pubkey_hex"`
+}
+
+func NewHSMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hsm",
+		Short: "Commands of the EOTS keys kept in an HSM",
+	}
+
+	cmd.AddCommand(
+		NewHSMAddKeyCmd(),
+	)
+
+	return cmd
+}
+
+func NewHSMAddKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-key [key-name]",
+		Short: "Generates a new EOTS key in the HSM set in the config.",
+		Long: `Generates a new EOTS secret in the token set in the pkcs11 section of the config,
+		which requires the key-backend to be pkcs11. The secret cannot be extracted from the
+		token, so back up the token with the tools of the HSM vendor. The key name is saved in
+		the database of eotsd like the keys added to the keyring.`,
+		Example: `eotsd hsm add-key my-key --home=/path/to/cfg`,
+		Args:    cobra.ExactArgs(1),
+		RunE:    addHSMKey,
+	}
+
+	cmd.Flags().String(sdkflags.FlagHome, config.DefaultEOTSDir, "The path to the eotsd home directory")
+
+	return cmd
+}
+
+func addHSMKey(cmd *cobra.Command, args []string) error {
+	keyName := args[0]
+
+	homePath, err := getHomePath(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+	if cfg.KeyBackend != config.KeyBackendPKCS11 {
+		return fmt.Errorf("the key backend should be %s to add keys to the HSM: got %s",
+			config.KeyBackendPKCS11, cfg.KeyBackend)
+	}
+
+	logger, err := log.NewRootLoggerWithFile(config.LogFile(homePath), cfg.LogLevel)
+	if err != nil {
+		return fmt.Errorf("failed to load the logger: %w", err)
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDBBackend()
+	if err != nil {
+		return fmt.Errorf("failed to create db backend: %w", err)
+	}
+	defer dbBackend.Close()
+
+	keys, err := newKeyBackend(cfg)
+	if err != nil {
+		return err
+	}
+	defer keys.Close()
+
+	eotsManager, err := eotsmanager.NewLocalEOTSManagerWithKeyBackend(homePath, cfg.KeyringBackend, keys, dbBackend, logger)
+	if err != nil {
+		return fmt.Errorf("failed to create EOTS manager: %w", err)
+	}
+
+	eotsPk, err := eotsManager.CreateKey(keyName, "", "")
+	if err != nil {
+		return fmt.Errorf("failed to create the key %s in the HSM: %w", keyName, err)
+	}
+
+	jsonBytes, err := json.MarshalIndent(HSMKeyOutput{
+		KeyName:   keyName,
+		PubKeyHex: hex.EncodeToString(eotsPk),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(jsonBytes))
+
+	return nil
+}
+
+// newKeyBackend returns the key backend set in the config, which is nil if the
+// EOTS keys are kept in the keyring
+func newKeyBackend(cfg *config.Config) (eotsmanager.KeyBackend, error) {
+	switch cfg.KeyBackend {
+	case config.KeyBackendPKCS11:
+		keys, err := pkcs11.NewKeyBackend(cfg.PKCS11.LibraryPath, cfg.PKCS11.TokenLabel, cfg.PKCS11.GetPin())
+		if err != nil {
+			return nil, fmt.Errorf("failed to open the PKCS#11 token: %w", err)
+		}
+
+		return keys, nil
+	default:
+		return nil, nil
+	}
+}
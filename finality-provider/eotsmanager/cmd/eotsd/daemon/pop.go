@@ -417,6 +417,10 @@ func loadEotsManager(eotsHomePath, eotsFpPubKeyStr, eotsKeyName, eotsKeyringBack
 		return nil, fmt.Errorf("at least one of the flags: %s, %s needs to be informed", keyNameFlag, eotsPkFlag)
 	}
 
+	return newEotsManager(eotsHomePath, eotsKeyringBackend)
+}
+
+func newEotsManager(eotsHomePath, eotsKeyringBackend string) (*eotsmanager.LocalEOTSManager, error) {
 	cfg, err := config.LoadConfig(eotsHomePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config at %s: %w", eotsHomePath, err)
@@ -432,7 +436,12 @@ func loadEotsManager(eotsHomePath, eotsFpPubKeyStr, eotsKeyName, eotsKeyringBack
 		return nil, fmt.Errorf("failed to create db backend: %w", err)
 	}
 
-	eotsManager, err := eotsmanager.NewLocalEOTSManager(eotsHomePath, eotsKeyringBackend, dbBackend, logger)
+	keys, err := newKeyBackend(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	eotsManager, err := eotsmanager.NewLocalEOTSManagerWithKeyBackend(eotsHomePath, eotsKeyringBackend, keys, dbBackend, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create EOTS manager: %w", err)
 	}
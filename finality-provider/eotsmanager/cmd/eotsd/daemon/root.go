@@ -27,6 +27,9 @@ func NewRootCmd() *cobra.Command {
 		version.CommandVersion("eotsd"),
 		CommandPrintAllKeys(),
 		NewPopCmd(),
+		NewThresholdCmd(),
+		NewSignHistoryCmd(),
+		NewHSMCmd(),
 	)
 
 	return rootCmd
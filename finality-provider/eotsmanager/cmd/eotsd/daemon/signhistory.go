@@ -0,0 +1,141 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+func NewSignHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-history",
+		Short: "Sign history (anti-slashing database) commands",
+	}
+
+	cmd.AddCommand(
+		NewSignHistoryExportCmd(),
+		NewSignHistoryImportCmd(),
+	)
+
+	return cmd
+}
+
+func NewSignHistoryExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the sign history of an EOTS key in the sign history interchange format.",
+		Long: `Exports the sign records of the EOTS key associated with the key-name or eots-pk
+		flag on all chains, with the highest height it signed at on each chain as watermark.
+		Import the sign history with eotsd sign-history import on the EOTS manager the key
+		migrates to before it starts signing. The EOTS manager has to be stopped.`,
+		RunE: exportSignHistory,
+	}
+
+	f := cmd.Flags()
+
+	f.String(sdkflags.FlagHome, config.DefaultEOTSDir, "EOTS home directory")
+	f.String(keyNameFlag, "", "EOTS key name")
+	f.String(eotsPkFlag, "", "EOTS public key of the finality-provider")
+	f.String(sdkflags.FlagKeyringBackend, keyring.BackendTest, "EOTS backend of the keyring")
+	f.String(flagOutputFile, "", "Path to output JSON file")
+
+	return cmd
+}
+
+func NewSignHistoryImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [sign-history-file]",
+		Short: "Imports a sign history in the sign history interchange format.",
+		Long: `Merges the sign records of the sign history into the ones of the EOTS manager,
+		keeping the existing record when both signed another message at the same height.
+		The EOTS manager refuses to sign at any height at or below the imported watermarks
+		from then on. The EOTS manager has to be stopped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: importSignHistory,
+	}
+
+	f := cmd.Flags()
+
+	f.String(sdkflags.FlagHome, config.DefaultEOTSDir, "EOTS home directory")
+	f.String(sdkflags.FlagKeyringBackend, keyring.BackendTest, "EOTS backend of the keyring")
+	f.String(flagOutputFile, "", "Path to output JSON file")
+
+	return cmd
+}
+
+func exportSignHistory(cmd *cobra.Command, _ []string) error {
+	eotsHomePath, eotsKeyName, eotsFpPubKeyStr, eotsKeyringBackend, err := eotsFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	eotsManager, err := loadEotsManager(eotsHomePath, eotsFpPubKeyStr, eotsKeyName, eotsKeyringBackend)
+	if err != nil {
+		return err
+	}
+	defer cmdCloseEots(cmd, eotsManager)
+
+	var eotsPk *bbntypes.BIP340PubKey
+	if len(eotsFpPubKeyStr) > 0 {
+		eotsPk, err = bbntypes.NewBIP340PubKeyFromHex(eotsFpPubKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid finality-provider public key %s: %w", eotsFpPubKeyStr, err)
+		}
+	} else {
+		eotsPk, err = eotsManager.LoadBIP340PubKeyFromKeyName(eotsKeyName)
+		if err != nil {
+			return err
+		}
+	}
+
+	history, err := eotsManager.ExportSignHistory(eotsPk.MustMarshal())
+	if err != nil {
+		return err
+	}
+
+	return handleOutputJSON(cmd, history)
+}
+
+func importSignHistory(cmd *cobra.Command, args []string) error {
+	historyBz, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("failed to read sign history file: %w", err)
+	}
+
+	var history types.SignHistory
+	if err := json.Unmarshal(historyBz, &history); err != nil {
+		return fmt.Errorf("failed to parse sign history file %s: %w", args[0], err)
+	}
+
+	eotsHomePath, err := getHomePath(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	eotsKeyringBackend, err := cmd.Flags().GetString(sdkflags.FlagKeyringBackend)
+	if err != nil {
+		return err
+	}
+
+	eotsManager, err := newEotsManager(eotsHomePath, eotsKeyringBackend)
+	if err != nil {
+		return err
+	}
+	defer cmdCloseEots(cmd, eotsManager)
+
+	res, err := eotsManager.ImportSignHistory(&history)
+	if err != nil {
+		return fmt.Errorf("failed to import the sign history: %w", err)
+	}
+
+	return handleOutputJSON(cmd, res)
+}
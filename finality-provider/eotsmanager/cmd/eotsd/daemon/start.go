@@ -60,7 +60,15 @@ func startFn(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("failed to create db backend: %w", err)
 	}
 
-	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, cfg.KeyringBackend, dbBackend, logger)
+	keys, err := newKeyBackend(cfg)
+	if err != nil {
+		return err
+	}
+	if keys != nil {
+		defer keys.Close()
+	}
+
+	eotsManager, err := eotsmanager.NewLocalEOTSManagerWithKeyBackend(homePath, cfg.KeyringBackend, keys, dbBackend, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create EOTS manager: %w", err)
 	}
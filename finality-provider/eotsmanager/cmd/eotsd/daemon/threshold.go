@@ -0,0 +1,244 @@
+package daemon
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/threshold"
+)
+
+const (
+	flagThreshold  = "threshold"
+	flagNumParties = "num-parties"
+	flagOutputDir  = "output-dir"
+)
+
+// ThresholdSplitOutput lists the files of the shares of a threshold EOTS key
+type ThresholdSplitOutput struct {
+	EotsPublicKey string   `json:"eotsPublicKey"`
+	Threshold     uint32   `json:"threshold"`
+	NumParties    uint32   `json:"numParties"`
+	ShareFiles    []string `json:"shareFiles"`
+}
+
+// ThresholdImportOutput is the share of a threshold EOTS key imported to eotsd
+type ThresholdImportOutput struct {
+	EotsPublicKey string `json:"eotsPublicKey"`
+	KeyName       string `json:"keyName"`
+	Index         uint32 `json:"index"`
+	Threshold     uint32 `json:"threshold"`
+	NumParties    uint32 `json:"numParties"`
+}
+
+func NewThresholdCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "threshold",
+		Short: "Threshold EOTS key commands",
+	}
+
+	cmd.AddCommand(
+		NewThresholdSplitCmd(),
+		NewThresholdImportCmd(),
+	)
+
+	return cmd
+}
+
+func NewThresholdSplitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split",
+		Short: "Splits an EOTS key into shares, so that a threshold of eotsd instances holding them can sign.",
+		Long: `Loads the EOTS key associated with the key-name or eots-pk flag and splits it
+		into num-parties shares, written as JSON files in the output directory. Any threshold of
+		the eotsd instances holding the shares sign together, while fewer of them learn nothing
+		about the key. The threshold has to be more than half of num-parties. Export the Proof of
+		Possession of the key before splitting it, and delete the key and the share files from
+		this machine once the shares are imported with eotsd threshold import.`,
+		RunE: splitThresholdKey,
+	}
+
+	f := cmd.Flags()
+
+	f.String(sdkflags.FlagHome, config.DefaultEOTSDir, "EOTS home directory")
+	f.String(keyNameFlag, "", "EOTS key name")
+	f.String(eotsPkFlag, "", "EOTS public key of the finality-provider")
+	f.String(passphraseFlag, "", "EOTS passphrase used to decrypt the keyring")
+	f.String(sdkflags.FlagKeyringBackend, keyring.BackendTest, "EOTS backend of the keyring")
+
+	f.Uint32(flagThreshold, 2, "Number of shares needed to sign")
+	f.Uint32(flagNumParties, 3, "Number of shares of the key")
+	f.String(flagOutputDir, "", "Directory of the share files")
+	f.String(flagOutputFile, "", "Path to output JSON file")
+
+	if err := cmd.MarkFlagRequired(flagOutputDir); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func NewThresholdImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [share-file]",
+		Short: "Imports the share of a threshold EOTS key produced by eotsd threshold split.",
+		Long: `Imports the private share into the keyring under the key-name flag and the
+		randomness seeds of the share into the database, encrypted with the private share.
+		The eotsd instance then serves the partial signatures of the threshold EOTS key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: importThresholdKeyShare,
+	}
+
+	f := cmd.Flags()
+
+	f.String(sdkflags.FlagHome, config.DefaultEOTSDir, "EOTS home directory")
+	f.String(keyNameFlag, "", "EOTS key name of the share")
+	f.String(passphraseFlag, "", "EOTS passphrase used to encrypt the keyring")
+	f.String(sdkflags.FlagKeyringBackend, keyring.BackendTest, "EOTS backend of the keyring")
+	f.String(flagOutputFile, "", "Path to output JSON file")
+
+	if err := cmd.MarkFlagRequired(keyNameFlag); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func splitThresholdKey(cmd *cobra.Command, _ []string) error {
+	f := cmd.Flags()
+
+	eotsPassphrase, err := f.GetString(passphraseFlag)
+	if err != nil {
+		return err
+	}
+
+	thresh, err := f.GetUint32(flagThreshold)
+	if err != nil {
+		return err
+	}
+
+	numParties, err := f.GetUint32(flagNumParties)
+	if err != nil {
+		return err
+	}
+
+	outputDir, err := f.GetString(flagOutputDir)
+	if err != nil {
+		return err
+	}
+
+	if err := threshold.ValidateParams(thresh, numParties); err != nil {
+		return err
+	}
+
+	eotsHomePath, eotsKeyName, eotsFpPubKeyStr, eotsKeyringBackend, err := eotsFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	eotsManager, err := loadEotsManager(eotsHomePath, eotsFpPubKeyStr, eotsKeyName, eotsKeyringBackend)
+	if err != nil {
+		return err
+	}
+	defer cmdCloseEots(cmd, eotsManager)
+
+	var eotsPk *bbntypes.BIP340PubKey
+	if len(eotsFpPubKeyStr) > 0 {
+		eotsPk, err = bbntypes.NewBIP340PubKeyFromHex(eotsFpPubKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid finality-provider public key %s: %w", eotsFpPubKeyStr, err)
+		}
+	} else {
+		eotsPk, err = eotsManager.LoadBIP340PubKeyFromKeyName(eotsKeyName)
+		if err != nil {
+			return err
+		}
+	}
+
+	record, err := eotsManager.KeyRecord(*eotsPk, eotsPassphrase)
+	if err != nil {
+		return fmt.Errorf("failed to load the EOTS key %s: %w", eotsPk.MarshalHex(), err)
+	}
+
+	shares, err := threshold.SplitKey(record.PrivKey, thresh, numParties, rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	cleanDir, err := filepath.Abs(filepath.Clean(outputDir))
+	if err != nil {
+		return fmt.Errorf("invalid output directory: %w", err)
+	}
+	if err := os.MkdirAll(cleanDir, 0750); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	out := ThresholdSplitOutput{
+		EotsPublicKey: eotsPk.MarshalHex(),
+		Threshold:     thresh,
+		NumParties:    numParties,
+	}
+	for _, share := range shares {
+		shareBz, err := json.MarshalIndent(share, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		shareFile := filepath.Join(cleanDir, fmt.Sprintf("%s-share-%d.json", eotsPk.MarshalHex(), share.Index))
+		if err := os.WriteFile(shareFile, shareBz, 0600); err != nil {
+			return fmt.Errorf("failed to write share file: %w", err)
+		}
+		out.ShareFiles = append(out.ShareFiles, shareFile)
+	}
+
+	return handleOutputJSON(cmd, out)
+}
+
+func importThresholdKeyShare(cmd *cobra.Command, args []string) error {
+	eotsPassphrase, err := cmd.Flags().GetString(passphraseFlag)
+	if err != nil {
+		return err
+	}
+
+	eotsHomePath, eotsKeyName, _, eotsKeyringBackend, err := eotsFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	shareBz, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("failed to read share file: %w", err)
+	}
+
+	var share threshold.KeyShare
+	if err := json.Unmarshal(shareBz, &share); err != nil {
+		return fmt.Errorf("failed to parse share file %s: %w", args[0], err)
+	}
+
+	eotsManager, err := loadEotsManager(eotsHomePath, "", eotsKeyName, eotsKeyringBackend)
+	if err != nil {
+		return err
+	}
+	defer cmdCloseEots(cmd, eotsManager)
+
+	eotsPk, err := eotsManager.ImportThresholdKeyShare(eotsKeyName, eotsPassphrase, &share)
+	if err != nil {
+		return fmt.Errorf("failed to import the threshold key share: %w", err)
+	}
+
+	return handleOutputJSON(cmd, ThresholdImportOutput{
+		EotsPublicKey: eotsPk.MarshalHex(),
+		KeyName:       eotsKeyName,
+		Index:         share.Index,
+		Threshold:     share.Threshold,
+		NumParties:    share.NumParties,
+	})
+}
@@ -37,10 +37,13 @@ var (
 type Config struct {
 	LogLevel       string          `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
 	KeyringBackend string          `long:"keyring-type" description:"Type of keyring to use"`
+	KeyBackend     string          `long:"key-backend" description:"The backend keeping the EOTS keys; the shares of threshold EOTS keys are always kept in the keyring" choice:"keyring" choice:"pkcs11"`
 	RPCListener    string          `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
 	Metrics        *metrics.Config `group:"metrics" namespace:"metrics"`
 
 	DatabaseConfig *DBConfig `group:"dbconfig" namespace:"dbconfig"`
+
+	PKCS11 *PKCS11Config `group:"pkcs11" namespace:"pkcs11"`
 }
 
 // LoadConfig initializes and parses the config using a config file and command
@@ -97,6 +100,20 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid metrics config")
 	}
 
+	switch cfg.KeyBackend {
+	// the configs prior to the key backends keep the keys in the keyring
+	case "", KeyBackendKeyring:
+	case KeyBackendPKCS11:
+		if cfg.PKCS11 == nil {
+			return fmt.Errorf("empty PKCS#11 config")
+		}
+		if err := cfg.PKCS11.Validate(); err != nil {
+			return fmt.Errorf("invalid PKCS#11 config: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported key backend %s", cfg.KeyBackend)
+	}
+
 	return nil
 }
 
@@ -124,6 +141,8 @@ func DefaultConfigWithHomePath(homePath string) *Config {
 	cfg := &Config{
 		LogLevel:       defaultLogLevel,
 		KeyringBackend: defaultKeyringBackend,
+		KeyBackend:     KeyBackendKeyring,
+		PKCS11:         &PKCS11Config{},
 		DatabaseConfig: DefaultDBConfigWithHomePath(homePath),
 		RPCListener:    defaultRPCListener,
 		Metrics:        metrics.DefaultEotsConfig(),
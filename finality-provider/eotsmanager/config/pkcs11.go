@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+const (
+	KeyBackendKeyring = "keyring"
+	KeyBackendPKCS11  = "pkcs11"
+
+	// PKCS11PinEnvVar is the environment variable the user PIN of the token is
+	// read from if it is not set in the config
+	PKCS11PinEnvVar = "EOTSD_PKCS11_PIN"
+)
+
+// PKCS11Config is the config of the HSM keeping the EOTS keys, which is accessed
+// through its PKCS#11 module
+type PKCS11Config struct {
+	LibraryPath string `long:"library-path" description:"Path to the PKCS#11 module of the HSM, e.g., /usr/lib/softhsm/libsofthsm2.so"`
+	TokenLabel  string `long:"token-label" description:"Label of the token keeping the EOTS keys"`
+	Pin         string `long:"pin" description:"User PIN of the token; read from the EOTSD_PKCS11_PIN environment variable if empty"`
+}
+
+// GetPin returns the user PIN of the token
+func (cfg *PKCS11Config) GetPin() string {
+	if cfg.Pin != "" {
+		return cfg.Pin
+	}
+
+	return os.Getenv(PKCS11PinEnvVar)
+}
+
+func (cfg *PKCS11Config) Validate() error {
+	if cfg.LibraryPath == "" {
+		return fmt.Errorf("the path to the PKCS#11 module should not be empty")
+	}
+
+	if cfg.TokenLabel == "" {
+		return fmt.Errorf("the token label should not be empty")
+	}
+
+	return nil
+}
@@ -47,3 +47,29 @@ type EOTSManager interface {
 
 	Close() error
 }
+
+// ThresholdParticipant holds shares of threshold EOTS keys, whose EOTS signatures
+// are produced by a coordinator from the partial signatures of a threshold of
+// participants
+type ThresholdParticipant interface {
+	// PubRandShareList returns the shares of the public randomness held by the
+	// participant from startHeight to startHeight+(num-1)
+	// It fails if the participant does not hold a share of the key or passPhrase is incorrect
+	PubRandShareList(uid []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) (*types.PubRandShareList, error)
+
+	// SignEOTSShare returns the partial EOTS signature of the participant, where pubRand
+	// is the public randomness of the given chain at the given height combined by the
+	// coordinator. Has the same anti-slashing mechanism as SignEOTS.
+	SignEOTSShare(uid []byte, chainID []byte, msg []byte, height uint64, pubRand *btcec.PublicKey, passphrase string) (*btcec.ModNScalar, error)
+
+	// SchnorrNonceShare returns the share of the public nonce of the Schnorr signature
+	// of the given message held by the participant, which is derived from the message
+	// It fails if the participant does not hold a share of the key or passPhrase is incorrect
+	SchnorrNonceShare(uid []byte, msg []byte, passphrase string) (*types.PubRandShareList, error)
+
+	// SignSchnorrSigShare returns the partial Schnorr signature of the participant, where
+	// pubNonce is the public nonce of the message combined by the coordinator
+	// It fails if the message was signed with another public nonce before, as it would
+	// leak the share of the key
+	SignSchnorrSigShare(uid []byte, msg []byte, pubNonce *btcec.PublicKey, passphrase string) (*btcec.ModNScalar, error)
+}
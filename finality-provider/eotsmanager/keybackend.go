@@ -0,0 +1,168 @@
+package eotsmanager
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/randgenerator"
+)
+
+// KeyBackend keeps the EOTS keys and uses them to derive the randomness and to
+// sign. The keys are referred to by name, and the EOTS manager maps the EOTS
+// public keys to the names. The passphrase is only used by the backends that
+// encrypt the keys with it.
+type KeyBackend interface {
+	// GenerateKey generates a new EOTS key at the given name and returns its public key
+	// It fails if there is an existing key with the same name
+	GenerateKey(name, passphrase, hdPath string) (*btcec.PublicKey, error)
+
+	// PubKey returns the public key of the EOTS key at the given name
+	PubKey(name string) (*btcec.PublicKey, error)
+
+	// RandomnessPair returns the randomness pair of the EOTS key at the given name
+	// for the given chain and height, which is deterministic with each given input
+	RandomnessPair(name, passphrase string, chainID []byte, height uint64) (*eots.PrivateRand, *eots.PublicRand, error)
+
+	// SignEOTS signs an EOTS over msg using the EOTS key at the given name and the
+	// given private randomness
+	SignEOTS(name, passphrase string, privRand *eots.PrivateRand, msg []byte) (*btcec.ModNScalar, error)
+
+	// SignSchnorr signs a BIP-340 Schnorr signature over msg using the EOTS key at
+	// the given name
+	SignSchnorr(name, passphrase string, msg []byte) (*schnorr.Signature, error)
+
+	// PrivKey returns the EOTS private key at the given name
+	// It fails with ErrKeyNotExportable if the backend does not allow exporting it
+	PrivKey(name, passphrase string) (*btcec.PrivateKey, error)
+
+	Close() error
+}
+
+var _ KeyBackend = &keyringKeyBackend{}
+
+// keyringKeyBackend keeps the EOTS keys in the keyring, which also keeps the
+// shares of the threshold EOTS keys
+type keyringKeyBackend struct {
+	mu sync.Mutex
+	kr keyring.Keyring
+	// input is to send passphrase to kr
+	input *strings.Reader
+}
+
+func newKeyringKeyBackend(kr keyring.Keyring, input *strings.Reader) *keyringKeyBackend {
+	return &keyringKeyBackend{
+		kr:    kr,
+		input: input,
+	}
+}
+
+func (kb *keyringKeyBackend) GenerateKey(name, passphrase, hdPath string) (*btcec.PublicKey, error) {
+	mnemonic, err := NewMnemonic()
+	if err != nil {
+		return nil, err
+	}
+
+	return kb.generateKeyWithMnemonic(name, passphrase, hdPath, mnemonic)
+}
+
+func (kb *keyringKeyBackend) generateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*btcec.PublicKey, error) {
+	keyringAlgos, _ := kb.kr.SupportedAlgorithms()
+	algo, err := keyring.NewSigningAlgoFromString(secp256k1Type, keyringAlgos)
+	if err != nil {
+		return nil, err
+	}
+
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	// we need to repeat the passphrase to mock the re-entry
+	// as when creating an account, passphrase will be asked twice
+	// by the keyring
+	kb.input.Reset(passphrase + "\n" + passphrase)
+	if _, err := kb.kr.NewAccount(name, mnemonic, passphrase, hdPath, algo); err != nil {
+		return nil, err
+	}
+
+	return kb.PubKey(name)
+}
+
+// importPrivKey imports the given private key at the given name
+func (kb *keyringKeyBackend) importPrivKey(name, passphrase string, privKey *btcec.PrivateKey) error {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	// as when creating an account, passphrase will be asked twice by the keyring
+	kb.input.Reset(passphrase + "\n" + passphrase)
+
+	return kb.kr.ImportPrivKeyHex(name, hex.EncodeToString(privKey.Serialize()), secp256k1Type)
+}
+
+func (kb *keyringKeyBackend) PubKey(name string) (*btcec.PublicKey, error) {
+	eotsPk, err := LoadBIP340PubKeyFromKeyName(kb.kr, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return eotsPk.ToBTCPK()
+}
+
+func (kb *keyringKeyBackend) RandomnessPair(name, passphrase string, chainID []byte, height uint64) (*eots.PrivateRand, *eots.PublicRand, error) {
+	privKey, err := kb.PrivKey(name, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+	privRand, pubRand := randgenerator.GenerateRandomness(privKey.Serialize(), chainID, height)
+
+	return privRand, pubRand, nil
+}
+
+func (kb *keyringKeyBackend) SignEOTS(name, passphrase string, privRand *eots.PrivateRand, msg []byte) (*btcec.ModNScalar, error) {
+	privKey, err := kb.PrivKey(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return eots.Sign(privKey, privRand, msg)
+}
+
+func (kb *keyringKeyBackend) SignSchnorr(name, passphrase string, msg []byte) (*schnorr.Signature, error) {
+	privKey, err := kb.PrivKey(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.Sign(privKey, msg)
+}
+
+func (kb *keyringKeyBackend) PrivKey(name, passphrase string) (*btcec.PrivateKey, error) {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	kb.input.Reset(passphrase)
+	k, err := kb.kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	privKeyCached := k.GetLocal().PrivKey.GetCachedValue()
+
+	switch v := privKeyCached.(type) {
+	case *secp256k1.PrivKey:
+		privKey, _ := btcec.PrivKeyFromBytes(v.Key)
+
+		return privKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type in keyring")
+	}
+}
+
+func (kb *keyringKeyBackend) Close() error {
+	return nil
+}
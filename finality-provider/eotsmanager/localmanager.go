@@ -1,11 +1,9 @@
 package eotsmanager
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
 	"strings"
-	"sync"
 
 	"github.com/babylonlabs-io/finality-provider/metrics"
 
@@ -20,7 +18,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/babylonlabs-io/finality-provider/codec"
-	"github.com/babylonlabs-io/finality-provider/eotsmanager/randgenerator"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
 	eotstypes "github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 )
@@ -33,16 +30,30 @@ const (
 var _ EOTSManager = &LocalEOTSManager{}
 
 type LocalEOTSManager struct {
-	mu     sync.Mutex
-	kr     keyring.Keyring
-	es     *store.EOTSStore
-	logger *zap.Logger
-	// input is to send passphrase to kr
-	input   *strings.Reader
+	kr keyring.Keyring
+	// krKeys keeps the keys in the keyring, including the shares of
+	// threshold EOTS keys
+	krKeys *keyringKeyBackend
+	// keys keeps the EOTS keys, which is krKeys unless another backend is set
+	keys    KeyBackend
+	es      *store.EOTSStore
+	logger  *zap.Logger
 	metrics *metrics.EotsMetrics
 }
 
 func NewLocalEOTSManager(homeDir, keyringBackend string, dbbackend kvdb.Backend, logger *zap.Logger) (*LocalEOTSManager, error) {
+	return NewLocalEOTSManagerWithKeyBackend(homeDir, keyringBackend, nil, dbbackend, logger)
+}
+
+// NewLocalEOTSManagerWithKeyBackend returns an EOTS manager keeping the EOTS keys
+// in the given key backend, or in the keyring if it is nil. The shares of the
+// threshold EOTS keys are always kept in the keyring.
+func NewLocalEOTSManagerWithKeyBackend(
+	homeDir, keyringBackend string,
+	keys KeyBackend,
+	dbbackend kvdb.Backend,
+	logger *zap.Logger,
+) (*LocalEOTSManager, error) {
 	inputReader := strings.NewReader("")
 
 	es, err := store.NewEOTSStore(dbbackend)
@@ -57,11 +68,17 @@ func NewLocalEOTSManager(homeDir, keyringBackend string, dbbackend kvdb.Backend,
 
 	eotsMetrics := metrics.NewEotsMetrics()
 
+	krKeys := newKeyringKeyBackend(kr, inputReader)
+	if keys == nil {
+		keys = krKeys
+	}
+
 	return &LocalEOTSManager{
 		kr:      kr,
+		krKeys:  krKeys,
+		keys:    keys,
 		es:      es,
 		logger:  logger,
-		input:   inputReader,
 		metrics: eotsMetrics,
 	}, nil
 }
@@ -77,12 +94,16 @@ func InitKeyring(homeDir, keyringBackend string, inputReader *strings.Reader) (k
 }
 
 func (lm *LocalEOTSManager) CreateKey(name, passphrase, hdPath string) ([]byte, error) {
-	mnemonic, err := NewMnemonic()
+	if lm.keyExists(name) {
+		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
+	}
+
+	pk, err := lm.keys.GenerateKey(name, passphrase, hdPath)
 	if err != nil {
 		return nil, err
 	}
 
-	eotsPk, err := lm.CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic)
+	eotsPk, err := lm.saveCreatedKey(name, pk)
 	if err != nil {
 		return nil, err
 	}
@@ -105,32 +126,27 @@ func NewMnemonic() (string, error) {
 	return mnemonic, nil
 }
 
+// CreateKeyWithMnemonic creates an EOTS key in the keyring from the given mnemonic
+// It fails if the EOTS keys are kept in another key backend
 func (lm *LocalEOTSManager) CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error) {
+	if lm.keys != lm.krKeys {
+		return nil, fmt.Errorf("creating a key from a mnemonic is only supported by the keyring")
+	}
 	if lm.keyExists(name) {
 		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
 	}
 
-	keyringAlgos, _ := lm.kr.SupportedAlgorithms()
-	algo, err := keyring.NewSigningAlgoFromString(secp256k1Type, keyringAlgos)
-	if err != nil {
-		return nil, err
-	}
-
-	// we need to repeat the passphrase to mock the re-entry
-	// as when creating an account, passphrase will be asked twice
-	// by the keyring
-	lm.input.Reset(passphrase + "\n" + passphrase)
-	_, err = lm.kr.NewAccount(name, mnemonic, passphrase, hdPath, algo)
+	pk, err := lm.krKeys.generateKeyWithMnemonic(name, passphrase, hdPath, mnemonic)
 	if err != nil {
 		return nil, err
 	}
 
-	eotsPk, err := lm.LoadBIP340PubKeyFromKeyName(name)
-	if err != nil {
-		return nil, err
-	}
+	return lm.saveCreatedKey(name, pk)
+}
 
-	if err := lm.SaveEOTSKeyName(eotsPk.MustToBTCPK(), name); err != nil {
+func (lm *LocalEOTSManager) saveCreatedKey(name string, pk *btcec.PublicKey) (*bbntypes.BIP340PubKey, error) {
+	eotsPk := bbntypes.NewBIP340PubKeyFromBTCPK(pk)
+	if err := lm.SaveEOTSKeyName(pk, name); err != nil {
 		return nil, err
 	}
 
@@ -149,7 +165,12 @@ func (lm *LocalEOTSManager) SaveEOTSKeyName(pk *btcec.PublicKey, keyName string)
 }
 
 func (lm *LocalEOTSManager) LoadBIP340PubKeyFromKeyName(keyName string) (*bbntypes.BIP340PubKey, error) {
-	return LoadBIP340PubKeyFromKeyName(lm.kr, keyName)
+	pk, err := lm.keys.PubKey(keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return bbntypes.NewBIP340PubKeyFromBTCPK(pk), nil
 }
 
 func LoadBIP340PubKeyFromKeyName(kr keyring.Keyring, keyName string) (*bbntypes.BIP340PubKey, error) {
@@ -196,55 +217,17 @@ func (lm *LocalEOTSManager) CreateRandomnessPairList(fpPk []byte, chainID []byte
 }
 
 func (lm *LocalEOTSManager) SignEOTS(eotsPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
-	record, found, err := lm.es.GetSignRecord(eotsPk, chainID, height)
+	sig, found, err := lm.checkSignRecord(eotsPk, chainID, msg, height)
 	if err != nil {
-		return nil, fmt.Errorf("error getting sign record: %w", err)
+		return nil, err
 	}
-
 	if found {
-		if bytes.Equal(msg, record.Msg) {
-			var s btcec.ModNScalar
-			s.SetByteSlice(record.Signature)
-
-			lm.logger.Warn(
-				"duplicate sign requested",
-				zap.String("eots_pk", hex.EncodeToString(eotsPk)),
-				zap.String("hash", hex.EncodeToString(msg)),
-				zap.Uint64("height", height),
-				zap.String("chainID", string(chainID)),
-			)
-
-			return &s, nil
-		}
-
-		lm.logger.Error(
-			"double sign requested",
-			zap.String("eots_pk", hex.EncodeToString(eotsPk)),
-			zap.String("hash", hex.EncodeToString(msg)),
-			zap.Uint64("height", height),
-			zap.String("chainID", string(chainID)),
-		)
-
-		return nil, eotstypes.ErrDoubleSign
-	}
-
-	privRand, _, err := lm.getRandomnessPair(eotsPk, chainID, height, passphrase)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get private randomness: %w", err)
+		return sig, nil
 	}
 
-	privKey, err := lm.getEOTSPrivKey(eotsPk, passphrase)
+	signedBytes, err := lm.signEOTS(eotsPk, chainID, msg, height, passphrase)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
-	}
-
-	// Update metrics
-	lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(eotsPk))
-	lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(eotsPk), float64(height))
-
-	signedBytes, err := eots.Sign(privKey, privRand, msg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign eots")
+		return nil, err
 	}
 
 	b := signedBytes.Bytes()
@@ -257,54 +240,57 @@ func (lm *LocalEOTSManager) SignEOTS(eotsPk []byte, chainID []byte, msg []byte,
 
 // UnsafeSignEOTS should only be used in e2e test to demonstrate double sign
 func (lm *LocalEOTSManager) UnsafeSignEOTS(fpPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
-	privRand, _, err := lm.getRandomnessPair(fpPk, chainID, height, passphrase)
+	return lm.signEOTS(fpPk, chainID, msg, height, passphrase)
+}
+
+// signEOTS signs an EOTS without checking the sign records
+func (lm *LocalEOTSManager) signEOTS(fpPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
+	keyName, err := lm.getEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get private randomness: %w", err)
+		return nil, err
 	}
 
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	privRand, _, err := lm.keys.RandomnessPair(keyName, passphrase, chainID, height)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+		return nil, fmt.Errorf("failed to get private randomness: %w", err)
 	}
 
 	// Update metrics
 	lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(fpPk))
 	lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(fpPk), float64(height))
 
-	return eots.Sign(privKey, privRand, msg)
+	sig, err := lm.keys.SignEOTS(keyName, passphrase, privRand, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign eots: %w", err)
+	}
+
+	return sig, nil
 }
 
 func (lm *LocalEOTSManager) SignSchnorrSig(fpPk []byte, msg []byte, passphrase string) (*schnorr.Signature, error) {
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	keyName, err := lm.getEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+		return nil, err
 	}
 
-	return lm.signSchnorrSigFromPrivKey(privKey, fpPk, msg)
+	return lm.signSchnorrSigFromKeyName(keyName, fpPk, msg, passphrase)
 }
 
-// signSchnorrSigFromPrivKey signs a Schnorr signature using the private key and updates metrics by the fpPk
-func (lm *LocalEOTSManager) signSchnorrSigFromPrivKey(privKey *btcec.PrivateKey, fpPk []byte, msg []byte) (*schnorr.Signature, error) {
+// signSchnorrSigFromKeyName signs a Schnorr signature using the key at the given name and updates metrics by the fpPk
+func (lm *LocalEOTSManager) signSchnorrSigFromKeyName(keyName string, fpPk []byte, msg []byte, passphrase string) (*schnorr.Signature, error) {
 	// Update metrics
 	lm.metrics.IncrementEotsFpTotalSchnorrSignCounter(hex.EncodeToString(fpPk))
 
-	return schnorr.Sign(privKey, msg)
+	return lm.keys.SignSchnorr(keyName, passphrase, msg)
 }
 
 func (lm *LocalEOTSManager) SignSchnorrSigFromKeyname(keyName, passphrase string, msg []byte) (*schnorr.Signature, *bbntypes.BIP340PubKey, error) {
-	lm.input.Reset(passphrase)
-
 	eotsPk, err := lm.LoadBIP340PubKeyFromKeyName(keyName)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	privKey, err := lm.eotsPrivKeyFromKeyName(keyName)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	signature, err := lm.signSchnorrSigFromPrivKey(privKey, *eotsPk, msg)
+	signature, err := lm.signSchnorrSigFromKeyName(keyName, *eotsPk, msg, passphrase)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to schnorr sign: %w", err)
 	}
@@ -313,26 +299,31 @@ func (lm *LocalEOTSManager) SignSchnorrSigFromKeyname(keyName, passphrase string
 }
 
 func (lm *LocalEOTSManager) Close() error {
+	if err := lm.keys.Close(); err != nil {
+		return err
+	}
+
 	return lm.es.Close()
 }
 
 // getRandomnessPair returns a randomness pair generated based on the given finality provider key, chainID and height
 func (lm *LocalEOTSManager) getRandomnessPair(fpPk []byte, chainID []byte, height uint64, passphrase string) (*eots.PrivateRand, *eots.PublicRand, error) {
-	record, err := lm.KeyRecord(fpPk, passphrase)
+	keyName, err := lm.getEOTSKeyName(fpPk)
 	if err != nil {
 		return nil, nil, err
 	}
-	privRand, pubRand := randgenerator.GenerateRandomness(record.PrivKey.Serialize(), chainID, height)
 
-	return privRand, pubRand, nil
+	return lm.keys.RandomnessPair(keyName, passphrase, chainID, height)
 }
 
+// KeyRecord returns the EOTS key record, which fails if the key backend does not
+// allow exporting the private key
 func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstypes.KeyRecord, error) {
-	name, err := lm.es.GetEOTSKeyName(fpPk)
+	name, err := lm.getEOTSKeyName(fpPk)
 	if err != nil {
 		return nil, err
 	}
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	privKey, err := lm.keys.PrivKey(name, passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -343,39 +334,36 @@ func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstype
 	}, nil
 }
 
-func (lm *LocalEOTSManager) getEOTSPrivKey(fpPk []byte, passphrase string) (*btcec.PrivateKey, error) {
-	lm.mu.Lock()
-	defer lm.mu.Unlock()
-	keyName, err := lm.es.GetEOTSKeyName(fpPk)
+// getEOTSKeyName returns the name of the EOTS key in the key backend, which
+// cannot be used with only a share of a threshold EOTS key
+func (lm *LocalEOTSManager) getEOTSKeyName(fpPk []byte) (string, error) {
+	isThreshold, err := lm.isThresholdKey(fpPk)
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	if isThreshold {
+		return "", fmt.Errorf("%w: %s", eotstypes.ErrThresholdKeyShare, hex.EncodeToString(fpPk))
 	}
 
-	lm.input.Reset(passphrase)
-
-	return lm.eotsPrivKeyFromKeyName(keyName)
+	return lm.es.GetEOTSKeyName(fpPk)
 }
 
-func (lm *LocalEOTSManager) eotsPrivKeyFromKeyName(keyName string) (*btcec.PrivateKey, error) {
-	k, err := lm.kr.Key(keyName)
+// getKeyringPrivKey returns the private key kept in the keyring, e.g., the
+// private share of a threshold EOTS key
+func (lm *LocalEOTSManager) getKeyringPrivKey(fpPk []byte, passphrase string) (*btcec.PrivateKey, error) {
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
 		return nil, err
 	}
-	privKeyCached := k.GetLocal().PrivKey.GetCachedValue()
 
-	var privKey *btcec.PrivateKey
-	switch v := privKeyCached.(type) {
-	case *secp256k1.PrivKey:
-		privKey, _ = btcec.PrivKeyFromBytes(v.Key)
-
-		return privKey, nil
-	default:
-		return nil, fmt.Errorf("unsupported key type in keyring")
-	}
+	return lm.krKeys.PrivKey(keyName, passphrase)
 }
 
 func (lm *LocalEOTSManager) keyExists(name string) bool {
-	_, err := lm.kr.Key(name)
+	if _, err := lm.kr.Key(name); err == nil {
+		return true
+	}
+	_, err := lm.keys.PubKey(name)
 
 	return err == nil
 }
@@ -1,6 +1,8 @@
 package eotsmanager_test
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -150,3 +152,88 @@ func FuzzSignRecord(f *testing.F) {
 		}
 	})
 }
+
+// FuzzSignHistory tests that the sign history exported from an EOTS manager keeps
+// the EOTS manager it is imported to from double signing
+func FuzzSignHistory(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		newLocalEOTSManager := func() *eotsmanager.LocalEOTSManager {
+			homeDir := filepath.Join(t.TempDir(), "eots-home")
+			eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+			dbBackend, err := eotsCfg.DatabaseConfig.GetDBBackend()
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				dbBackend.Close()
+			})
+			lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+			require.NoError(t, err)
+
+			return lm
+		}
+		oldLm := newLocalEOTSManager()
+		newLm := newLocalEOTSManager()
+
+		fpPk, err := oldLm.CreateKey(testutil.GenRandomHexStr(r, 4), passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := []byte(testutil.GenRandomHexStr(r, 8))
+		startHeight := datagen.RandomInt(r, 100) + 1
+		gap := datagen.RandomInt(r, 10) + 2
+		msgs := [][]byte{datagen.GenRandomByteArray(r, 32), datagen.GenRandomByteArray(r, 32)}
+		heights := []uint64{startHeight, startHeight + gap}
+		for i, height := range heights {
+			_, err := oldLm.SignEOTS(fpPk, chainID, msgs[i], height, passphrase)
+			require.NoError(t, err)
+		}
+
+		history, err := oldLm.ExportSignHistory(fpPk)
+		require.NoError(t, err)
+		require.Len(t, history.Data, 1)
+		require.Equal(t, heights[1], history.Data[0].WatermarkHeight)
+		require.Len(t, history.Data[0].SignedBlocks, len(heights))
+
+		// the history survives the interchange format
+		historyJSON, err := json.Marshal(history)
+		require.NoError(t, err)
+		var loaded types.SignHistory
+		require.NoError(t, json.Unmarshal(historyJSON, &loaded))
+
+		res, err := newLm.ImportSignHistory(&loaded)
+		require.NoError(t, err)
+		require.Equal(t, len(heights), res.Imported)
+		require.Zero(t, res.Conflicts)
+
+		// the signed message is signed again, while another one is refused
+		sig, err := newLm.SignEOTS(fpPk, chainID, msgs[0], heights[0], passphrase)
+		require.NoError(t, err)
+		oldSig, err := oldLm.SignEOTS(fpPk, chainID, msgs[0], heights[0], passphrase)
+		require.NoError(t, err)
+		require.Equal(t, oldSig, sig)
+		_, err = newLm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), heights[0], passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		// the heights without records at or below the watermark are refused
+		_, err = newLm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), startHeight+1, passphrase)
+		require.ErrorIs(t, err, types.ErrBelowSignWatermark)
+
+		// conflicting records are counted and not imported, and the watermark is
+		// only raised
+		loaded.Data[0].SignedBlocks[0].Msg = hex.EncodeToString(datagen.GenRandomByteArray(r, 32))
+		loaded.Data[0].WatermarkHeight = startHeight
+		res, err = oldLm.ImportSignHistory(&loaded)
+		require.NoError(t, err)
+		require.Zero(t, res.Imported)
+		require.Equal(t, 1, res.Conflicts)
+		_, err = oldLm.SignEOTS(fpPk, chainID, msgs[0], heights[0], passphrase)
+		require.NoError(t, err)
+		_, err = oldLm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), heights[1]+1, passphrase)
+		require.NoError(t, err)
+
+		loaded.Metadata.InterchangeFormatVersion = "0"
+		_, err = newLm.ImportSignHistory(&loaded)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,297 @@
+package pkcs11
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	p11 "github.com/miekg/pkcs11"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+const (
+	// secretLen is the length in bytes of the EOTS secret kept in the HSM
+	secretLen = 32
+)
+
+// privKeyMsg is the message whose HMAC under the EOTS secret is the EOTS private key
+var privKeyMsg = []byte("eots-private-key")
+
+var _ eotsmanager.KeyBackend = &KeyBackend{}
+
+// KeyBackend keeps the EOTS secrets in an HSM accessed through its PKCS#11 module.
+// Each EOTS key is a 32-byte generic secret labeled with the key name, which is
+// generated in the HSM and cannot be extracted from it. The HSM computes the HMAC
+// of the randomness message under the secret, from which the randomness pair is
+// derived in the same way as with the keyring, and the HMAC of a constant message,
+// which is the EOTS private key.
+//
+// PKCS#11 has no mechanism for EOTS or BIP-340 signatures, so signing on the token
+// is not possible, and the signing itself is done in the process with the private
+// key derived by the HSM, which is zeroed right after. The HSM protects the secret
+// at rest and prevents exporting it, but not a compromised process from deriving
+// the private key. As the private key is derived from a secret that never leaves
+// the HSM, existing keyring keys cannot be moved into it.
+type KeyBackend struct {
+	// mu guards the session, which is not safe for concurrent use
+	mu      sync.Mutex
+	ctx     *p11.Ctx
+	session p11.SessionHandle
+
+	pubKeys map[string]*btcec.PublicKey
+}
+
+// NewKeyBackend loads the PKCS#11 module at libraryPath and logs into the token
+// with the given label using the given user PIN
+func NewKeyBackend(libraryPath, tokenLabel, pin string) (*KeyBackend, error) {
+	ctx := p11.New(libraryPath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load the PKCS#11 module %s", libraryPath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+
+		return nil, fmt.Errorf("failed to initialize the PKCS#11 module: %w", err)
+	}
+
+	kb := &KeyBackend{
+		ctx:     ctx,
+		pubKeys: make(map[string]*btcec.PublicKey),
+	}
+	if err := kb.openSession(tokenLabel, pin); err != nil {
+		_ = ctx.Finalize()
+		ctx.Destroy()
+
+		return nil, err
+	}
+
+	return kb, nil
+}
+
+func (kb *KeyBackend) openSession(tokenLabel, pin string) error {
+	slots, err := kb.ctx.GetSlotList(true)
+	if err != nil {
+		return fmt.Errorf("failed to get the PKCS#11 slots: %w", err)
+	}
+
+	for _, slot := range slots {
+		info, err := kb.ctx.GetTokenInfo(slot)
+		if err != nil {
+			return fmt.Errorf("failed to get the info of the token in slot %d: %w", slot, err)
+		}
+		// the label is padded with spaces
+		if strings.TrimSpace(info.Label) != tokenLabel {
+			continue
+		}
+
+		session, err := kb.ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+		if err != nil {
+			return fmt.Errorf("failed to open a session with the token %s: %w", tokenLabel, err)
+		}
+		err = kb.ctx.Login(session, p11.CKU_USER, pin)
+		if err != nil && !errors.Is(err, p11.Error(p11.CKR_USER_ALREADY_LOGGED_IN)) {
+			_ = kb.ctx.CloseSession(session)
+
+			return fmt.Errorf("failed to log into the token %s: %w", tokenLabel, err)
+		}
+		kb.session = session
+
+		return nil
+	}
+
+	return fmt.Errorf("the token %s is not found", tokenLabel)
+}
+
+// GenerateKey generates a new EOTS secret in the HSM at the given name
+// The passphrase and the HD path are not used as the secret is not derived from
+// a mnemonic and is protected by the HSM
+func (kb *KeyBackend) GenerateKey(name, _, _ string) (*btcec.PublicKey, error) {
+	kb.mu.Lock()
+	_, err := kb.findKey(name)
+	switch {
+	case err == nil:
+		kb.mu.Unlock()
+
+		return nil, fmt.Errorf("the key %s already exists in the HSM", name)
+	case !errors.Is(err, types.ErrKeyNotFound):
+		kb.mu.Unlock()
+
+		return nil, err
+	}
+
+	mech := []*p11.Mechanism{p11.NewMechanism(p11.CKM_GENERIC_SECRET_KEY_GEN, nil)}
+	template := append(secretTemplate(name), p11.NewAttribute(p11.CKA_VALUE_LEN, secretLen))
+	_, err = kb.ctx.GenerateKey(kb.session, mech, template)
+	kb.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the key %s in the HSM: %w", name, err)
+	}
+
+	return kb.PubKey(name)
+}
+
+func (kb *KeyBackend) PubKey(name string) (*btcec.PublicKey, error) {
+	kb.mu.Lock()
+	pk, ok := kb.pubKeys[name]
+	kb.mu.Unlock()
+	if ok {
+		return pk, nil
+	}
+
+	privKey, err := kb.privKey(name)
+	if err != nil {
+		return nil, err
+	}
+	defer privKey.Zero()
+	pk = privKey.PubKey()
+
+	kb.mu.Lock()
+	kb.pubKeys[name] = pk
+	kb.mu.Unlock()
+
+	return pk, nil
+}
+
+func (kb *KeyBackend) RandomnessPair(name, _ string, chainID []byte, height uint64) (*eots.PrivateRand, *eots.PublicRand, error) {
+	randPre, err := kb.hmac(name, randgenerator.RandomnessMsg(chainID, height))
+	if err != nil {
+		return nil, nil, err
+	}
+	privRand, pubRand := randgenerator.RandomnessFromDigest(randPre)
+
+	return privRand, pubRand, nil
+}
+
+func (kb *KeyBackend) SignEOTS(name, _ string, privRand *eots.PrivateRand, msg []byte) (*btcec.ModNScalar, error) {
+	privKey, err := kb.privKey(name)
+	if err != nil {
+		return nil, err
+	}
+	defer privKey.Zero()
+
+	return eots.Sign(privKey, privRand, msg)
+}
+
+func (kb *KeyBackend) SignSchnorr(name, _ string, msg []byte) (*schnorr.Signature, error) {
+	privKey, err := kb.privKey(name)
+	if err != nil {
+		return nil, err
+	}
+	defer privKey.Zero()
+
+	return schnorr.Sign(privKey, msg)
+}
+
+// PrivKey always fails as the EOTS secret cannot be exported from the HSM
+func (kb *KeyBackend) PrivKey(_, _ string) (*btcec.PrivateKey, error) {
+	return nil, types.ErrKeyNotExportable
+}
+
+func (kb *KeyBackend) Close() error {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	var errs []error
+	if err := kb.ctx.Logout(kb.session); err != nil {
+		errs = append(errs, fmt.Errorf("failed to log out of the token: %w", err))
+	}
+	if err := kb.ctx.CloseSession(kb.session); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close the session: %w", err))
+	}
+	if err := kb.ctx.Finalize(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to finalize the PKCS#11 module: %w", err))
+	}
+	kb.ctx.Destroy()
+
+	return errors.Join(errs...)
+}
+
+// privKey derives the EOTS private key at the given name in the HSM
+// The caller should zero it once done
+func (kb *KeyBackend) privKey(name string) (*btcec.PrivateKey, error) {
+	digest, err := kb.hmac(name, privKeyMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	var x btcec.ModNScalar
+	overflow := x.SetByteSlice(digest)
+	clear(digest)
+	if overflow || x.IsZero() {
+		// negligible probability
+		return nil, fmt.Errorf("the EOTS secret %s derives an invalid private key", name)
+	}
+
+	return btcec.PrivKeyFromScalar(&x), nil
+}
+
+// hmac computes HMAC-SHA256 over msg in the HSM under the EOTS secret at the given name
+func (kb *KeyBackend) hmac(name string, msg []byte) ([]byte, error) {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+
+	key, err := kb.findKey(name)
+	if err != nil {
+		return nil, err
+	}
+
+	mech := []*p11.Mechanism{p11.NewMechanism(p11.CKM_SHA256_HMAC, nil)}
+	if err := kb.ctx.SignInit(kb.session, mech, key); err != nil {
+		return nil, fmt.Errorf("failed to initialize HMAC with the key %s: %w", name, err)
+	}
+	digest, err := kb.ctx.Sign(kb.session, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute HMAC with the key %s: %w", name, err)
+	}
+
+	return digest, nil
+}
+
+// findKey returns the handle of the EOTS secret at the given name
+// The caller should hold mu
+func (kb *KeyBackend) findKey(name string) (p11.ObjectHandle, error) {
+	template := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+		p11.NewAttribute(p11.CKA_LABEL, name),
+	}
+	if err := kb.ctx.FindObjectsInit(kb.session, template); err != nil {
+		return 0, fmt.Errorf("failed to search for the key %s: %w", name, err)
+	}
+	objs, _, err := kb.ctx.FindObjects(kb.session, 2)
+	if finalErr := kb.ctx.FindObjectsFinal(kb.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to search for the key %s: %w", name, err)
+	}
+
+	switch len(objs) {
+	case 0:
+		return 0, fmt.Errorf("%w: %s", types.ErrKeyNotFound, name)
+	case 1:
+		return objs[0], nil
+	default:
+		return 0, fmt.Errorf("multiple keys are labeled %s in the HSM", name)
+	}
+}
+
+// secretTemplate returns the attributes of an EOTS secret, which is kept on the
+// token, can only be used for HMAC, and cannot be extracted
+func secretTemplate(name string) []*p11.Attribute {
+	return []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_LABEL, name),
+		p11.NewAttribute(p11.CKA_TOKEN, true),
+		p11.NewAttribute(p11.CKA_PRIVATE, true),
+		p11.NewAttribute(p11.CKA_SENSITIVE, true),
+		p11.NewAttribute(p11.CKA_EXTRACTABLE, false),
+		p11.NewAttribute(p11.CKA_SIGN, true),
+		p11.NewAttribute(p11.CKA_VERIFY, true),
+	}
+}
@@ -0,0 +1,139 @@
+package pkcs11_test
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/pkcs11"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// newTestKeyBackend opens the token set in the environment, which is
+// initialized by scripts/test_softhsm.sh
+func newTestKeyBackend(t *testing.T) *pkcs11.KeyBackend {
+	lib := os.Getenv("EOTSD_TEST_PKCS11_LIB")
+	token := os.Getenv("EOTSD_TEST_PKCS11_TOKEN")
+	pin := os.Getenv("EOTSD_TEST_PKCS11_PIN")
+	if lib == "" || token == "" {
+		t.Skip("EOTSD_TEST_PKCS11_LIB and EOTSD_TEST_PKCS11_TOKEN are not set; run make test-softhsm")
+	}
+
+	kb, err := pkcs11.NewKeyBackend(lib, token, pin)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, kb.Close())
+	})
+
+	return kb
+}
+
+func TestPKCS11KeyBackend(t *testing.T) {
+	kb := newTestKeyBackend(t)
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+	dbBackend, err := eotsCfg.DatabaseConfig.GetDBBackend()
+	require.NoError(t, err)
+	defer dbBackend.Close()
+
+	lm, err := eotsmanager.NewLocalEOTSManagerWithKeyBackend(homeDir, eotsCfg.KeyringBackend, kb, dbBackend, zap.NewNop())
+	require.NoError(t, err)
+
+	fpName := testutil.GenRandomHexStr(r, 8)
+	fpPk, err := lm.CreateKey(fpName, "", "")
+	require.NoError(t, err)
+	eotsPk, err := bbntypes.NewBIP340PubKey(fpPk)
+	require.NoError(t, err)
+
+	_, err = lm.CreateKey(fpName, "", "")
+	require.ErrorIs(t, err, types.ErrFinalityProviderAlreadyExisted)
+
+	loadedPk, err := lm.LoadBIP340PubKeyFromKeyName(fpName)
+	require.NoError(t, err)
+	require.True(t, eotsPk.Equals(loadedPk))
+
+	// the private key cannot be exported
+	_, err = lm.KeyRecord(fpPk, "")
+	require.ErrorIs(t, err, types.ErrKeyNotExportable)
+
+	// the randomness is deterministic and the EOTS signatures verify
+	chainID := datagen.GenRandomByteArray(r, 10)
+	startHeight := datagen.RandomInt(r, 100)
+	num := r.Intn(10) + 1
+	pubRandList, err := lm.CreateRandomnessPairList(fpPk, chainID, startHeight, uint32(num), "")
+	require.NoError(t, err)
+	require.Len(t, pubRandList, num)
+	pubRandList2, err := lm.CreateRandomnessPairList(fpPk, chainID, startHeight, uint32(num), "")
+	require.NoError(t, err)
+	require.Equal(t, pubRandList, pubRandList2)
+
+	for i := 0; i < num; i++ {
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig, err := lm.SignEOTS(fpPk, chainID, msg, startHeight+uint64(i), "")
+		require.NoError(t, err)
+		require.NoError(t, eots.Verify(eotsPk.MustToBTCPK(), pubRandList[i], msg, sig))
+	}
+
+	msg := datagen.GenRandomByteArray(r, 32)
+	schnorrSig, err := lm.SignSchnorrSig(fpPk, msg, "")
+	require.NoError(t, err)
+	require.True(t, schnorrSig.Verify(msg, eotsPk.MustToBTCPK()))
+}
+
+func TestPKCS11KeyBackendGenerateKey(t *testing.T) {
+	kb := newTestKeyBackend(t)
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	name := testutil.GenRandomHexStr(r, 8)
+	pk, err := kb.GenerateKey(name, "", "")
+	require.NoError(t, err)
+
+	_, err = kb.GenerateKey(name, "", "")
+	require.Error(t, err)
+
+	loadedPk, err := kb.PubKey(name)
+	require.NoError(t, err)
+	require.True(t, pk.IsEqual(loadedPk))
+
+	// the randomness is derived deterministically in the HSM
+	chainID := datagen.GenRandomByteArray(r, 10)
+	height := datagen.RandomInt(r, 100)
+	privRand1, pubRand1, err := kb.RandomnessPair(name, "", chainID, height)
+	require.NoError(t, err)
+	privRand2, pubRand2, err := kb.RandomnessPair(name, "", chainID, height)
+	require.NoError(t, err)
+	require.True(t, privRand1.Equals(privRand2))
+	require.True(t, pubRand1.Equals(pubRand2))
+
+	msg := datagen.GenRandomByteArray(r, 32)
+	sig, err := kb.SignEOTS(name, "", privRand1, msg)
+	require.NoError(t, err)
+	require.NoError(t, eots.Verify(pk, pubRand1, msg, sig))
+
+	schnorrSig, err := kb.SignSchnorr(name, "", msg)
+	require.NoError(t, err)
+	_, err = schnorr.ParseSignature(schnorrSig.Serialize())
+	require.NoError(t, err)
+	require.True(t, schnorrSig.Verify(msg, pk))
+
+	// the secret cannot be exported
+	_, err = kb.PrivKey(name, "")
+	require.ErrorIs(t, err, types.ErrKeyNotExportable)
+
+	_, err = kb.PubKey(testutil.GenRandomHexStr(r, 8))
+	require.ErrorIs(t, err, types.ErrKeyNotFound)
+}
@@ -27,6 +27,10 @@ const (
 	EOTSManager_UnsafeSignEOTS_FullMethodName           = "/proto.EOTSManager/UnsafeSignEOTS"
 	EOTSManager_SignSchnorrSig_FullMethodName           = "/proto.EOTSManager/SignSchnorrSig"
 	EOTSManager_SaveEOTSKeyName_FullMethodName          = "/proto.EOTSManager/SaveEOTSKeyName"
+	EOTSManager_PubRandShareList_FullMethodName         = "/proto.EOTSManager/PubRandShareList"
+	EOTSManager_SignEOTSShare_FullMethodName            = "/proto.EOTSManager/SignEOTSShare"
+	EOTSManager_SchnorrNonceShare_FullMethodName        = "/proto.EOTSManager/SchnorrNonceShare"
+	EOTSManager_SignSchnorrSigShare_FullMethodName      = "/proto.EOTSManager/SignSchnorrSigShare"
 )
 
 // EOTSManagerClient is the client API for EOTSManager service.
@@ -48,6 +52,18 @@ type EOTSManagerClient interface {
 	SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error)
 	// SaveEOTSKeyName saves a new key name mapping for the EOTS public key
 	SaveEOTSKeyName(ctx context.Context, in *SaveEOTSKeyNameRequest, opts ...grpc.CallOption) (*SaveEOTSKeyNameResponse, error)
+	// PubRandShareList returns a list of the shares of the Schnorr public
+	// randomness of a threshold EOTS key held by this EOTS manager
+	PubRandShareList(ctx context.Context, in *CreateRandomnessPairListRequest, opts ...grpc.CallOption) (*PubRandShareListResponse, error)
+	// SignEOTSShare signs a partial EOTS with the share of a threshold EOTS key
+	// held by this EOTS manager and the relevant randomness share
+	SignEOTSShare(ctx context.Context, in *SignEOTSShareRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error)
+	// SchnorrNonceShare returns the share of the public nonce of the Schnorr
+	// signature of a message with a threshold EOTS key
+	SchnorrNonceShare(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*PubRandShareListResponse, error)
+	// SignSchnorrSigShare signs a partial Schnorr sig with the share of a
+	// threshold EOTS key held by this EOTS manager
+	SignSchnorrSigShare(ctx context.Context, in *SignSchnorrSigShareRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error)
 }
 
 type eOTSManagerClient struct {
@@ -130,6 +146,42 @@ func (c *eOTSManagerClient) SaveEOTSKeyName(ctx context.Context, in *SaveEOTSKey
 	return out, nil
 }
 
+func (c *eOTSManagerClient) PubRandShareList(ctx context.Context, in *CreateRandomnessPairListRequest, opts ...grpc.CallOption) (*PubRandShareListResponse, error) {
+	out := new(PubRandShareListResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_PubRandShareList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) SignEOTSShare(ctx context.Context, in *SignEOTSShareRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error) {
+	out := new(SignEOTSResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_SignEOTSShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) SchnorrNonceShare(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*PubRandShareListResponse, error) {
+	out := new(PubRandShareListResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_SchnorrNonceShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) SignSchnorrSigShare(ctx context.Context, in *SignSchnorrSigShareRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error) {
+	out := new(SignEOTSResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_SignSchnorrSigShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EOTSManagerServer is the server API for EOTSManager service.
 // All implementations must embed UnimplementedEOTSManagerServer
 // for forward compatibility
@@ -149,6 +201,18 @@ type EOTSManagerServer interface {
 	SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error)
 	// SaveEOTSKeyName saves a new key name mapping for the EOTS public key
 	SaveEOTSKeyName(context.Context, *SaveEOTSKeyNameRequest) (*SaveEOTSKeyNameResponse, error)
+	// PubRandShareList returns a list of the shares of the Schnorr public
+	// randomness of a threshold EOTS key held by this EOTS manager
+	PubRandShareList(context.Context, *CreateRandomnessPairListRequest) (*PubRandShareListResponse, error)
+	// SignEOTSShare signs a partial EOTS with the share of a threshold EOTS key
+	// held by this EOTS manager and the relevant randomness share
+	SignEOTSShare(context.Context, *SignEOTSShareRequest) (*SignEOTSResponse, error)
+	// SchnorrNonceShare returns the share of the public nonce of the Schnorr
+	// signature of a message with a threshold EOTS key
+	SchnorrNonceShare(context.Context, *SignSchnorrSigRequest) (*PubRandShareListResponse, error)
+	// SignSchnorrSigShare signs a partial Schnorr sig with the share of a
+	// threshold EOTS key held by this EOTS manager
+	SignSchnorrSigShare(context.Context, *SignSchnorrSigShareRequest) (*SignEOTSResponse, error)
 	mustEmbedUnimplementedEOTSManagerServer()
 }
 
@@ -180,6 +244,18 @@ func (UnimplementedEOTSManagerServer) SignSchnorrSig(context.Context, *SignSchno
 func (UnimplementedEOTSManagerServer) SaveEOTSKeyName(context.Context, *SaveEOTSKeyNameRequest) (*SaveEOTSKeyNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveEOTSKeyName not implemented")
 }
+func (UnimplementedEOTSManagerServer) PubRandShareList(context.Context, *CreateRandomnessPairListRequest) (*PubRandShareListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubRandShareList not implemented")
+}
+func (UnimplementedEOTSManagerServer) SignEOTSShare(context.Context, *SignEOTSShareRequest) (*SignEOTSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignEOTSShare not implemented")
+}
+func (UnimplementedEOTSManagerServer) SchnorrNonceShare(context.Context, *SignSchnorrSigRequest) (*PubRandShareListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchnorrNonceShare not implemented")
+}
+func (UnimplementedEOTSManagerServer) SignSchnorrSigShare(context.Context, *SignSchnorrSigShareRequest) (*SignEOTSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSchnorrSigShare not implemented")
+}
 func (UnimplementedEOTSManagerServer) mustEmbedUnimplementedEOTSManagerServer() {}
 
 // UnsafeEOTSManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -337,6 +413,78 @@ func _EOTSManager_SaveEOTSKeyName_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_PubRandShareList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRandomnessPairListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).PubRandShareList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_PubRandShareList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).PubRandShareList(ctx, req.(*CreateRandomnessPairListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_SignEOTSShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignEOTSShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).SignEOTSShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_SignEOTSShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).SignEOTSShare(ctx, req.(*SignEOTSShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_SchnorrNonceShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSchnorrSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).SchnorrNonceShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_SchnorrNonceShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).SchnorrNonceShare(ctx, req.(*SignSchnorrSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_SignSchnorrSigShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSchnorrSigShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).SignSchnorrSigShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_SignSchnorrSigShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).SignSchnorrSigShare(ctx, req.(*SignSchnorrSigShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EOTSManager_ServiceDesc is the grpc.ServiceDesc for EOTSManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -376,6 +524,22 @@ var EOTSManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SaveEOTSKeyName",
 			Handler:    _EOTSManager_SaveEOTSKeyName_Handler,
 		},
+		{
+			MethodName: "PubRandShareList",
+			Handler:    _EOTSManager_PubRandShareList_Handler,
+		},
+		{
+			MethodName: "SignEOTSShare",
+			Handler:    _EOTSManager_SignEOTSShare_Handler,
+		},
+		{
+			MethodName: "SchnorrNonceShare",
+			Handler:    _EOTSManager_SchnorrNonceShare_Handler,
+		},
+		{
+			MethodName: "SignSchnorrSigShare",
+			Handler:    _EOTSManager_SignSchnorrSigShare_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eotsmanager.proto",
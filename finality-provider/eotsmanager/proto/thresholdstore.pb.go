@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: thresholdstore.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ThresholdKeyShare represents the share of a threshold EOTS key held by this
+// EOTS manager, whose private share is kept in the keyring.
+// it is keyed by the BIP-340 public key of the threshold EOTS key
+type ThresholdKeyShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_pk is the threshold EOTS public key in compressed format
+	GroupPk []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+	// index is the index of the share, from 1 to num_parties
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// threshold is the number of shares needed to sign
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// num_parties is the number of shares of the key
+	NumParties uint32 `protobuf:"varint,4,opt,name=num_parties,json=numParties,proto3" json:"num_parties,omitempty"`
+	// sealed_rand_seeds are the randomness seeds of the share, encrypted with
+	// a key derived from the private share
+	SealedRandSeeds []byte `protobuf:"bytes,5,opt,name=sealed_rand_seeds,json=sealedRandSeeds,proto3" json:"sealed_rand_seeds,omitempty"`
+}
+
+func (x *ThresholdKeyShare) Reset() {
+	*x = ThresholdKeyShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thresholdstore_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdKeyShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdKeyShare) ProtoMessage() {}
+
+func (x *ThresholdKeyShare) ProtoReflect() protoreflect.Message {
+	mi := &file_thresholdstore_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdKeyShare.ProtoReflect.Descriptor instead.
+func (*ThresholdKeyShare) Descriptor() ([]byte, []int) {
+	return file_thresholdstore_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ThresholdKeyShare) GetGroupPk() []byte {
+	if x != nil {
+		return x.GroupPk
+	}
+	return nil
+}
+
+func (x *ThresholdKeyShare) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ThresholdKeyShare) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *ThresholdKeyShare) GetNumParties() uint32 {
+	if x != nil {
+		return x.NumParties
+	}
+	return 0
+}
+
+func (x *ThresholdKeyShare) GetSealedRandSeeds() []byte {
+	if x != nil {
+		return x.SealedRandSeeds
+	}
+	return nil
+}
+
+// SchnorrSigningRecord represents a record of a partial Schnorr signature with
+// the share of a threshold EOTS key, binding the message to the public nonce.
+// it is keyed by (public_key || msg)
+type SchnorrSigningRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pub_nonce is the combined public nonce that the signature is signed with,
+	// in compressed format
+	PubNonce []byte `protobuf:"bytes,1,opt,name=pub_nonce,json=pubNonce,proto3" json:"pub_nonce,omitempty"`
+	// partial_sig is the partial Schnorr signature
+	PartialSig []byte `protobuf:"bytes,2,opt,name=partial_sig,json=partialSig,proto3" json:"partial_sig,omitempty"`
+	// timestamp is the timestamp of the signing operation, in Unix seconds.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SchnorrSigningRecord) Reset() {
+	*x = SchnorrSigningRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thresholdstore_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchnorrSigningRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchnorrSigningRecord) ProtoMessage() {}
+
+func (x *SchnorrSigningRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_thresholdstore_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchnorrSigningRecord.ProtoReflect.Descriptor instead.
+func (*SchnorrSigningRecord) Descriptor() ([]byte, []int) {
+	return file_thresholdstore_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SchnorrSigningRecord) GetPubNonce() []byte {
+	if x != nil {
+		return x.PubNonce
+	}
+	return nil
+}
+
+func (x *SchnorrSigningRecord) GetPartialSig() []byte {
+	if x != nil {
+		return x.PartialSig
+	}
+	return nil
+}
+
+func (x *SchnorrSigningRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+var File_thresholdstore_proto protoreflect.FileDescriptor
+
+var file_thresholdstore_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01,
+	0x0a, 0x11, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x61,
+	0x6e, 0x64, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x65, 0x64, 0x73, 0x22,
+	0x72, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x75, 0x62, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x61, 0x62, 0x79, 0x6c, 0x6f, 0x6e, 0x6c, 0x61, 0x62, 0x73, 0x2d, 0x69, 0x6f,
+	0x2f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2f, 0x65, 0x6f, 0x74, 0x73, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_thresholdstore_proto_rawDescOnce sync.Once
+	file_thresholdstore_proto_rawDescData = file_thresholdstore_proto_rawDesc
+)
+
+func file_thresholdstore_proto_rawDescGZIP() []byte {
+	file_thresholdstore_proto_rawDescOnce.Do(func() {
+		file_thresholdstore_proto_rawDescData = protoimpl.X.CompressGZIP(file_thresholdstore_proto_rawDescData)
+	})
+	return file_thresholdstore_proto_rawDescData
+}
+
+var file_thresholdstore_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_thresholdstore_proto_goTypes = []interface{}{
+	(*ThresholdKeyShare)(nil),    // 0: proto.ThresholdKeyShare
+	(*SchnorrSigningRecord)(nil), // 1: proto.SchnorrSigningRecord
+}
+var file_thresholdstore_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_thresholdstore_proto_init() }
+func file_thresholdstore_proto_init() {
+	if File_thresholdstore_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_thresholdstore_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdKeyShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thresholdstore_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchnorrSigningRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_thresholdstore_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_thresholdstore_proto_goTypes,
+		DependencyIndexes: file_thresholdstore_proto_depIdxs,
+		MessageInfos:      file_thresholdstore_proto_msgTypes,
+	}.Build()
+	File_thresholdstore_proto = out.File
+	file_thresholdstore_proto_rawDesc = nil
+	file_thresholdstore_proto_goTypes = nil
+	file_thresholdstore_proto_depIdxs = nil
+}
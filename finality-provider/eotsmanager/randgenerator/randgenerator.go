@@ -15,9 +15,22 @@ import (
 func GenerateRandomness(key []byte, chainID []byte, height uint64) (*eots.PrivateRand, *eots.PublicRand) {
 	// calculate the randomn hash of the key concatenated with chainID and height
 	digest := hmac.New(sha256.New, key)
-	digest.Write(append(sdk.Uint64ToBigEndian(height), chainID...))
+	digest.Write(RandomnessMsg(chainID, height))
 	randPre := digest.Sum(nil)
 
+	return RandomnessFromDigest(randPre)
+}
+
+// RandomnessMsg returns the message whose HMAC is the randomness of the given
+// chain at the given height
+func RandomnessMsg(chainID []byte, height uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(height), chainID...)
+}
+
+// RandomnessFromDigest converts the HMAC of the randomness message into the
+// randomness pair, so that the HMAC can be computed outside of the process,
+// e.g., by an HSM
+func RandomnessFromDigest(randPre []byte) (*eots.PrivateRand, *eots.PublicRand) {
 	// convert the hash into private random
 	var randScalar btcec.ModNScalar
 	randScalar.SetByteSlice(randPre)
@@ -5,6 +5,8 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
@@ -136,3 +138,103 @@ func (r *rpcServer) SaveEOTSKeyName(
 
 	return &proto.SaveEOTSKeyNameResponse{}, r.em.SaveEOTSKeyName(eotsPk, req.KeyName)
 }
+
+// PubRandShareList returns a list of the shares of the Schnorr public randomness
+// of a threshold EOTS key
+func (r *rpcServer) PubRandShareList(_ context.Context, req *proto.CreateRandomnessPairListRequest) (
+	*proto.PubRandShareListResponse, error) {
+	tp, ok := r.em.(eotsmanager.ThresholdParticipant)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the EOTS manager does not support threshold EOTS keys")
+	}
+
+	shareList, err := tp.PubRandShareList(req.Uid, req.ChainId, req.StartHeight, req.Num, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	pubRandShareBytesList := make([][]byte, 0, len(shareList.PubRandShares))
+	for _, p := range shareList.PubRandShares {
+		pubRandShareBytesList = append(pubRandShareBytesList, p.SerializeCompressed())
+	}
+
+	return &proto.PubRandShareListResponse{
+		Index:            shareList.Index,
+		Threshold:        shareList.Threshold,
+		PubRandShareList: pubRandShareBytesList,
+	}, nil
+}
+
+// SignEOTSShare signs a partial EOTS with the share of a threshold EOTS key and
+// the relevant randomness share
+func (r *rpcServer) SignEOTSShare(_ context.Context, req *proto.SignEOTSShareRequest) (
+	*proto.SignEOTSResponse, error) {
+	tp, ok := r.em.(eotsmanager.ThresholdParticipant)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the EOTS manager does not support threshold EOTS keys")
+	}
+
+	pubRand, err := btcec.ParsePubKey(req.PubRand)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public randomness: %v", err)
+	}
+
+	sig, err := tp.SignEOTSShare(req.Uid, req.ChainId, req.Msg, req.Height, pubRand, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes := sig.Bytes()
+
+	return &proto.SignEOTSResponse{Sig: sigBytes[:]}, nil
+}
+
+// SchnorrNonceShare returns the share of the public nonce of the Schnorr signature
+// of a message with a threshold EOTS key
+func (r *rpcServer) SchnorrNonceShare(_ context.Context, req *proto.SignSchnorrSigRequest) (
+	*proto.PubRandShareListResponse, error) {
+	tp, ok := r.em.(eotsmanager.ThresholdParticipant)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the EOTS manager does not support threshold EOTS keys")
+	}
+
+	shareList, err := tp.SchnorrNonceShare(req.Uid, req.Msg, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	pubNonceShareBytesList := make([][]byte, 0, len(shareList.PubRandShares))
+	for _, p := range shareList.PubRandShares {
+		pubNonceShareBytesList = append(pubNonceShareBytesList, p.SerializeCompressed())
+	}
+
+	return &proto.PubRandShareListResponse{
+		Index:            shareList.Index,
+		Threshold:        shareList.Threshold,
+		PubRandShareList: pubNonceShareBytesList,
+	}, nil
+}
+
+// SignSchnorrSigShare signs a partial Schnorr sig with the share of a threshold
+// EOTS key
+func (r *rpcServer) SignSchnorrSigShare(_ context.Context, req *proto.SignSchnorrSigShareRequest) (
+	*proto.SignEOTSResponse, error) {
+	tp, ok := r.em.(eotsmanager.ThresholdParticipant)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the EOTS manager does not support threshold EOTS keys")
+	}
+
+	pubNonce, err := btcec.ParsePubKey(req.PubNonce)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public nonce: %v", err)
+	}
+
+	sig, err := tp.SignSchnorrSigShare(req.Uid, req.Msg, pubNonce, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes := sig.Bytes()
+
+	return &proto.SignEOTSResponse{Sig: sigBytes[:]}, nil
+}
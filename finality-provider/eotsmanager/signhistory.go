@@ -0,0 +1,136 @@
+package eotsmanager
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	eotstypes "github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+// ExportSignHistory exports the sign records and watermarks of the given EOTS key
+// on all chains in the sign history interchange format. The watermark of each
+// chain is the highest height the EOTS key signed at, or was imported with.
+func (lm *LocalEOTSManager) ExportSignHistory(eotsPk []byte) (*eotstypes.SignHistory, error) {
+	records, err := lm.es.ListSignRecords(eotsPk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sign records: %w", err)
+	}
+
+	watermarks, err := lm.es.ListSignWatermarks(eotsPk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sign watermarks: %w", err)
+	}
+
+	pkHex := hex.EncodeToString(eotsPk)
+	entries := make(map[string]*eotstypes.SignHistoryEntry)
+	getEntry := func(chainID string) *eotstypes.SignHistoryEntry {
+		entry, ok := entries[chainID]
+		if !ok {
+			entry = &eotstypes.SignHistoryEntry{EotsPk: pkHex, ChainID: chainID}
+			entries[chainID] = entry
+		}
+
+		return entry
+	}
+
+	for chainID, watermark := range watermarks {
+		getEntry(chainID).WatermarkHeight = watermark
+	}
+	for _, record := range records {
+		entry := getEntry(string(record.ChainID))
+		entry.SignedBlocks = append(entry.SignedBlocks, &eotstypes.SignedBlock{
+			Height:    record.Height,
+			Msg:       hex.EncodeToString(record.Msg),
+			EotsSig:   hex.EncodeToString(record.Signature),
+			Timestamp: record.Timestamp,
+		})
+		if record.Height > entry.WatermarkHeight {
+			entry.WatermarkHeight = record.Height
+		}
+	}
+
+	history := &eotstypes.SignHistory{
+		Metadata: eotstypes.SignHistoryMetadata{
+			InterchangeFormatVersion: eotstypes.SignHistoryFormatVersion,
+			ExportedAt:               time.Now().UnixMilli(),
+		},
+		Data: make([]*eotstypes.SignHistoryEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		sort.Slice(entry.SignedBlocks, func(i, j int) bool {
+			return entry.SignedBlocks[i].Height < entry.SignedBlocks[j].Height
+		})
+		history.Data = append(history.Data, entry)
+	}
+	sort.Slice(history.Data, func(i, j int) bool {
+		return history.Data[i].ChainID < history.Data[j].ChainID
+	})
+
+	return history, nil
+}
+
+// ImportSignHistory merges the given sign history into the sign records of this
+// EOTS manager. The sign records in the db are kept over the conflicting ones of
+// the history, and the EOTS manager refuses to sign at any height at or below the
+// imported watermarks from then on.
+func (lm *LocalEOTSManager) ImportSignHistory(history *eotstypes.SignHistory) (*eotstypes.SignHistoryImportResult, error) {
+	if err := history.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := &eotstypes.SignHistoryImportResult{}
+	for _, entry := range history.Data {
+		eotsPk, err := bbntypes.NewBIP340PubKeyFromHex(entry.EotsPk)
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]*store.SignRecordEntry, 0, len(entry.SignedBlocks))
+		for _, block := range entry.SignedBlocks {
+			// well-formed as validated above
+			msg, _ := hex.DecodeString(block.Msg)
+			sig, _ := hex.DecodeString(block.EotsSig)
+			records = append(records, &store.SignRecordEntry{
+				SigningRecord: store.SigningRecord{
+					Msg:       msg,
+					Signature: sig,
+					Timestamp: block.Timestamp,
+				},
+				ChainID: []byte(entry.ChainID),
+				Height:  block.Height,
+			})
+		}
+
+		imported, conflicts, err := lm.es.ImportSignHistory(eotsPk.MustMarshal(), []byte(entry.ChainID), records, entry.WatermarkHeight)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import the sign history of %s on chain %s: %w", entry.EotsPk, entry.ChainID, err)
+		}
+		if conflicts > 0 {
+			lm.logger.Warn(
+				"imported sign history conflicts with the sign records",
+				zap.String("eots_pk", entry.EotsPk),
+				zap.String("chainID", entry.ChainID),
+				zap.Int("conflicts", conflicts),
+			)
+		}
+
+		lm.logger.Info(
+			"successfully imported the sign history",
+			zap.String("eots_pk", entry.EotsPk),
+			zap.String("chainID", entry.ChainID),
+			zap.Uint64("watermark", entry.WatermarkHeight),
+			zap.Int("imported", imported),
+		)
+
+		res.Imported += imported
+		res.Conflicts += conflicts
+	}
+
+	return res, nil
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"time"
@@ -12,12 +13,22 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcwallet/walletdb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
 	eotsBucketName       = []byte("fpKeyNames")
 	signRecordBucketName = []byte("signRecord")
+	// thresholdKeyShareBucketName maps the BIP-340 public key of a threshold EOTS
+	// key to the share of it held by this EOTS manager
+	thresholdKeyShareBucketName = []byte("thresholdKeyShares")
+	// schnorrSignRecordBucketName keeps the partial Schnorr signatures with the
+	// shares of threshold EOTS keys
+	schnorrSignRecordBucketName = []byte("schnorrSignRecord")
+	// signWatermarkBucketName maps (chainID || pk) to the height at or below which
+	// the EOTS key is considered to have signed, as imported from its sign history
+	signWatermarkBucketName = []byte("signWatermark")
 )
 
 type EOTSStore struct {
@@ -45,6 +56,21 @@ func (s *EOTSStore) initBuckets() error {
 			return err
 		}
 
+		_, err = tx.CreateTopLevelBucket(thresholdKeyShareBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(schnorrSignRecordBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(signWatermarkBucketName)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -201,6 +227,302 @@ func (s *EOTSStore) GetSignRecord(eotsPk, chainID []byte, height uint64) (*Signi
 	return res, true, nil
 }
 
+// ListSignRecords returns the sign records of the given EOTS key on all chains
+func (s *EOTSStore) ListSignRecords(eotsPk []byte) ([]*SignRecordEntry, error) {
+	var entries []*SignRecordEntry
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(signRecordBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			chainID, height, ok := parseSignRecordKey(k, eotsPk)
+			if !ok {
+				return nil
+			}
+
+			protoRes := &proto.SigningRecord{}
+			if err := pm.Unmarshal(v, protoRes); err != nil {
+				return err
+			}
+
+			entry := &SignRecordEntry{ChainID: chainID, Height: height}
+			entry.FromProto(protoRes)
+			entries = append(entries, entry)
+
+			return nil
+		})
+	}, func() {
+		entries = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetSignWatermark returns the height at or below which the EOTS key is considered
+// to have signed on the given chain, and whether such a watermark was imported
+func (s *EOTSStore) GetSignWatermark(eotsPk, chainID []byte) (uint64, bool, error) {
+	var (
+		watermark uint64
+		found     bool
+	)
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(signWatermarkBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		watermarkBytes := bucket.Get(getSignWatermarkKey(chainID, eotsPk))
+		if watermarkBytes == nil {
+			return nil
+		}
+
+		watermark = sdk.BigEndianToUint64(watermarkBytes)
+		found = true
+
+		return nil
+	}, func() {
+		watermark = 0
+		found = false
+	})
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return watermark, found, nil
+}
+
+// ListSignWatermarks returns the sign watermarks of the given EOTS key by chain ID
+func (s *EOTSStore) ListSignWatermarks(eotsPk []byte) (map[string]uint64, error) {
+	watermarks := make(map[string]uint64)
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(signWatermarkBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) < len(eotsPk) || !bytes.Equal(k[len(k)-len(eotsPk):], eotsPk) {
+				return nil
+			}
+			watermarks[string(k[:len(k)-len(eotsPk)])] = sdk.BigEndianToUint64(v)
+
+			return nil
+		})
+	}, func() {
+		watermarks = make(map[string]uint64)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return watermarks, nil
+}
+
+// ImportSignHistory merges the sign records and the watermark of the given EOTS
+// key on the given chain into the db, atomically. A sign record conflicting with
+// the one in the db at the same height is not imported, and is counted in the
+// returned number of conflicts. The watermark is raised to the highest of the
+// given one, the heights of the records, and the one in the db.
+func (s *EOTSStore) ImportSignHistory(
+	eotsPk, chainID []byte,
+	records []*SignRecordEntry,
+	watermark uint64,
+) (imported int, conflicts int, err error) {
+	for _, record := range records {
+		if record.Height > watermark {
+			watermark = record.Height
+		}
+	}
+
+	err = kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		signBucket := tx.ReadWriteBucket(signRecordBucketName)
+		if signBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+		watermarkBucket := tx.ReadWriteBucket(signWatermarkBucketName)
+		if watermarkBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		for _, record := range records {
+			key := getSignRecordKey(chainID, eotsPk, record.Height)
+			if existing := signBucket.Get(key); existing != nil {
+				existingRecord := &proto.SigningRecord{}
+				if err := pm.Unmarshal(existing, existingRecord); err != nil {
+					return err
+				}
+				if !bytes.Equal(existingRecord.Msg, record.Msg) {
+					conflicts++
+				}
+
+				continue
+			}
+
+			marshalled, err := pm.Marshal(&proto.SigningRecord{
+				Msg:       record.Msg,
+				EotsSig:   record.Signature,
+				Timestamp: record.Timestamp,
+			})
+			if err != nil {
+				return err
+			}
+			if err := signBucket.Put(key, marshalled); err != nil {
+				return err
+			}
+			imported++
+		}
+
+		watermarkKey := getSignWatermarkKey(chainID, eotsPk)
+		if existing := watermarkBucket.Get(watermarkKey); existing != nil && sdk.BigEndianToUint64(existing) >= watermark {
+			return nil
+		}
+
+		return watermarkBucket.Put(watermarkKey, sdk.Uint64ToBigEndian(watermark))
+	}, func() {
+		imported = 0
+		conflicts = 0
+	})
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return imported, conflicts, nil
+}
+
+// SaveThresholdKeyShare saves the share of a threshold EOTS key held by this EOTS manager
+func (s *EOTSStore) SaveThresholdKeyShare(share *ThresholdKeyShareRecord) error {
+	key := schnorr.SerializePubKey(share.GroupPk)
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(thresholdKeyShareBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		if bucket.Get(key) != nil {
+			return ErrDuplicateThresholdKeyShare
+		}
+
+		marshalled, err := pm.Marshal(share.ToProto())
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, marshalled)
+	})
+}
+
+// GetThresholdKeyShare returns the share of the threshold EOTS key with the given
+// BIP-340 public key, and whether this EOTS manager holds a share of it
+func (s *EOTSStore) GetThresholdKeyShare(eotsPk []byte) (*ThresholdKeyShareRecord, bool, error) {
+	protoRes := &proto.ThresholdKeyShare{}
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(thresholdKeyShareBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		shareBytes := bucket.Get(eotsPk)
+		if shareBytes == nil {
+			return ErrThresholdKeyShareNotFound
+		}
+
+		return pm.Unmarshal(shareBytes, protoRes)
+	}, func() {})
+
+	if err != nil {
+		if errors.Is(err, ErrThresholdKeyShareNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	res := &ThresholdKeyShareRecord{}
+	if err := res.FromProto(protoRes); err != nil {
+		return nil, false, err
+	}
+
+	return res, true, nil
+}
+
+// SaveSchnorrSignRecord saves the partial Schnorr signature of the message with
+// the share of the threshold EOTS key
+func (s *EOTSStore) SaveSchnorrSignRecord(eotsPk, msg, pubNonce, partialSig []byte) error {
+	key := getSchnorrSignRecordKey(eotsPk, msg)
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(schnorrSignRecordBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		if bucket.Get(key) != nil {
+			return ErrDuplicateSignRecord
+		}
+
+		signRecord := &proto.SchnorrSigningRecord{
+			PubNonce:   pubNonce,
+			PartialSig: partialSig,
+			Timestamp:  time.Now().UnixMilli(),
+		}
+
+		marshalled, err := pm.Marshal(signRecord)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, marshalled)
+	})
+}
+
+// GetSchnorrSignRecord returns the partial Schnorr signature of the message with
+// the share of the threshold EOTS key, and whether it was signed
+func (s *EOTSStore) GetSchnorrSignRecord(eotsPk, msg []byte) (*SchnorrSigningRecord, bool, error) {
+	key := getSchnorrSignRecordKey(eotsPk, msg)
+	protoRes := &proto.SchnorrSigningRecord{}
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(schnorrSignRecordBucketName)
+		if bucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		signRecordBytes := bucket.Get(key)
+		if signRecordBytes == nil {
+			return ErrSignRecordNotFound
+		}
+
+		return pm.Unmarshal(signRecordBytes, protoRes)
+	}, func() {})
+
+	if err != nil {
+		if errors.Is(err, ErrSignRecordNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	res := &SchnorrSigningRecord{}
+	res.FromProto(protoRes)
+
+	return res, true, nil
+}
+
 func (s *EOTSStore) Close() error {
 	return s.db.Close()
 }
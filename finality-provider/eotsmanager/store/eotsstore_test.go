@@ -176,3 +176,54 @@ func FuzzListKeysEOTSStore(f *testing.F) {
 		}
 	})
 }
+
+// FuzzThresholdKeyShareStore tests save and get threshold key shares
+func FuzzThresholdKeyShareStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		dbBackend, err := cfg.GetDBBackend()
+		require.NoError(t, err)
+
+		vs, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+
+		defer func() {
+			dbBackend.Close()
+		}()
+
+		_, groupPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		share := &store.ThresholdKeyShareRecord{
+			GroupPk:         groupPk,
+			Index:           uint32(r.Intn(3)) + 1,
+			Threshold:       2,
+			NumParties:      3,
+			SealedRandSeeds: testutil.GenRandomByteArray(r, 100),
+		}
+
+		err = vs.SaveThresholdKeyShare(share)
+		require.NoError(t, err)
+
+		err = vs.SaveThresholdKeyShare(share)
+		require.ErrorIs(t, err, store.ErrDuplicateThresholdKeyShare)
+
+		shareFromDB, found, err := vs.GetThresholdKeyShare(schnorr.SerializePubKey(groupPk))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, share.GroupPk.SerializeCompressed(), shareFromDB.GroupPk.SerializeCompressed())
+		require.Equal(t, share.Index, shareFromDB.Index)
+		require.Equal(t, share.SealedRandSeeds, shareFromDB.SealedRandSeeds)
+
+		_, randomBtcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, found, err = vs.GetThresholdKeyShare(schnorr.SerializePubKey(randomBtcPk))
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+}
@@ -17,4 +17,10 @@ var (
 
 	// ErrDuplicateSignRecord indicates err if sign record is already saved at given height
 	ErrDuplicateSignRecord = errors.New("sign record for given height already exists")
+
+	// ErrThresholdKeyShareNotFound no share of the threshold EOTS key is held
+	ErrThresholdKeyShareNotFound = errors.New("threshold key share not found")
+
+	// ErrDuplicateThresholdKeyShare a share of the threshold EOTS key is already held
+	ErrDuplicateThresholdKeyShare = errors.New("threshold key share already exists")
 )
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
@@ -18,6 +20,13 @@ func (s *SigningRecord) FromProto(sr *proto.SigningRecord) {
 	s.Signature = sr.EotsSig
 }
 
+// SignRecordEntry is a sign record with the chain and height it was signed at
+type SignRecordEntry struct {
+	SigningRecord
+	ChainID []byte
+	Height  uint64
+}
+
 // the record key is (chainID || pk || height)
 func getSignRecordKey(chainID, pk []byte, height uint64) []byte {
 	// Convert height to bytes
@@ -31,3 +40,31 @@ func getSignRecordKey(chainID, pk []byte, height uint64) []byte {
 
 	return key
 }
+
+// parseSignRecordKey returns the chain ID and height of the record key if it is
+// the one of the given pk
+func parseSignRecordKey(key, pk []byte) ([]byte, uint64, bool) {
+	if len(key) < len(pk)+8 {
+		return nil, 0, false
+	}
+
+	heightStart := len(key) - 8
+	pkStart := heightStart - len(pk)
+	if !bytes.Equal(key[pkStart:heightStart], pk) {
+		return nil, 0, false
+	}
+
+	chainID := make([]byte, pkStart)
+	copy(chainID, key[:pkStart])
+
+	return chainID, sdk.BigEndianToUint64(key[heightStart:]), true
+}
+
+// the watermark key is (chainID || pk)
+func getSignWatermarkKey(chainID, pk []byte) []byte {
+	key := make([]byte, 0, len(chainID)+len(pk))
+	key = append(key, chainID...)
+	key = append(key, pk...)
+
+	return key
+}
@@ -0,0 +1,65 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+)
+
+// ThresholdKeyShareRecord is the share of a threshold EOTS key held by this EOTS
+// manager, except for the private share, which is kept in the keyring
+type ThresholdKeyShareRecord struct {
+	GroupPk         *btcec.PublicKey
+	Index           uint32
+	Threshold       uint32
+	NumParties      uint32
+	SealedRandSeeds []byte // The randomness seeds, encrypted with the private share.
+}
+
+func (s *ThresholdKeyShareRecord) ToProto() *proto.ThresholdKeyShare {
+	return &proto.ThresholdKeyShare{
+		GroupPk:         s.GroupPk.SerializeCompressed(),
+		Index:           s.Index,
+		Threshold:       s.Threshold,
+		NumParties:      s.NumParties,
+		SealedRandSeeds: s.SealedRandSeeds,
+	}
+}
+
+func (s *ThresholdKeyShareRecord) FromProto(ts *proto.ThresholdKeyShare) error {
+	groupPk, err := btcec.ParsePubKey(ts.GroupPk)
+	if err != nil {
+		return fmt.Errorf("%w: invalid threshold public key: %w", ErrCorruptedEOTSDb, err)
+	}
+
+	s.GroupPk = groupPk
+	s.Index = ts.Index
+	s.Threshold = ts.Threshold
+	s.NumParties = ts.NumParties
+	s.SealedRandSeeds = ts.SealedRandSeeds
+
+	return nil
+}
+
+type SchnorrSigningRecord struct {
+	PubNonce   []byte // The combined public nonce that the signature is signed with.
+	PartialSig []byte
+	Timestamp  int64 // The timestamp of the signing operation, in Unix seconds.
+}
+
+func (s *SchnorrSigningRecord) FromProto(sr *proto.SchnorrSigningRecord) {
+	s.PubNonce = sr.PubNonce
+	s.PartialSig = sr.PartialSig
+	s.Timestamp = sr.Timestamp
+}
+
+// the record key is (pk || msg)
+func getSchnorrSignRecordKey(pk, msg []byte) []byte {
+	key := make([]byte, 0, len(pk)+len(msg))
+	key = append(key, pk...)
+	key = append(key, msg...)
+
+	return key
+}
@@ -0,0 +1,298 @@
+package threshold
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"sort"
+	"strconv"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+const (
+	// MaxParties bounds the number of shares of a threshold EOTS key, as each share
+	// holds C(n-1, t-1) randomness seeds
+	MaxParties = 10
+
+	randSeedSize = 32
+)
+
+var (
+	ErrInvalidThreshold = errors.New("invalid threshold")
+	ErrInvalidKeyShare  = errors.New("invalid key share")
+)
+
+// KeyShare is the share of a threshold EOTS key held by one of the eotsd instances.
+//
+// The EOTS private key is split by Shamir secret sharing, so that any Threshold of
+// the NumParties shares can sign together. The secret randomness of each height is
+// shared by pseudorandom secret sharing: each set of NumParties-Threshold+1 parties
+// holds a common seed, so that any Threshold parties derive Shamir shares of the
+// same randomness without interacting, while any Threshold-1 parties miss a seed.
+type KeyShare struct {
+	// GroupPk is the EOTS public key of the finality provider
+	GroupPk *btcec.PublicKey
+	// Index is the index of the share, from 1 to NumParties
+	Index      uint32
+	Threshold  uint32
+	NumParties uint32
+	// PrivShare is the share of the EOTS private key
+	PrivShare *btcec.PrivateKey
+	// RandSeeds are the randomness seeds held by the share, keyed by the bitmask
+	// of the parties holding the same seed
+	RandSeeds map[uint64][]byte
+}
+
+// ValidateParams checks that a key can be split into numParties shares so that any
+// threshold of them can sign. A threshold of more than half of the parties ensures
+// that any two sets of signers have a party in common, which refuses to sign two
+// different messages at the same height.
+func ValidateParams(threshold, numParties uint32) error {
+	if numParties == 0 || numParties > MaxParties {
+		return fmt.Errorf("%w: the number of parties must be between 1 and %d", ErrInvalidThreshold, MaxParties)
+	}
+	if threshold == 0 || threshold > numParties {
+		return fmt.Errorf("%w: the threshold must be between 1 and the number of parties", ErrInvalidThreshold)
+	}
+	if 2*threshold <= numParties {
+		return fmt.Errorf("%w: the threshold must be more than half of the number of parties", ErrInvalidThreshold)
+	}
+
+	return nil
+}
+
+// SplitKey splits the given EOTS private key into numParties shares, so that any
+// threshold of them can sign. It is meant to be run by a trusted dealer, which
+// has to delete the private key afterwards.
+func SplitKey(sk *btcec.PrivateKey, threshold, numParties uint32, randSource io.Reader) ([]*KeyShare, error) {
+	if err := ValidateParams(threshold, numParties); err != nil {
+		return nil, err
+	}
+
+	// a(x) = sk + c_1*x + ... + c_{t-1}*x^{t-1}
+	coeffs := make([]btcec.ModNScalar, threshold)
+	coeffs[0].Set(&sk.Key)
+	for i := 1; i < len(coeffs); i++ {
+		if err := randScalar(randSource, &coeffs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	shares := make([]*KeyShare, 0, numParties)
+	for index := uint32(1); index <= numParties; index++ {
+		privShare := evalPolynomial(coeffs, index)
+		if privShare.IsZero() {
+			return nil, fmt.Errorf("%w: zero private share", ErrInvalidKeyShare)
+		}
+		shares = append(shares, &KeyShare{
+			GroupPk:    sk.PubKey(),
+			Index:      index,
+			Threshold:  threshold,
+			NumParties: numParties,
+			PrivShare:  btcec.PrivKeyFromScalar(&privShare),
+			RandSeeds:  make(map[uint64][]byte),
+		})
+	}
+
+	for _, parties := range seedHolderSets(threshold, numParties) {
+		seed := make([]byte, randSeedSize)
+		if _, err := io.ReadFull(randSource, seed); err != nil {
+			return nil, err
+		}
+		for _, share := range shares {
+			if holdsSeed(parties, share.Index) {
+				share.RandSeeds[parties] = seed
+			}
+		}
+	}
+
+	return shares, nil
+}
+
+// Validate checks that the share is consistent with its parameters
+func (s *KeyShare) Validate() error {
+	if err := ValidateParams(s.Threshold, s.NumParties); err != nil {
+		return err
+	}
+	if s.Index == 0 || s.Index > s.NumParties {
+		return fmt.Errorf("%w: index %d out of range", ErrInvalidKeyShare, s.Index)
+	}
+	if s.GroupPk == nil || s.PrivShare == nil {
+		return fmt.Errorf("%w: missing key", ErrInvalidKeyShare)
+	}
+
+	expected := 0
+	for _, parties := range seedHolderSets(s.Threshold, s.NumParties) {
+		if holdsSeed(parties, s.Index) {
+			expected++
+		}
+	}
+	if len(s.RandSeeds) != expected {
+		return fmt.Errorf("%w: expected %d randomness seeds, got %d", ErrInvalidKeyShare, expected, len(s.RandSeeds))
+	}
+	for parties, seed := range s.RandSeeds {
+		if bits.OnesCount64(parties) != int(s.NumParties-s.Threshold+1) || parties>>s.NumParties != 0 ||
+			!holdsSeed(parties, s.Index) || len(seed) != randSeedSize {
+			return fmt.Errorf("%w: invalid randomness seed of parties %b", ErrInvalidKeyShare, parties)
+		}
+	}
+
+	return nil
+}
+
+// seedHolderSets returns the sets of parties holding a common randomness seed, i.e.,
+// the complements of the sets of threshold-1 parties, as bitmasks
+func seedHolderSets(threshold, numParties uint32) []uint64 {
+	var sets []uint64
+	for parties := uint64(1); parties < 1<<numParties; parties++ {
+		if bits.OnesCount64(parties) == int(numParties-threshold+1) {
+			sets = append(sets, parties)
+		}
+	}
+
+	return sets
+}
+
+func holdsSeed(parties uint64, index uint32) bool {
+	return parties&(1<<(index-1)) != 0
+}
+
+// evalPolynomial returns the value of the polynomial with the given coefficients at x
+func evalPolynomial(coeffs []btcec.ModNScalar, x uint32) btcec.ModNScalar {
+	var xScalar, result btcec.ModNScalar
+	xScalar.SetInt(x)
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		result.Mul(&xScalar).Add(&coeffs[i])
+	}
+
+	return result
+}
+
+func randScalar(randSource io.Reader, scalar *btcec.ModNScalar) error {
+	var b [32]byte
+	for {
+		if _, err := io.ReadFull(randSource, b[:]); err != nil {
+			return err
+		}
+		if overflow := scalar.SetBytes(&b); overflow == 0 && !scalar.IsZero() {
+			return nil
+		}
+	}
+}
+
+// lagrangeCoefficient returns the coefficient of the share of the given index in
+// the interpolation at 0 of the shares of the given signers
+func lagrangeCoefficient(index uint32, signers []uint32) (*btcec.ModNScalar, error) {
+	var num, den btcec.ModNScalar
+	num.SetInt(1)
+	den.SetInt(1)
+
+	found := false
+	for _, j := range signers {
+		if j == index {
+			if found {
+				return nil, fmt.Errorf("%w: duplicate signer %d", ErrInvalidKeyShare, j)
+			}
+			found = true
+
+			continue
+		}
+
+		// num *= j, den *= j - index
+		var jScalar, diff btcec.ModNScalar
+		jScalar.SetInt(j)
+		diff.SetInt(index).Negate().Add(&jScalar)
+		num.Mul(&jScalar)
+		den.Mul(&diff)
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: signer %d is not in the signer set", ErrInvalidKeyShare, index)
+	}
+
+	return num.Mul(den.InverseNonConst()), nil
+}
+
+func sortedIndices[T any](shares map[uint32]T) []uint32 {
+	indices := make([]uint32, 0, len(shares))
+	for index := range shares {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	return indices
+}
+
+// keyShareJSON is the format of the key share files produced by the dealer
+type keyShareJSON struct {
+	GroupPk    string            `json:"group_pk"`
+	Index      uint32            `json:"index"`
+	Threshold  uint32            `json:"threshold"`
+	NumParties uint32            `json:"num_parties"`
+	PrivShare  string            `json:"priv_share"`
+	RandSeeds  map[string]string `json:"rand_seeds"`
+}
+
+func (s *KeyShare) MarshalJSON() ([]byte, error) {
+	randSeeds := make(map[string]string, len(s.RandSeeds))
+	for parties, seed := range s.RandSeeds {
+		randSeeds[strconv.FormatUint(parties, 10)] = hex.EncodeToString(seed)
+	}
+
+	return json.Marshal(&keyShareJSON{
+		GroupPk:    hex.EncodeToString(s.GroupPk.SerializeCompressed()),
+		Index:      s.Index,
+		Threshold:  s.Threshold,
+		NumParties: s.NumParties,
+		PrivShare:  hex.EncodeToString(s.PrivShare.Serialize()),
+		RandSeeds:  randSeeds,
+	})
+}
+
+func (s *KeyShare) UnmarshalJSON(data []byte) error {
+	var sj keyShareJSON
+	if err := json.Unmarshal(data, &sj); err != nil {
+		return err
+	}
+
+	groupPkBytes, err := hex.DecodeString(sj.GroupPk)
+	if err != nil {
+		return fmt.Errorf("%w: invalid group public key: %w", ErrInvalidKeyShare, err)
+	}
+	groupPk, err := btcec.ParsePubKey(groupPkBytes)
+	if err != nil {
+		return fmt.Errorf("%w: invalid group public key: %w", ErrInvalidKeyShare, err)
+	}
+	privShareBytes, err := hex.DecodeString(sj.PrivShare)
+	if err != nil || len(privShareBytes) != btcec.PrivKeyBytesLen {
+		return fmt.Errorf("%w: invalid private share", ErrInvalidKeyShare)
+	}
+	privShare, _ := btcec.PrivKeyFromBytes(privShareBytes)
+
+	randSeeds := make(map[uint64][]byte, len(sj.RandSeeds))
+	for partiesStr, seedHex := range sj.RandSeeds {
+		parties, err := strconv.ParseUint(partiesStr, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: invalid parties of randomness seed: %w", ErrInvalidKeyShare, err)
+		}
+		seed, err := hex.DecodeString(seedHex)
+		if err != nil {
+			return fmt.Errorf("%w: invalid randomness seed: %w", ErrInvalidKeyShare, err)
+		}
+		randSeeds[parties] = seed
+	}
+
+	*s = KeyShare{
+		GroupPk:    groupPk,
+		Index:      sj.Index,
+		Threshold:  sj.Threshold,
+		NumParties: sj.NumParties,
+		PrivShare:  privShare,
+		RandSeeds:  randSeeds,
+	}
+
+	return s.Validate()
+}
@@ -0,0 +1,89 @@
+package threshold
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+const sealedSeedSize = 8 + randSeedSize
+
+var sealKeyInfo = []byte("threshold-eots-rand-seeds")
+
+// SealRandSeeds encrypts the randomness seeds of the share with a key derived from
+// its private share, so that they can be kept next to the keyring
+func (s *KeyShare) SealRandSeeds() ([]byte, error) {
+	aead, err := sealCipher(s.PrivShare)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext := make([]byte, 0, len(s.RandSeeds)*sealedSeedSize)
+	for _, parties := range sortedSeedParties(s.RandSeeds) {
+		plaintext = binary.BigEndian.AppendUint64(plaintext, parties)
+		plaintext = append(plaintext, s.RandSeeds[parties]...)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// OpenRandSeeds decrypts the randomness seeds sealed with the given private share
+func OpenRandSeeds(privShare *btcec.PrivateKey, sealed []byte) (map[uint64][]byte, error) {
+	aead, err := sealCipher(privShare)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("%w: sealed randomness seeds too short", ErrInvalidKeyShare)
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to open the randomness seeds: %w", ErrInvalidKeyShare, err)
+	}
+	if len(plaintext)%sealedSeedSize != 0 {
+		return nil, fmt.Errorf("%w: invalid length of randomness seeds", ErrInvalidKeyShare)
+	}
+
+	randSeeds := make(map[uint64][]byte, len(plaintext)/sealedSeedSize)
+	for i := 0; i < len(plaintext); i += sealedSeedSize {
+		parties := binary.BigEndian.Uint64(plaintext[i : i+8])
+		randSeeds[parties] = plaintext[i+8 : i+sealedSeedSize]
+	}
+
+	return randSeeds, nil
+}
+
+func sealCipher(privShare *btcec.PrivateKey) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, privShare.Serialize())
+	mac.Write(sealKeyInfo)
+
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func sortedSeedParties(randSeeds map[uint64][]byte) []uint64 {
+	parties := make([]uint64, 0, len(randSeeds))
+	for p := range randSeeds {
+		parties = append(parties, p)
+	}
+	sort.Slice(parties, func(i, j int) bool { return parties[i] < parties[j] })
+
+	return parties
+}
@@ -0,0 +1,213 @@
+package threshold
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/randgenerator"
+)
+
+var schnorrNonceTag = []byte("threshold-eots-schnorr-nonce")
+
+// RandShare returns the share of the secret randomness of the given chain and
+// height held by the key share. The shares of any Threshold parties interpolate
+// to the same secret randomness.
+func (s *KeyShare) RandShare(chainID []byte, height uint64) *btcec.ModNScalar {
+	return s.nonceShare(func(seed []byte) *btcec.ModNScalar {
+		randPart, _ := randgenerator.GenerateRandomness(seed, chainID, height)
+
+		return randPart
+	})
+}
+
+// PubRandShare returns the share of the public randomness of the given chain and height
+func (s *KeyShare) PubRandShare(chainID []byte, height uint64) *btcec.PublicKey {
+	return btcec.PrivKeyFromScalar(s.RandShare(chainID, height)).PubKey()
+}
+
+// SchnorrNonceShare returns the share of the secret nonce of the Schnorr signature
+// of the given message hash held by the key share. The nonce is derived from the
+// message, so that different messages are never signed with the same nonce.
+func (s *KeyShare) SchnorrNonceShare(msg []byte) *btcec.ModNScalar {
+	return s.nonceShare(func(seed []byte) *btcec.ModNScalar {
+		nonceKey := hmac.New(sha256.New, seed)
+		nonceKey.Write(schnorrNonceTag)
+		digest := hmac.New(sha256.New, nonceKey.Sum(nil))
+		digest.Write(msg)
+
+		var noncePart btcec.ModNScalar
+		noncePart.SetByteSlice(digest.Sum(nil))
+
+		return &noncePart
+	})
+}
+
+// PubSchnorrNonceShare returns the share of the public nonce of the Schnorr
+// signature of the given message hash
+func (s *KeyShare) PubSchnorrNonceShare(msg []byte) *btcec.PublicKey {
+	return btcec.PrivKeyFromScalar(s.SchnorrNonceShare(msg)).PubKey()
+}
+
+// PartialSign returns the partial EOTS signature of the message at the given chain
+// and height, where pubRand is the combined public randomness of the height
+func (s *KeyShare) PartialSign(chainID []byte, height uint64, msg []byte, pubRand *btcec.PublicKey) (*btcec.ModNScalar, error) {
+	// EOTS signs the hash of the message
+	msgHash := sha256.Sum256(msg)
+
+	return s.partialSign(s.RandShare(chainID, height), pubRand, msgHash[:])
+}
+
+// PartialSignSchnorr returns the partial BIP-340 Schnorr signature of the message
+// hash, where pubNonce is the combined public nonce of the message
+func (s *KeyShare) PartialSignSchnorr(msg []byte, pubNonce *btcec.PublicKey) (*btcec.ModNScalar, error) {
+	if len(msg) != chainhash.HashSize {
+		return nil, fmt.Errorf("wrong size for message (got %v, want %v)", len(msg), chainhash.HashSize)
+	}
+
+	return s.partialSign(s.SchnorrNonceShare(msg), pubNonce, msg)
+}
+
+// partialSign returns s_i = k_i + e*d_i, where e is the BIP-340 challenge of the
+// combined public nonce and the message hash
+func (s *KeyShare) partialSign(k *btcec.ModNScalar, pubNonce *btcec.PublicKey, msgHash []byte) (*btcec.ModNScalar, error) {
+	// the shares of the private key and nonce are negated along with their
+	// combination, as in BIP-340 signing
+	var d btcec.ModNScalar
+	d.Set(&s.PrivShare.Key)
+	if s.GroupPk.SerializeCompressed()[0] == secp256k1.PubKeyFormatCompressedOdd {
+		d.Negate()
+	}
+	if pubNonce.SerializeCompressed()[0] == secp256k1.PubKeyFormatCompressedOdd {
+		k.Negate()
+	}
+
+	e, err := challenge(s.GroupPk, pubNonce, msgHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(btcec.ModNScalar).Mul2(e, &d).Add(k), nil
+}
+
+// nonceShare returns the share of a secret nonce, given the derivation of the
+// nonce from each of the randomness seeds
+func (s *KeyShare) nonceShare(derive func(seed []byte) *btcec.ModNScalar) *btcec.ModNScalar {
+	var share btcec.ModNScalar
+	for parties, seed := range s.RandSeeds {
+		// the seed of the parties contributes to the shares by a polynomial of
+		// degree Threshold-1 which is 1 at 0 and vanishes at the other parties
+		share.Add(new(btcec.ModNScalar).Mul2(derive(seed), seedPolynomial(parties, s.NumParties, s.Index)))
+	}
+
+	return &share
+}
+
+// CombinePubRand returns the public randomness of a height, or the public nonce of
+// a Schnorr signature, from the shares of at least threshold parties, keyed by the
+// index of the party
+func CombinePubRand(shares map[uint32]*btcec.PublicKey, threshold uint32) (*btcec.PublicKey, error) {
+	if uint32(len(shares)) < threshold {
+		return nil, fmt.Errorf("%w: got %d public randomness shares, need %d", ErrInvalidThreshold, len(shares), threshold)
+	}
+
+	signers := sortedIndices(shares)
+	var sum btcec.JacobianPoint
+	for _, index := range signers {
+		lambda, err := lagrangeCoefficient(index, signers)
+		if err != nil {
+			return nil, err
+		}
+		var share, term btcec.JacobianPoint
+		shares[index].AsJacobian(&share)
+		btcec.ScalarMultNonConst(lambda, &share, &term)
+		btcec.AddNonConst(&sum, &term, &sum)
+	}
+
+	if (sum.X.IsZero() && sum.Y.IsZero()) || sum.Z.IsZero() {
+		return nil, fmt.Errorf("%w: the public randomness is the point at infinity", ErrInvalidKeyShare)
+	}
+	sum.ToAffine()
+
+	return btcec.NewPublicKey(&sum.X, &sum.Y), nil
+}
+
+// CombinePartialSigs returns the EOTS signature, or the s value of the Schnorr
+// signature, from the partial signatures of at least threshold parties, keyed by
+// the index of the party
+func CombinePartialSigs(partialSigs map[uint32]*btcec.ModNScalar, threshold uint32) (*btcec.ModNScalar, error) {
+	if uint32(len(partialSigs)) < threshold {
+		return nil, fmt.Errorf("%w: got %d partial signatures, need %d", ErrInvalidThreshold, len(partialSigs), threshold)
+	}
+
+	signers := sortedIndices(partialSigs)
+	var sig btcec.ModNScalar
+	for _, index := range signers {
+		lambda, err := lagrangeCoefficient(index, signers)
+		if err != nil {
+			return nil, err
+		}
+		sig.Add(lambda.Mul(partialSigs[index]))
+	}
+
+	return &sig, nil
+}
+
+// PubRandFieldVal returns the public randomness as committed to Babylon, i.e., the
+// x coordinate of the point
+func PubRandFieldVal(pubRand *btcec.PublicKey) *eots.PublicRand {
+	var j btcec.JacobianPoint
+	pubRand.AsJacobian(&j)
+
+	return &j.X
+}
+
+// seedPolynomial returns f(index), where f is the polynomial of degree
+// numParties-|parties| with f(0) = 1 and f(j) = 0 for the parties j not
+// holding the seed, i.e., f(x) = prod_{j not in parties} (j - x) / j
+func seedPolynomial(parties uint64, numParties, index uint32) *btcec.ModNScalar {
+	var num, den btcec.ModNScalar
+	num.SetInt(1)
+	den.SetInt(1)
+	for j := uint32(1); j <= numParties; j++ {
+		if holdsSeed(parties, j) {
+			continue
+		}
+		var jScalar, diff btcec.ModNScalar
+		jScalar.SetInt(j)
+		diff.SetInt(index).Negate().Add(&jScalar)
+		num.Mul(&diff)
+		den.Mul(&jScalar)
+	}
+
+	return num.Mul(den.InverseNonConst())
+}
+
+// challenge returns the BIP-340 challenge e = H(R.x || P.x || msgHash)
+func challenge(pk, pubNonce *btcec.PublicKey, msgHash []byte) (*btcec.ModNScalar, error) {
+	commitment := chainhash.TaggedHash(
+		chainhash.TagBIP0340Challenge,
+		schnorr.SerializePubKey(pubNonce),
+		schnorr.SerializePubKey(pk),
+		msgHash,
+	)
+
+	var e btcec.ModNScalar
+	if overflow := e.SetBytes((*[32]byte)(commitment)); overflow != 0 {
+		return nil, fmt.Errorf("hash of (r || P || m) too big")
+	}
+
+	return &e, nil
+}
+
+// SchnorrSignature returns the BIP-340 Schnorr signature from the combined public
+// nonce and the combined partial signatures
+func SchnorrSignature(pubNonce *btcec.PublicKey, sig *btcec.ModNScalar) *schnorr.Signature {
+	return schnorr.NewSignature(PubRandFieldVal(pubNonce), sig)
+}
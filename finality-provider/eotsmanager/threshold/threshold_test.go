@@ -0,0 +1,134 @@
+package threshold_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/threshold"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzThresholdSign tests that any threshold of the shares of an EOTS key produce
+// the same public randomness and a valid EOTS signature, which is extractable, as
+// well as a valid Schnorr signature
+func FuzzThresholdSign(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numParties := uint32(datagen.RandomInt(r, 5)) + 1
+		thresh := numParties/2 + 1
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		shares, err := threshold.SplitKey(sk, thresh, numParties, r)
+		require.NoError(t, err)
+		require.Len(t, shares, int(numParties))
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := r.Uint64()
+
+		signers := func() []*threshold.KeyShare {
+			perm := r.Perm(int(numParties))
+			selected := make([]*threshold.KeyShare, 0, thresh)
+			for _, i := range perm[:thresh] {
+				selected = append(selected, shares[i])
+			}
+
+			return selected
+		}
+		combinePubRand := func(signers []*threshold.KeyShare) *btcec.PublicKey {
+			pubRandShares := make(map[uint32]*btcec.PublicKey)
+			for _, share := range signers {
+				pubRandShares[share.Index] = share.PubRandShare(chainID, height)
+			}
+			pubRand, err := threshold.CombinePubRand(pubRandShares, thresh)
+			require.NoError(t, err)
+
+			return pubRand
+		}
+		sign := func(signers []*threshold.KeyShare, pubRand *btcec.PublicKey, msg []byte) *eots.Signature {
+			partialSigs := make(map[uint32]*btcec.ModNScalar)
+			for _, share := range signers {
+				partialSig, err := share.PartialSign(chainID, height, msg, pubRand)
+				require.NoError(t, err)
+				partialSigs[share.Index] = partialSig
+			}
+			sig, err := threshold.CombinePartialSigs(partialSigs, thresh)
+			require.NoError(t, err)
+
+			return sig
+		}
+
+		// different sets of signers agree on the public randomness
+		pubRand := combinePubRand(signers())
+		require.True(t, pubRand.IsEqual(combinePubRand(signers())))
+		_, err = threshold.CombinePubRand(map[uint32]*btcec.PublicKey{}, thresh)
+		require.ErrorIs(t, err, threshold.ErrInvalidThreshold)
+
+		msg1 := datagen.GenRandomByteArray(r, 32)
+		sig1 := sign(signers(), pubRand, msg1)
+		require.NoError(t, eots.Verify(sk.PubKey(), threshold.PubRandFieldVal(pubRand), msg1, sig1))
+
+		// signing two messages at the same height leaks the key
+		msg2 := datagen.GenRandomByteArray(r, 32)
+		sig2 := sign(signers(), pubRand, msg2)
+		extracted, err := eots.Extract(sk.PubKey(), threshold.PubRandFieldVal(pubRand), msg1, sig1, msg2, sig2)
+		require.NoError(t, err)
+		require.True(t, sk.PubKey().IsEqual(extracted.PubKey()))
+
+		// any threshold of the shares sign a Schnorr signature
+		msgHash := datagen.GenRandomByteArray(r, 32)
+		nonceSigners := signers()
+		pubNonceShares := make(map[uint32]*btcec.PublicKey)
+		for _, share := range nonceSigners {
+			pubNonceShares[share.Index] = share.PubSchnorrNonceShare(msgHash)
+		}
+		pubNonce, err := threshold.CombinePubRand(pubNonceShares, thresh)
+		require.NoError(t, err)
+		partialSigs := make(map[uint32]*btcec.ModNScalar)
+		for _, share := range signers() {
+			partialSig, err := share.PartialSignSchnorr(msgHash, pubNonce)
+			require.NoError(t, err)
+			partialSigs[share.Index] = partialSig
+		}
+		s, err := threshold.CombinePartialSigs(partialSigs, thresh)
+		require.NoError(t, err)
+		require.True(t, threshold.SchnorrSignature(pubNonce, s).Verify(msgHash, sk.PubKey()))
+
+		// the key shares survive their file format and the sealing of their seeds
+		share := shares[r.Intn(len(shares))]
+		shareJSON, err := json.Marshal(share)
+		require.NoError(t, err)
+		var loaded threshold.KeyShare
+		require.NoError(t, json.Unmarshal(shareJSON, &loaded))
+		require.Equal(t, share.RandSeeds, loaded.RandSeeds)
+		require.True(t, share.PubRandShare(chainID, height).IsEqual(loaded.PubRandShare(chainID, height)))
+
+		sealed, err := share.SealRandSeeds()
+		require.NoError(t, err)
+		opened, err := threshold.OpenRandSeeds(share.PrivShare, sealed)
+		require.NoError(t, err)
+		require.Equal(t, share.RandSeeds, opened)
+		otherSk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		_, err = threshold.OpenRandSeeds(otherSk, sealed)
+		require.ErrorIs(t, err, threshold.ErrInvalidKeyShare)
+	})
+}
+
+func TestValidateParams(t *testing.T) {
+	require.NoError(t, threshold.ValidateParams(1, 1))
+	require.NoError(t, threshold.ValidateParams(2, 3))
+	require.NoError(t, threshold.ValidateParams(3, 4))
+	require.ErrorIs(t, threshold.ValidateParams(0, 3), threshold.ErrInvalidThreshold)
+	require.ErrorIs(t, threshold.ValidateParams(4, 3), threshold.ErrInvalidThreshold)
+	// two disjoint sets of signers could sign different messages at the same height
+	require.ErrorIs(t, threshold.ValidateParams(2, 4), threshold.ErrInvalidThreshold)
+	require.ErrorIs(t, threshold.ValidateParams(6, threshold.MaxParties+1), threshold.ErrInvalidThreshold)
+}
@@ -0,0 +1,274 @@
+package eotsmanager
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/threshold"
+	eotstypes "github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+var _ ThresholdParticipant = &LocalEOTSManager{}
+
+// ImportThresholdKeyShare imports the share of a threshold EOTS key produced by
+// the dealer. The private share is kept in the keyring at the given name, while
+// the randomness seeds are kept in the db, sealed with the private share.
+func (lm *LocalEOTSManager) ImportThresholdKeyShare(name, passphrase string, share *threshold.KeyShare) (*bbntypes.BIP340PubKey, error) {
+	if err := share.Validate(); err != nil {
+		return nil, err
+	}
+	if lm.keyExists(name) {
+		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
+	}
+
+	eotsPk := bbntypes.NewBIP340PubKeyFromBTCPK(share.GroupPk)
+	if _, err := lm.es.GetEOTSKeyName(eotsPk.MustMarshal()); err == nil {
+		return nil, store.ErrDuplicateEOTSKeyName
+	} else if !errors.Is(err, store.ErrEOTSKeyNameNotFound) {
+		return nil, err
+	}
+
+	sealedRandSeeds, err := share.SealRandSeeds()
+	if err != nil {
+		return nil, fmt.Errorf("failed to seal the randomness seeds: %w", err)
+	}
+
+	if err := lm.krKeys.importPrivKey(name, passphrase, share.PrivShare); err != nil {
+		return nil, fmt.Errorf("failed to import the private share: %w", err)
+	}
+
+	if err := lm.es.SaveThresholdKeyShare(&store.ThresholdKeyShareRecord{
+		GroupPk:         share.GroupPk,
+		Index:           share.Index,
+		Threshold:       share.Threshold,
+		NumParties:      share.NumParties,
+		SealedRandSeeds: sealedRandSeeds,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := lm.SaveEOTSKeyName(share.GroupPk, name); err != nil {
+		return nil, err
+	}
+
+	lm.logger.Info(
+		"successfully imported a threshold EOTS key share",
+		zap.String("key name", name),
+		zap.String("pk", eotsPk.MarshalHex()),
+		zap.Uint32("index", share.Index),
+		zap.Uint32("threshold", share.Threshold),
+		zap.Uint32("num_parties", share.NumParties),
+	)
+
+	return eotsPk, nil
+}
+
+func (lm *LocalEOTSManager) PubRandShareList(eotsPk []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) (*eotstypes.PubRandShareList, error) {
+	share, err := lm.getThresholdKeyShare(eotsPk, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	shareList := &eotstypes.PubRandShareList{
+		Index:         share.Index,
+		Threshold:     share.Threshold,
+		PubRandShares: make([]*btcec.PublicKey, 0, num),
+	}
+	for i := uint32(0); i < num; i++ {
+		shareList.PubRandShares = append(shareList.PubRandShares, share.PubRandShare(chainID, startHeight+uint64(i)))
+	}
+	lm.metrics.IncrementEotsFpTotalGeneratedRandomnessCounter(hex.EncodeToString(eotsPk))
+	lm.metrics.SetEotsFpLastGeneratedRandomnessHeight(hex.EncodeToString(eotsPk), float64(startHeight))
+
+	return shareList, nil
+}
+
+func (lm *LocalEOTSManager) SignEOTSShare(eotsPk []byte, chainID []byte, msg []byte, height uint64, pubRand *btcec.PublicKey, passphrase string) (*btcec.ModNScalar, error) {
+	partialSig, found, err := lm.checkSignRecord(eotsPk, chainID, msg, height)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return partialSig, nil
+	}
+
+	share, err := lm.getThresholdKeyShare(eotsPk, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update metrics
+	lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(eotsPk))
+	lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(eotsPk), float64(height))
+
+	partialSig, err = share.PartialSign(chainID, height, msg, pubRand)
+	if err != nil {
+		return nil, fmt.Errorf("failed to partially sign eots: %w", err)
+	}
+
+	b := partialSig.Bytes()
+	if err := lm.es.SaveSignRecord(height, chainID, msg, eotsPk, b[:]); err != nil {
+		return nil, fmt.Errorf("failed to save signing record: %w", err)
+	}
+
+	return partialSig, nil
+}
+
+func (lm *LocalEOTSManager) SchnorrNonceShare(eotsPk []byte, msg []byte, passphrase string) (*eotstypes.PubRandShareList, error) {
+	share, err := lm.getThresholdKeyShare(eotsPk, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eotstypes.PubRandShareList{
+		Index:         share.Index,
+		Threshold:     share.Threshold,
+		PubRandShares: []*btcec.PublicKey{share.PubSchnorrNonceShare(msg)},
+	}, nil
+}
+
+func (lm *LocalEOTSManager) SignSchnorrSigShare(eotsPk []byte, msg []byte, pubNonce *btcec.PublicKey, passphrase string) (*btcec.ModNScalar, error) {
+	// the nonce is derived from the message, so signing it again with another
+	// public nonce, i.e., another challenge, would leak the share of the key
+	record, found, err := lm.es.GetSchnorrSignRecord(eotsPk, msg)
+	if err != nil {
+		return nil, fmt.Errorf("error getting schnorr sign record: %w", err)
+	}
+	if found {
+		if !bytes.Equal(record.PubNonce, pubNonce.SerializeCompressed()) {
+			lm.logger.Error(
+				"schnorr sign requested with another public nonce",
+				zap.String("eots_pk", hex.EncodeToString(eotsPk)),
+				zap.String("hash", hex.EncodeToString(msg)),
+			)
+
+			return nil, eotstypes.ErrSchnorrNonceMismatch
+		}
+
+		var s btcec.ModNScalar
+		s.SetByteSlice(record.PartialSig)
+
+		return &s, nil
+	}
+
+	share, err := lm.getThresholdKeyShare(eotsPk, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update metrics
+	lm.metrics.IncrementEotsFpTotalSchnorrSignCounter(hex.EncodeToString(eotsPk))
+
+	partialSig, err := share.PartialSignSchnorr(msg, pubNonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to partially schnorr sign: %w", err)
+	}
+
+	b := partialSig.Bytes()
+	if err := lm.es.SaveSchnorrSignRecord(eotsPk, msg, pubNonce.SerializeCompressed(), b[:]); err != nil {
+		return nil, fmt.Errorf("failed to save schnorr signing record: %w", err)
+	}
+
+	return partialSig, nil
+}
+
+// checkSignRecord returns the signature of the given message if it was signed at
+// the given height before, ErrDoubleSign if another message was signed, and
+// ErrBelowSignWatermark if the height is at or below the imported watermark
+func (lm *LocalEOTSManager) checkSignRecord(eotsPk []byte, chainID []byte, msg []byte, height uint64) (*btcec.ModNScalar, bool, error) {
+	record, found, err := lm.es.GetSignRecord(eotsPk, chainID, height)
+	if err != nil {
+		return nil, false, fmt.Errorf("error getting sign record: %w", err)
+	}
+	if !found {
+		// the sign history imported from another EOTS manager may not carry the
+		// records of all the heights up to its watermark
+		watermark, hasWatermark, err := lm.es.GetSignWatermark(eotsPk, chainID)
+		if err != nil {
+			return nil, false, fmt.Errorf("error getting sign watermark: %w", err)
+		}
+		if hasWatermark && height <= watermark {
+			lm.logger.Error(
+				"sign requested at or below the sign watermark",
+				zap.String("eots_pk", hex.EncodeToString(eotsPk)),
+				zap.Uint64("height", height),
+				zap.Uint64("watermark", watermark),
+				zap.String("chainID", string(chainID)),
+			)
+
+			return nil, false, eotstypes.ErrBelowSignWatermark
+		}
+
+		return nil, false, nil
+	}
+
+	if bytes.Equal(msg, record.Msg) {
+		var s btcec.ModNScalar
+		s.SetByteSlice(record.Signature)
+
+		lm.logger.Warn(
+			"duplicate sign requested",
+			zap.String("eots_pk", hex.EncodeToString(eotsPk)),
+			zap.String("hash", hex.EncodeToString(msg)),
+			zap.Uint64("height", height),
+			zap.String("chainID", string(chainID)),
+		)
+
+		return &s, true, nil
+	}
+
+	lm.logger.Error(
+		"double sign requested",
+		zap.String("eots_pk", hex.EncodeToString(eotsPk)),
+		zap.String("hash", hex.EncodeToString(msg)),
+		zap.Uint64("height", height),
+		zap.String("chainID", string(chainID)),
+	)
+
+	return nil, false, eotstypes.ErrDoubleSign
+}
+
+// getThresholdKeyShare returns the share of the threshold EOTS key held by this
+// EOTS manager, with its private share from the keyring
+func (lm *LocalEOTSManager) getThresholdKeyShare(eotsPk []byte, passphrase string) (*threshold.KeyShare, error) {
+	record, found, err := lm.es.GetThresholdKeyShare(eotsPk)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", store.ErrThresholdKeyShareNotFound, hex.EncodeToString(eotsPk))
+	}
+
+	privShare, err := lm.getKeyringPrivKey(eotsPk, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	randSeeds, err := threshold.OpenRandSeeds(privShare, record.SealedRandSeeds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &threshold.KeyShare{
+		GroupPk:    record.GroupPk,
+		Index:      record.Index,
+		Threshold:  record.Threshold,
+		NumParties: record.NumParties,
+		PrivShare:  privShare,
+		RandSeeds:  randSeeds,
+	}, nil
+}
+
+// isThresholdKey returns whether only a share of the given EOTS key is held
+func (lm *LocalEOTSManager) isThresholdKey(eotsPk []byte) (bool, error) {
+	_, found, err := lm.es.GetThresholdKeyShare(eotsPk)
+
+	return found, err
+}
@@ -5,4 +5,9 @@ import "errors"
 var (
 	ErrFinalityProviderAlreadyExisted = errors.New("the finality provider has already existed")
 	ErrDoubleSign                     = errors.New("double sign")
+	ErrThresholdKeyShare              = errors.New("only a share of the threshold EOTS key is held")
+	ErrSchnorrNonceMismatch           = errors.New("the message was signed with another public nonce")
+	ErrBelowSignWatermark             = errors.New("the height is at or below the imported sign watermark")
+	ErrKeyNotExportable               = errors.New("the EOTS private key cannot be exported from the key backend")
+	ErrKeyNotFound                    = errors.New("the EOTS key is not found in the key backend")
 )
@@ -0,0 +1,11 @@
+package types
+
+import "github.com/btcsuite/btcd/btcec/v2"
+
+// PubRandShareList is a list of the shares of the public randomness of consecutive
+// heights held by a participant of a threshold EOTS key
+type PubRandShareList struct {
+	Index         uint32
+	Threshold     uint32
+	PubRandShares []*btcec.PublicKey
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+)
+
+// SignHistoryFormatVersion is the version of the sign history interchange format
+const SignHistoryFormatVersion = "1"
+
+// SignHistory is the interchange format of the sign history of EOTS keys, which
+// carries their double-sign protection when migrating them between EOTS managers.
+// It is specified in docs/sign-history-interchange.md.
+type SignHistory struct {
+	Metadata SignHistoryMetadata `json:"metadata"`
+	Data     []*SignHistoryEntry `json:"data"`
+}
+
+type SignHistoryMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	// ExportedAt is the time of the export, in Unix milliseconds
+	ExportedAt int64 `json:"exported_at"`
+}
+
+// SignHistoryEntry is the sign history of an EOTS key on a chain
+type SignHistoryEntry struct {
+	// EotsPk is the hex of the BIP-340 public key of the EOTS key
+	EotsPk  string `json:"eots_pk"`
+	ChainID string `json:"chain_id"`
+	// WatermarkHeight is the height at or below which the EOTS key is considered to
+	// have signed, whether or not its sign records are part of the history
+	WatermarkHeight uint64         `json:"watermark_height,string"`
+	SignedBlocks    []*SignedBlock `json:"signed_blocks"`
+}
+
+// SignedBlock is the EOTS signature of the EOTS key at a height
+type SignedBlock struct {
+	Height uint64 `json:"height,string"`
+	// Msg is the hex of the message the signature is signed over
+	Msg string `json:"msg"`
+	// EotsSig is the hex of the EOTS signature
+	EotsSig string `json:"eots_sig"`
+	// Timestamp is the time of the signing, in Unix milliseconds
+	Timestamp int64 `json:"timestamp"`
+}
+
+// Validate checks the sign history is of a supported version and well-formed
+func (h *SignHistory) Validate() error {
+	if h.Metadata.InterchangeFormatVersion != SignHistoryFormatVersion {
+		return fmt.Errorf("unsupported sign history interchange format version %q, expected %q",
+			h.Metadata.InterchangeFormatVersion, SignHistoryFormatVersion)
+	}
+
+	seen := make(map[string]struct{})
+	for _, entry := range h.Data {
+		if _, err := bbntypes.NewBIP340PubKeyFromHex(entry.EotsPk); err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", entry.EotsPk, err)
+		}
+		if entry.ChainID == "" {
+			return fmt.Errorf("empty chain ID in the sign history of %s", entry.EotsPk)
+		}
+		entryKey := entry.EotsPk + "/" + entry.ChainID
+		if _, exists := seen[entryKey]; exists {
+			return fmt.Errorf("duplicate sign history of %s on chain %s", entry.EotsPk, entry.ChainID)
+		}
+		seen[entryKey] = struct{}{}
+
+		heights := make(map[uint64]struct{}, len(entry.SignedBlocks))
+		for _, block := range entry.SignedBlocks {
+			if _, exists := heights[block.Height]; exists {
+				return fmt.Errorf("duplicate signed block of %s on chain %s at height %d",
+					entry.EotsPk, entry.ChainID, block.Height)
+			}
+			heights[block.Height] = struct{}{}
+
+			if _, err := hex.DecodeString(block.Msg); err != nil {
+				return fmt.Errorf("invalid message at height %d: %w", block.Height, err)
+			}
+			if _, err := hex.DecodeString(block.EotsSig); err != nil {
+				return fmt.Errorf("invalid EOTS signature at height %d: %w", block.Height, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SignHistoryImportResult counts the signed blocks of a sign history imported to
+// the EOTS manager
+type SignHistoryImportResult struct {
+	// Imported is the number of signed blocks added to the sign records
+	Imported int `json:"imported"`
+	// Conflicts is the number of signed blocks at a height the EOTS manager
+	// signed another message at, which are not imported
+	Conflicts int `json:"conflicts"`
+}
@@ -81,6 +81,10 @@ func runCommandCommitPubRand(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to initiate public randomness store: %w", err)
 	}
+	voteLedger, err := store.NewVoteLedgerStore(db)
+	if err != nil {
+		return fmt.Errorf("failed to initiate vote ledger store: %w", err)
+	}
 	cc, err := fpcc.NewClientController(cfg.ChainType, cfg.BabylonConfig, &cfg.BTCNetParams, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create rpc client for the Babylon chain: %w", err)
@@ -88,13 +92,13 @@ func runCommandCommitPubRand(cmd *cobra.Command, args []string) error {
 	if err := cc.Start(); err != nil {
 		return fmt.Errorf("failed to start client controller: %w", err)
 	}
-	em, err := eotsclient.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
+	em, err := eotsclient.NewEOTSManagerClient(cfg.EOTSManagerAddress, cfg.EOTSManagerThresholdAddrs)
 	if err != nil {
 		return fmt.Errorf("failed to create EOTS manager client: %w", err)
 	}
 
 	fp, err := service.NewFinalityProviderInstance(
-		fpPk, cfg, fpStore, pubRandStore, cc, em, metrics.NewFpMetrics(), "",
+		fpPk, cfg, fpStore, pubRandStore, voteLedger, cc, em, metrics.NewFpMetrics(), "",
 		make(chan<- *service.CriticalError), logger)
 	if err != nil {
 		return fmt.Errorf("failed to create finality-provider %s instance: %w", fpPk.MarshalHex(), err)
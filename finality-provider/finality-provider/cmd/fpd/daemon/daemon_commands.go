@@ -557,6 +557,65 @@ func runCommandUnsafePruneMerkleProof(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// CommandUptime returns the uptime command by connecting to the fpd daemon.
+func CommandUptime() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "uptime [fp-eots-pk-hex]",
+		Short: "Shows the votes of the finality provider over the rolling missed-blocks window.",
+		Long: strings.TrimSpace(`Shows the votes of the finality provider over the latest blocks it had voting
+power at, as kept in the vote ledger of fpd, and warns if the missed blocks approach the number of blocks
+it can miss before being jailed. The window is the one of the finality params of Babylon, unless
+overridden in the uptime config.`),
+		Example: fmt.Sprintf(`fpd uptime [fp-eots-pk-hex] --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runCommandUptime,
+	}
+	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	cmd.Flags().String(chainIDFlag, "", "The identifier of the chain; the one the finality provider is registered to if empty")
+
+	return cmd
+}
+
+func runCommandUptime(cmd *cobra.Command, args []string) error {
+	fpPk, err := types.NewBIP340PubKeyFromHex(args[0])
+	if err != nil {
+		return err
+	}
+
+	daemonAddress, err := cmd.Flags().GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	chainID, err := cmd.Flags().GetString(chainIDFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", chainIDFlag, err)
+	}
+
+	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	resp, err := client.QueryFinalityProviderUptime(context.Background(), fpPk, chainID)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+
+	if resp.AtRisk {
+		cmd.PrintErrf("WARNING: the finality provider missed %d of the %d blocks it can miss in the window "+
+			"of %d blocks before being jailed\n", resp.Missed, resp.MaxMissed, resp.SignedBlocksWindow)
+	}
+
+	return nil
+}
+
 func printRespJSON(resp interface{}) {
 	jsonBytes, err := json.MarshalIndent(resp, "", "    ")
 	if err != nil {
@@ -40,7 +40,7 @@ func main() {
 		daemon.CommandInfoFP(), daemon.CommandAddFinalitySig(), daemon.CommandUnjailFP(),
 		daemon.CommandEditFinalityDescription(), daemon.CommandCommitPubRand(),
 		incentivecli.NewWithdrawRewardCmd(), incentivecli.NewSetWithdrawAddressCmd(),
-		version.CommandVersion("fpd"), daemon.CommandUnsafePruneMerkleProof(),
+		version.CommandVersion("fpd"), daemon.CommandUnsafePruneMerkleProof(), daemon.CommandUptime(),
 	)
 
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
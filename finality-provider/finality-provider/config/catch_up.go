@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+)
+
+var (
+	defaultCatchUpLagThreshold     = uint64(100)
+	defaultCatchUpInitialBatchSize = uint32(50)
+	defaultCatchUpMaxBatchSize     = uint32(500)
+	defaultSigningWorkers          = uint32(8)
+)
+
+// CatchUpConfig is the config of the catch-up mode, which the finality provider
+// enters once it falls behind the tip of the chain, e.g., after an outage
+type CatchUpConfig struct {
+	Enabled          bool   `long:"enabled" description:"Whether to enter the catch-up mode once the finality provider falls behind the tip of the chain"`
+	LagThreshold     uint64 `long:"lagthreshold" description:"The number of blocks behind the tip of the chain from which the catch-up mode is entered"`
+	InitialBatchSize uint32 `long:"initialbatchsize" description:"The number of finality signatures in the first transaction of the catch-up mode"`
+	MaxBatchSize     uint32 `long:"maxbatchsize" description:"The maximum number of finality signatures in a transaction of the catch-up mode; the batch shrinks below it if the transaction exceeds the gas or size limits of the chain"`
+	SigningWorkers   uint32 `long:"signingworkers" description:"The number of finality signatures of a batch requested from the EOTS manager in parallel"`
+}
+
+func DefaultCatchUpConfig() CatchUpConfig {
+	return CatchUpConfig{
+		Enabled:          true,
+		LagThreshold:     defaultCatchUpLagThreshold,
+		InitialBatchSize: defaultCatchUpInitialBatchSize,
+		MaxBatchSize:     defaultCatchUpMaxBatchSize,
+		SigningWorkers:   defaultSigningWorkers,
+	}
+}
+
+func (c *CatchUpConfig) Validate() error {
+	if c.LagThreshold == 0 {
+		return fmt.Errorf("catch-up lag threshold must be positive")
+	}
+
+	if c.InitialBatchSize == 0 {
+		return fmt.Errorf("catch-up initial batch size must be positive")
+	}
+
+	if c.MaxBatchSize < c.InitialBatchSize {
+		return fmt.Errorf("catch-up max batch size %d must not be lower than the initial batch size %d",
+			c.MaxBatchSize, c.InitialBatchSize)
+	}
+
+	if c.SigningWorkers == 0 {
+		return fmt.Errorf("signing workers must be positive")
+	}
+
+	return nil
+}
@@ -59,6 +59,7 @@ type Config struct {
 	TimestampingDelayBlocks     uint32        `long:"timestampingdelayblocks" description:"The delay, measured in blocks, between a randomness commit submission and the randomness is BTC-timestamped"`
 	MaxSubmissionRetries        uint32        `long:"maxsubmissionretries" description:"The maximum number of retries to submit finality signature or public randomness"`
 	EOTSManagerAddress          string        `long:"eotsmanageraddress" description:"The address of the remote EOTS manager; Empty if the EOTS manager is running locally"`
+	EOTSManagerThresholdAddrs   []string      `long:"eotsmanagerthresholdaddress" description:"The addresses of the remote EOTS managers holding the shares of threshold EOTS keys; Replaces eotsmanageraddress if set"`
 	BatchSubmissionSize         uint32        `long:"batchsubmissionsize" description:"The size of a batch in one submission"`
 	RandomnessCommitInterval    time.Duration `long:"randomnesscommitinterval" description:"The interval between each attempt to commit public randomness"`
 	SubmissionRetryInterval     time.Duration `long:"submissionretryinterval" description:"The interval between each attempt to submit finality signature or public randomness after a failure"`
@@ -70,10 +71,18 @@ type Config struct {
 
 	PollerConfig *ChainPollerConfig `group:"chainpollerconfig" namespace:"chainpollerconfig"`
 
+	UptimeConfig *UptimeConfig `group:"uptime" namespace:"uptime"`
+
+	PubRandPlannerConfig *PubRandPlannerConfig `group:"pubrandplanner" namespace:"pubrandplanner"`
+
+	CatchUpConfig *CatchUpConfig `group:"catchup" namespace:"catchup"`
+
 	DatabaseConfig *DBConfig `group:"dbconfig" namespace:"dbconfig"`
 
 	BabylonConfig *BBNConfig `group:"babylon" namespace:"babylon"`
 
+	ConsumerChains []CosmwasmConfig `long:"consumerchains" description:"A CosmWasm-based consumer chain to submit finality signatures to, as a JSON object; Can be given multiple times"`
+
 	RPCListener string `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
 
 	Metrics *metrics.Config `group:"metrics" namespace:"metrics"`
@@ -84,12 +93,18 @@ func DefaultConfigWithHome(homePath string) Config {
 	bbnCfg.Key = defaultFinalityProviderKeyName
 	bbnCfg.KeyDirectory = homePath
 	pollerCfg := DefaultChainPollerConfig()
+	uptimeCfg := DefaultUptimeConfig()
+	plannerCfg := DefaultPubRandPlannerConfig()
+	catchUpCfg := DefaultCatchUpConfig()
 	cfg := Config{
 		ChainType:                   defaultChainType,
 		LogLevel:                    defaultLogLevel.String(),
 		DatabaseConfig:              DefaultDBConfigWithHomePath(homePath),
 		BabylonConfig:               &bbnCfg,
 		PollerConfig:                &pollerCfg,
+		UptimeConfig:                &uptimeCfg,
+		PubRandPlannerConfig:        &plannerCfg,
+		CatchUpConfig:               &catchUpCfg,
 		NumPubRand:                  defaultNumPubRand,
 		NumPubRandMax:               defaultNumPubRandMax,
 		TimestampingDelayBlocks:     defaultTimestampingDelayBlocks,
@@ -169,7 +184,7 @@ func LoadConfig(homePath string) (*Config, error) {
 // illegal values or a combination of values are set. All file system paths are
 // normalized. The cleaned up config is returned on success.
 func (cfg *Config) Validate() error {
-	if cfg.EOTSManagerAddress == "" {
+	if cfg.EOTSManagerAddress == "" && len(cfg.EOTSManagerThresholdAddrs) == 0 {
 		return fmt.Errorf("EOTS manager address not specified")
 	}
 	// Multiple networks can't be selected simultaneously.  Count number of
@@ -198,6 +213,33 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid poller config: %w", err)
 	}
 
+	if err := cfg.UptimeConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid uptime config: %w", err)
+	}
+
+	if err := cfg.PubRandPlannerConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid public randomness planner config: %w", err)
+	}
+
+	if err := cfg.CatchUpConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid catch-up config: %w", err)
+	}
+
+	chainIDs := make(map[string]struct{}, len(cfg.ConsumerChains)+1)
+	if cfg.BabylonConfig != nil {
+		chainIDs[cfg.BabylonConfig.ChainID] = struct{}{}
+	}
+	for i := range cfg.ConsumerChains {
+		consumerCfg := &cfg.ConsumerChains[i]
+		if err := consumerCfg.Validate(); err != nil {
+			return fmt.Errorf("invalid consumer chain config: %w", err)
+		}
+		if _, exists := chainIDs[consumerCfg.ChainID]; exists {
+			return fmt.Errorf("duplicate chain ID %s in consumer chain configs", consumerCfg.ChainID)
+		}
+		chainIDs[consumerCfg.ChainID] = struct{}{}
+	}
+
 	// All good, return the sanitized result.
 	return nil
 }
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	bbncfg "github.com/babylonlabs-io/babylon/client/config"
+)
+
+// CosmwasmConfig is the config of a CosmWasm-based consumer chain, whose finality
+// contract the finality provider submits finality signatures and public randomness
+// commits to. It is given as a JSON object in the config file, e.g.,
+//
+//	ConsumerChains = {"chain-id":"bsn-1","rpc-address":"http://localhost:26657","acc-prefix":"bbn","gas-prices":"0.01ustake","finality-contract-address":"bbn1..."}
+//
+// The transactions are signed with the key of the Babylon config, unless another
+// one is given, from the same keyring.
+type CosmwasmConfig struct {
+	ChainID                 string  `json:"chain-id"`
+	RPCAddr                 string  `json:"rpc-address"`
+	AccountPrefix           string  `json:"acc-prefix"`
+	Key                     string  `json:"key,omitempty"`
+	GasAdjustment           float64 `json:"gas-adjustment,omitempty"`
+	GasPrices               string  `json:"gas-prices"`
+	FinalityContractAddress string  `json:"finality-contract-address"`
+}
+
+// UnmarshalFlag parses the JSON config of the consumer chain from the config file
+func (cfg *CosmwasmConfig) UnmarshalFlag(value string) error {
+	if err := json.Unmarshal([]byte(value), cfg); err != nil {
+		return fmt.Errorf("invalid consumer chain config %s: %w", value, err)
+	}
+
+	return nil
+}
+
+// MarshalFlag writes the JSON config of the consumer chain to the config file
+func (cfg CosmwasmConfig) MarshalFlag() (string, error) {
+	bz, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+func (cfg *CosmwasmConfig) Validate() error {
+	if cfg.ChainID == "" {
+		return fmt.Errorf("empty chain ID")
+	}
+	if cfg.RPCAddr == "" {
+		return fmt.Errorf("empty rpc address of consumer chain %s", cfg.ChainID)
+	}
+	if cfg.AccountPrefix == "" {
+		return fmt.Errorf("empty account prefix of consumer chain %s", cfg.ChainID)
+	}
+	if cfg.FinalityContractAddress == "" {
+		return fmt.Errorf("empty finality contract address of consumer chain %s", cfg.ChainID)
+	}
+
+	return nil
+}
+
+// CosmwasmConfigToBabylonConfig returns the config of the Cosmos client of the
+// consumer chain, which shares the keyring and timeouts of the Babylon config
+func CosmwasmConfigToBabylonConfig(cfg *CosmwasmConfig, bbnCfg *BBNConfig) bbncfg.BabylonConfig {
+	cosmosCfg := BBNConfigToBabylonConfig(bbnCfg)
+	cosmosCfg.ChainID = cfg.ChainID
+	cosmosCfg.RPCAddr = cfg.RPCAddr
+	cosmosCfg.AccountPrefix = cfg.AccountPrefix
+	cosmosCfg.GasPrices = cfg.GasPrices
+	if cfg.Key != "" {
+		cosmosCfg.Key = cfg.Key
+	}
+	if cfg.GasAdjustment != 0 {
+		cosmosCfg.GasAdjustment = cfg.GasAdjustment
+	}
+
+	return cosmosCfg
+}
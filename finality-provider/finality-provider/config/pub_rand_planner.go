@@ -0,0 +1,49 @@
+package config
+
+import (
+	"fmt"
+)
+
+var (
+	defaultPubRandCommitLookback = uint64(10)
+	defaultStuckCommitBlocks     = uint64(100)
+	defaultMaxFailedCommits      = uint32(5)
+	defaultBlockRateSamples      = uint32(20)
+)
+
+// PubRandPlannerConfig is the config of the planner of the public randomness commits
+type PubRandPlannerConfig struct {
+	CommitLookback    uint64 `long:"commitlookback" description:"The number of the latest public randomness commits queried from the chain at each attempt to commit"`
+	StuckCommitBlocks uint64 `long:"stuckcommitblocks" description:"The number of blocks after which a submitted commit that is not seen on chain is considered stuck and is recommitted"`
+	MaxFailedCommits  uint32 `long:"maxfailedcommits" description:"The maximum number of consecutive rejected commits before the finality provider instance is stopped"`
+	BlockRateSamples  uint32 `long:"blockratesamples" description:"The number of samples of the tip height the block rate is estimated from"`
+}
+
+func DefaultPubRandPlannerConfig() PubRandPlannerConfig {
+	return PubRandPlannerConfig{
+		CommitLookback:    defaultPubRandCommitLookback,
+		StuckCommitBlocks: defaultStuckCommitBlocks,
+		MaxFailedCommits:  defaultMaxFailedCommits,
+		BlockRateSamples:  defaultBlockRateSamples,
+	}
+}
+
+func (c *PubRandPlannerConfig) Validate() error {
+	if c.CommitLookback == 0 {
+		return fmt.Errorf("commit lookback must be positive")
+	}
+
+	if c.StuckCommitBlocks == 0 {
+		return fmt.Errorf("stuck commit blocks must be positive")
+	}
+
+	if c.MaxFailedCommits == 0 {
+		return fmt.Errorf("max failed commits must be positive")
+	}
+
+	if c.BlockRateSamples < 2 {
+		return fmt.Errorf("block rate samples must be at least 2: %d", c.BlockRateSamples)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"fmt"
+)
+
+var (
+	defaultUptimeWarnThreshold    = 0.5
+	defaultVoteLedgerRetainBlocks = uint64(100000)
+)
+
+// UptimeConfig is the config of the rolling missed-blocks window. The window and
+// the minimum signed ratio are the signed_blocks_window and min_signed_per_window
+// finality params of Babylon, queried at startup unless set here as an override.
+type UptimeConfig struct {
+	SignedBlocksWindow     int64   `long:"signedblockswindow" description:"Overrides the number of blocks with voting power in the rolling window the missed blocks are counted over; 0 to use the signed_blocks_window finality param of Babylon"`
+	MinSignedPerWindow     float64 `long:"minsignedperwindow" description:"Overrides the fraction of the blocks in the window the finality provider has to vote for to not be jailed; 0 to use the min_signed_per_window finality param of Babylon"`
+	WarnThreshold          float64 `long:"warnthreshold" description:"The fraction of the blocks the finality provider can miss in the window after which it is warned about being jailed"`
+	VoteLedgerRetainBlocks uint64  `long:"voteledgerretainblocks" description:"The number of the latest heights whose votes are kept in the vote ledger"`
+}
+
+func DefaultUptimeConfig() UptimeConfig {
+	return UptimeConfig{
+		WarnThreshold:          defaultUptimeWarnThreshold,
+		VoteLedgerRetainBlocks: defaultVoteLedgerRetainBlocks,
+	}
+}
+
+// MinSignedPerWindowInt returns the number of blocks of the window the finality
+// provider has to vote for, rounded as Babylon does
+func (c *UptimeConfig) MinSignedPerWindowInt() int64 {
+	return int64(float64(c.SignedBlocksWindow)*c.MinSignedPerWindow + 0.5)
+}
+
+// SetJailingParams sets the window and the minimum signed ratio to the given
+// finality params of the chain, except the ones overridden in the config
+func (c *UptimeConfig) SetJailingParams(signedBlocksWindow int64, minSignedPerWindow float64) error {
+	if c.SignedBlocksWindow == 0 {
+		c.SignedBlocksWindow = signedBlocksWindow
+	}
+	if c.MinSignedPerWindow == 0 {
+		c.MinSignedPerWindow = minSignedPerWindow
+	}
+
+	return c.Validate()
+}
+
+// IsOverridden returns whether both the window and the minimum signed ratio are
+// set in the config, so that the finality params need not be queried
+func (c *UptimeConfig) IsOverridden() bool {
+	return c.SignedBlocksWindow != 0 && c.MinSignedPerWindow != 0
+}
+
+// Validate checks the config, where a zero window or minimum signed ratio is
+// left to be set from the finality params
+func (c *UptimeConfig) Validate() error {
+	if c.SignedBlocksWindow < 0 {
+		return fmt.Errorf("signed blocks window must not be negative: %d", c.SignedBlocksWindow)
+	}
+
+	if c.MinSignedPerWindow < 0 || c.MinSignedPerWindow > 1 {
+		return fmt.Errorf("min signed per window must be in [0, 1]: %f", c.MinSignedPerWindow)
+	}
+
+	if c.WarnThreshold <= 0 || c.WarnThreshold > 1 {
+		return fmt.Errorf("warn threshold must be in (0, 1]: %f", c.WarnThreshold)
+	}
+
+	if c.VoteLedgerRetainBlocks < uint64(c.SignedBlocksWindow) {
+		return fmt.Errorf("the vote ledger must retain at least the signed blocks window %d, got %d",
+			c.SignedBlocksWindow, c.VoteLedgerRetainBlocks)
+	}
+
+	return nil
+}
@@ -102,6 +102,65 @@ func (FinalityProviderStatus) EnumDescriptor() ([]byte, []int) {
 	return file_finality_providers_proto_rawDescGZIP(), []int{0}
 }
 
+// VoteStatus is the outcome of the vote of a finality provider at a height
+type VoteStatus int32
+
+const (
+	// VOTED defines a height the finality provider submitted its finality signature at
+	VoteStatus_VOTED VoteStatus = 0
+	// SKIPPED_NO_POWER defines a height the finality provider did not vote at
+	// as it had no voting power
+	VoteStatus_SKIPPED_NO_POWER VoteStatus = 1
+	// FAILED defines a height the submission of the finality signature failed at
+	VoteStatus_FAILED VoteStatus = 2
+	// FINALIZED_WITHOUT_VOTE defines a height that was finalized before the
+	// finality signature of the finality provider was submitted
+	VoteStatus_FINALIZED_WITHOUT_VOTE VoteStatus = 3
+)
+
+// Enum value maps for VoteStatus.
+var (
+	VoteStatus_name = map[int32]string{
+		0: "VOTED",
+		1: "SKIPPED_NO_POWER",
+		2: "FAILED",
+		3: "FINALIZED_WITHOUT_VOTE",
+	}
+	VoteStatus_value = map[string]int32{
+		"VOTED":                  0,
+		"SKIPPED_NO_POWER":       1,
+		"FAILED":                 2,
+		"FINALIZED_WITHOUT_VOTE": 3,
+	}
+)
+
+func (x VoteStatus) Enum() *VoteStatus {
+	p := new(VoteStatus)
+	*p = x
+	return p
+}
+
+func (x VoteStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoteStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_finality_providers_proto_enumTypes[1].Descriptor()
+}
+
+func (VoteStatus) Type() protoreflect.EnumType {
+	return &file_finality_providers_proto_enumTypes[1]
+}
+
+func (x VoteStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoteStatus.Descriptor instead.
+func (VoteStatus) EnumDescriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{1}
+}
+
 type GetInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -146,6 +205,12 @@ type GetInfoResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// pub_rand_status is the status of the public randomness of each running
+	// finality provider instance
+	PubRandStatus []*PubRandStatus `protobuf:"bytes,2,rep,name=pub_rand_status,json=pubRandStatus,proto3" json:"pub_rand_status,omitempty"`
+	// catch_up_status is the status of the catch-up mode of each running
+	// finality provider instance
+	CatchUpStatus []*CatchUpStatus `protobuf:"bytes,3,rep,name=catch_up_status,json=catchUpStatus,proto3" json:"catch_up_status,omitempty"`
 }
 
 func (x *GetInfoResponse) Reset() {
@@ -187,6 +252,439 @@ func (x *GetInfoResponse) GetVersion() string {
 	return ""
 }
 
+func (x *GetInfoResponse) GetPubRandStatus() []*PubRandStatus {
+	if x != nil {
+		return x.PubRandStatus
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetCatchUpStatus() []*CatchUpStatus {
+	if x != nil {
+		return x.CatchUpStatus
+	}
+	return nil
+}
+
+// CatchUpStatus is the progress of the catch-up mode of a finality provider on
+// a chain, which it enters once it falls behind the tip of the chain
+type CatchUpStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// btc_pk_hex is the hex string of the BTC secp256k1 PK of the finality provider encoded in BIP-340 spec
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// chain_id is the identifier of the chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// active is true if the finality provider is catching up
+	Active bool `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// start_height is the height the last catch-up started from
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// target_height is the tip height the last catch-up catches up to
+	TargetHeight uint64 `protobuf:"varint,5,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
+	// processed_height is the highest height processed by the last catch-up
+	ProcessedHeight uint64 `protobuf:"varint,6,opt,name=processed_height,json=processedHeight,proto3" json:"processed_height,omitempty"`
+	// skipped_finalized is the number of blocks skipped by the last catch-up as
+	// they were already finalized
+	SkippedFinalized uint64 `protobuf:"varint,7,opt,name=skipped_finalized,json=skippedFinalized,proto3" json:"skipped_finalized,omitempty"`
+	// skipped_no_power is the number of blocks skipped by the last catch-up as
+	// the finality provider had no voting power at them
+	SkippedNoPower uint64 `protobuf:"varint,8,opt,name=skipped_no_power,json=skippedNoPower,proto3" json:"skipped_no_power,omitempty"`
+	// voted is the number of blocks voted for by the last catch-up
+	Voted uint64 `protobuf:"varint,9,opt,name=voted,proto3" json:"voted,omitempty"`
+	// num_txs is the number of transactions submitted by the last catch-up
+	NumTxs uint64 `protobuf:"varint,10,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+	// batch_size is the current number of finality signatures in a transaction
+	BatchSize uint32 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// started_at is the unix time in seconds the last catch-up started at
+	StartedAt int64 `protobuf:"varint,12,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// finished_at is the unix time in seconds the last catch-up finished at, or
+	// zero if it is active
+	FinishedAt int64 `protobuf:"varint,13,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// eta is the predicted unix time in seconds the active catch-up finishes at,
+	// or zero if it is not yet known
+	Eta int64 `protobuf:"varint,14,opt,name=eta,proto3" json:"eta,omitempty"`
+}
+
+func (x *CatchUpStatus) Reset() {
+	*x = CatchUpStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_finality_providers_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatchUpStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatchUpStatus) ProtoMessage() {}
+
+func (x *CatchUpStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatchUpStatus.ProtoReflect.Descriptor instead.
+func (*CatchUpStatus) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CatchUpStatus) GetBtcPkHex() string {
+	if x != nil {
+		return x.BtcPkHex
+	}
+	return ""
+}
+
+func (x *CatchUpStatus) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *CatchUpStatus) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *CatchUpStatus) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetTargetHeight() uint64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetProcessedHeight() uint64 {
+	if x != nil {
+		return x.ProcessedHeight
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetSkippedFinalized() uint64 {
+	if x != nil {
+		return x.SkippedFinalized
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetSkippedNoPower() uint64 {
+	if x != nil {
+		return x.SkippedNoPower
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetVoted() uint64 {
+	if x != nil {
+		return x.Voted
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetNumTxs() uint64 {
+	if x != nil {
+		return x.NumTxs
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *CatchUpStatus) GetEta() int64 {
+	if x != nil {
+		return x.Eta
+	}
+	return 0
+}
+
+// PubRandStatus is the status of the public randomness commits of a finality
+// provider on a chain, as tracked by the commit planner
+type PubRandStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// btc_pk_hex is the hex string of the BTC secp256k1 PK of the finality provider encoded in BIP-340 spec
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// chain_id is the identifier of the chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// tip_height is the tip height of the chain when the planner was last updated
+	TipHeight uint64 `protobuf:"varint,3,opt,name=tip_height,json=tipHeight,proto3" json:"tip_height,omitempty"`
+	// last_committed_height is the highest height with committed randomness
+	LastCommittedHeight uint64 `protobuf:"varint,4,opt,name=last_committed_height,json=lastCommittedHeight,proto3" json:"last_committed_height,omitempty"`
+	// covered_height is the highest height up to which the randomness is committed
+	// without gaps from the tip height
+	CoveredHeight uint64 `protobuf:"varint,5,opt,name=covered_height,json=coveredHeight,proto3" json:"covered_height,omitempty"`
+	// timestamped_height is the highest height up to which the randomness is
+	// committed and BTC-timestamped without gaps from the tip height
+	TimestampedHeight uint64 `protobuf:"varint,6,opt,name=timestamped_height,json=timestampedHeight,proto3" json:"timestamped_height,omitempty"`
+	// last_finalized_epoch is the last epoch finalized by BTC timestamping
+	LastFinalizedEpoch uint64 `protobuf:"varint,7,opt,name=last_finalized_epoch,json=lastFinalizedEpoch,proto3" json:"last_finalized_epoch,omitempty"`
+	// timestamping_delay_blocks is the delay in blocks between a commit and its
+	// BTC timestamping used to plan the commits
+	TimestampingDelayBlocks uint64 `protobuf:"varint,8,opt,name=timestamping_delay_blocks,json=timestampingDelayBlocks,proto3" json:"timestamping_delay_blocks,omitempty"`
+	// num_commits is the number of the tracked commits ending at or after the tip height
+	NumCommits uint32 `protobuf:"varint,9,opt,name=num_commits,json=numCommits,proto3" json:"num_commits,omitempty"`
+	// gaps are the height ranges between the tracked commits without randomness
+	Gaps []*HeightRange `protobuf:"bytes,10,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	// overlaps are the height ranges committed more than once
+	Overlaps []*HeightRange `protobuf:"bytes,11,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+	// pending_commit_start_height is the start height of the submitted commit
+	// that is not yet seen on chain, or zero if there is none
+	PendingCommitStartHeight uint64 `protobuf:"varint,12,opt,name=pending_commit_start_height,json=pendingCommitStartHeight,proto3" json:"pending_commit_start_height,omitempty"`
+	// failed_commits is the number of consecutive rejected commits
+	FailedCommits uint32 `protobuf:"varint,13,opt,name=failed_commits,json=failedCommits,proto3" json:"failed_commits,omitempty"`
+	// block_time_ms is the observed average block time in milliseconds, or zero
+	// if it is not yet known
+	BlockTimeMs int64 `protobuf:"varint,14,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+	// runout_time is the predicted unix time in seconds the committed randomness
+	// runs out at, or zero if it is not yet known
+	RunoutTime int64 `protobuf:"varint,15,opt,name=runout_time,json=runoutTime,proto3" json:"runout_time,omitempty"`
+	// timestamped_runout_time is the predicted unix time in seconds the committed
+	// and BTC-timestamped randomness runs out at, or zero if it is not yet known
+	TimestampedRunoutTime int64 `protobuf:"varint,16,opt,name=timestamped_runout_time,json=timestampedRunoutTime,proto3" json:"timestamped_runout_time,omitempty"`
+}
+
+func (x *PubRandStatus) Reset() {
+	*x = PubRandStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_finality_providers_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubRandStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubRandStatus) ProtoMessage() {}
+
+func (x *PubRandStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubRandStatus.ProtoReflect.Descriptor instead.
+func (*PubRandStatus) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PubRandStatus) GetBtcPkHex() string {
+	if x != nil {
+		return x.BtcPkHex
+	}
+	return ""
+}
+
+func (x *PubRandStatus) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PubRandStatus) GetTipHeight() uint64 {
+	if x != nil {
+		return x.TipHeight
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetLastCommittedHeight() uint64 {
+	if x != nil {
+		return x.LastCommittedHeight
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetCoveredHeight() uint64 {
+	if x != nil {
+		return x.CoveredHeight
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetTimestampedHeight() uint64 {
+	if x != nil {
+		return x.TimestampedHeight
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetLastFinalizedEpoch() uint64 {
+	if x != nil {
+		return x.LastFinalizedEpoch
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetTimestampingDelayBlocks() uint64 {
+	if x != nil {
+		return x.TimestampingDelayBlocks
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetNumCommits() uint32 {
+	if x != nil {
+		return x.NumCommits
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetGaps() []*HeightRange {
+	if x != nil {
+		return x.Gaps
+	}
+	return nil
+}
+
+func (x *PubRandStatus) GetOverlaps() []*HeightRange {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+func (x *PubRandStatus) GetPendingCommitStartHeight() uint64 {
+	if x != nil {
+		return x.PendingCommitStartHeight
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetFailedCommits() uint32 {
+	if x != nil {
+		return x.FailedCommits
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetBlockTimeMs() int64 {
+	if x != nil {
+		return x.BlockTimeMs
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetRunoutTime() int64 {
+	if x != nil {
+		return x.RunoutTime
+	}
+	return 0
+}
+
+func (x *PubRandStatus) GetTimestampedRunoutTime() int64 {
+	if x != nil {
+		return x.TimestampedRunoutTime
+	}
+	return 0
+}
+
+// HeightRange is an inclusive range of heights
+type HeightRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (x *HeightRange) Reset() {
+	*x = HeightRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_finality_providers_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeightRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeightRange) ProtoMessage() {}
+
+func (x *HeightRange) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeightRange.ProtoReflect.Descriptor instead.
+func (*HeightRange) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HeightRange) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *HeightRange) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
 type CreateFinalityProviderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -211,7 +709,7 @@ type CreateFinalityProviderRequest struct {
 func (x *CreateFinalityProviderRequest) Reset() {
 	*x = CreateFinalityProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[2]
+		mi := &file_finality_providers_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -224,7 +722,7 @@ func (x *CreateFinalityProviderRequest) String() string {
 func (*CreateFinalityProviderRequest) ProtoMessage() {}
 
 func (x *CreateFinalityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[2]
+	mi := &file_finality_providers_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +735,7 @@ func (x *CreateFinalityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFinalityProviderRequest.ProtoReflect.Descriptor instead.
 func (*CreateFinalityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{2}
+	return file_finality_providers_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateFinalityProviderRequest) GetKeyName() string {
@@ -295,7 +793,7 @@ type CreateFinalityProviderResponse struct {
 func (x *CreateFinalityProviderResponse) Reset() {
 	*x = CreateFinalityProviderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[3]
+		mi := &file_finality_providers_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -308,7 +806,7 @@ func (x *CreateFinalityProviderResponse) String() string {
 func (*CreateFinalityProviderResponse) ProtoMessage() {}
 
 func (x *CreateFinalityProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[3]
+	mi := &file_finality_providers_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,7 +819,7 @@ func (x *CreateFinalityProviderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFinalityProviderResponse.ProtoReflect.Descriptor instead.
 func (*CreateFinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{3}
+	return file_finality_providers_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateFinalityProviderResponse) GetFinalityProvider() *FinalityProviderInfo {
@@ -356,7 +854,7 @@ type AddFinalitySignatureRequest struct {
 func (x *AddFinalitySignatureRequest) Reset() {
 	*x = AddFinalitySignatureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[4]
+		mi := &file_finality_providers_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -369,7 +867,7 @@ func (x *AddFinalitySignatureRequest) String() string {
 func (*AddFinalitySignatureRequest) ProtoMessage() {}
 
 func (x *AddFinalitySignatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[4]
+	mi := &file_finality_providers_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +880,7 @@ func (x *AddFinalitySignatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFinalitySignatureRequest.ProtoReflect.Descriptor instead.
 func (*AddFinalitySignatureRequest) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{4}
+	return file_finality_providers_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AddFinalitySignatureRequest) GetBtcPk() string {
@@ -429,7 +927,7 @@ type AddFinalitySignatureResponse struct {
 func (x *AddFinalitySignatureResponse) Reset() {
 	*x = AddFinalitySignatureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[5]
+		mi := &file_finality_providers_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -442,7 +940,7 @@ func (x *AddFinalitySignatureResponse) String() string {
 func (*AddFinalitySignatureResponse) ProtoMessage() {}
 
 func (x *AddFinalitySignatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[5]
+	mi := &file_finality_providers_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +953,7 @@ func (x *AddFinalitySignatureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFinalitySignatureResponse.ProtoReflect.Descriptor instead.
 func (*AddFinalitySignatureResponse) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{5}
+	return file_finality_providers_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AddFinalitySignatureResponse) GetTxHash() string {
@@ -491,7 +989,7 @@ type UnjailFinalityProviderRequest struct {
 func (x *UnjailFinalityProviderRequest) Reset() {
 	*x = UnjailFinalityProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[6]
+		mi := &file_finality_providers_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -504,7 +1002,7 @@ func (x *UnjailFinalityProviderRequest) String() string {
 func (*UnjailFinalityProviderRequest) ProtoMessage() {}
 
 func (x *UnjailFinalityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[6]
+	mi := &file_finality_providers_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +1015,7 @@ func (x *UnjailFinalityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnjailFinalityProviderRequest.ProtoReflect.Descriptor instead.
 func (*UnjailFinalityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{6}
+	return file_finality_providers_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UnjailFinalityProviderRequest) GetBtcPk() string {
@@ -539,7 +1037,7 @@ type UnjailFinalityProviderResponse struct {
 func (x *UnjailFinalityProviderResponse) Reset() {
 	*x = UnjailFinalityProviderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[7]
+		mi := &file_finality_providers_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -552,7 +1050,7 @@ func (x *UnjailFinalityProviderResponse) String() string {
 func (*UnjailFinalityProviderResponse) ProtoMessage() {}
 
 func (x *UnjailFinalityProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[7]
+	mi := &file_finality_providers_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +1063,7 @@ func (x *UnjailFinalityProviderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnjailFinalityProviderResponse.ProtoReflect.Descriptor instead.
 func (*UnjailFinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{7}
+	return file_finality_providers_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UnjailFinalityProviderResponse) GetTxHash() string {
@@ -587,7 +1085,7 @@ type QueryFinalityProviderRequest struct {
 func (x *QueryFinalityProviderRequest) Reset() {
 	*x = QueryFinalityProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[8]
+		mi := &file_finality_providers_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -600,7 +1098,7 @@ func (x *QueryFinalityProviderRequest) String() string {
 func (*QueryFinalityProviderRequest) ProtoMessage() {}
 
 func (x *QueryFinalityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[8]
+	mi := &file_finality_providers_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,7 +1111,7 @@ func (x *QueryFinalityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryFinalityProviderRequest.ProtoReflect.Descriptor instead.
 func (*QueryFinalityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{8}
+	return file_finality_providers_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryFinalityProviderRequest) GetBtcPk() string {
@@ -634,7 +1132,7 @@ type QueryFinalityProviderResponse struct {
 func (x *QueryFinalityProviderResponse) Reset() {
 	*x = QueryFinalityProviderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[9]
+		mi := &file_finality_providers_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -647,7 +1145,7 @@ func (x *QueryFinalityProviderResponse) String() string {
 func (*QueryFinalityProviderResponse) ProtoMessage() {}
 
 func (x *QueryFinalityProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[9]
+	mi := &file_finality_providers_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +1158,7 @@ func (x *QueryFinalityProviderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryFinalityProviderResponse.ProtoReflect.Descriptor instead.
 func (*QueryFinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{9}
+	return file_finality_providers_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryFinalityProviderResponse) GetFinalityProvider() *FinalityProviderInfo {
@@ -679,7 +1177,7 @@ type QueryFinalityProviderListRequest struct {
 func (x *QueryFinalityProviderListRequest) Reset() {
 	*x = QueryFinalityProviderListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[10]
+		mi := &file_finality_providers_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -692,7 +1190,7 @@ func (x *QueryFinalityProviderListRequest) String() string {
 func (*QueryFinalityProviderListRequest) ProtoMessage() {}
 
 func (x *QueryFinalityProviderListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[10]
+	mi := &file_finality_providers_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +1203,7 @@ func (x *QueryFinalityProviderListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryFinalityProviderListRequest.ProtoReflect.Descriptor instead.
 func (*QueryFinalityProviderListRequest) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{10}
+	return file_finality_providers_proto_rawDescGZIP(), []int{13}
 }
 
 type QueryFinalityProviderListResponse struct {
@@ -719,7 +1217,7 @@ type QueryFinalityProviderListResponse struct {
 func (x *QueryFinalityProviderListResponse) Reset() {
 	*x = QueryFinalityProviderListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[11]
+		mi := &file_finality_providers_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +1230,7 @@ func (x *QueryFinalityProviderListResponse) String() string {
 func (*QueryFinalityProviderListResponse) ProtoMessage() {}
 
 func (x *QueryFinalityProviderListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[11]
+	mi := &file_finality_providers_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +1243,7 @@ func (x *QueryFinalityProviderListResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use QueryFinalityProviderListResponse.ProtoReflect.Descriptor instead.
 func (*QueryFinalityProviderListResponse) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{11}
+	return file_finality_providers_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryFinalityProviderListResponse) GetFinalityProviders() []*FinalityProviderInfo {
@@ -780,7 +1278,7 @@ type FinalityProvider struct {
 func (x *FinalityProvider) Reset() {
 	*x = FinalityProvider{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[12]
+		mi := &file_finality_providers_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -793,7 +1291,7 @@ func (x *FinalityProvider) String() string {
 func (*FinalityProvider) ProtoMessage() {}
 
 func (x *FinalityProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[12]
+	mi := &file_finality_providers_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +1304,7 @@ func (x *FinalityProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalityProvider.ProtoReflect.Descriptor instead.
 func (*FinalityProvider) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{12}
+	return file_finality_providers_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FinalityProvider) GetFpAddr() string {
@@ -883,7 +1381,7 @@ type FinalityProviderInfo struct {
 func (x *FinalityProviderInfo) Reset() {
 	*x = FinalityProviderInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[13]
+		mi := &file_finality_providers_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1394,7 @@ func (x *FinalityProviderInfo) String() string {
 func (*FinalityProviderInfo) ProtoMessage() {}
 
 func (x *FinalityProviderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[13]
+	mi := &file_finality_providers_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1407,7 @@ func (x *FinalityProviderInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalityProviderInfo.ProtoReflect.Descriptor instead.
 func (*FinalityProviderInfo) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{13}
+	return file_finality_providers_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *FinalityProviderInfo) GetFpAddr() string {
@@ -977,7 +1475,7 @@ type Description struct {
 func (x *Description) Reset() {
 	*x = Description{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[14]
+		mi := &file_finality_providers_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -990,7 +1488,7 @@ func (x *Description) String() string {
 func (*Description) ProtoMessage() {}
 
 func (x *Description) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[14]
+	mi := &file_finality_providers_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1501,7 @@ func (x *Description) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Description.ProtoReflect.Descriptor instead.
 func (*Description) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{14}
+	return file_finality_providers_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Description) GetMoniker() string {
@@ -1057,7 +1555,7 @@ type ProofOfPossession struct {
 func (x *ProofOfPossession) Reset() {
 	*x = ProofOfPossession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[15]
+		mi := &file_finality_providers_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1070,7 +1568,7 @@ func (x *ProofOfPossession) String() string {
 func (*ProofOfPossession) ProtoMessage() {}
 
 func (x *ProofOfPossession) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[15]
+	mi := &file_finality_providers_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1581,7 @@ func (x *ProofOfPossession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProofOfPossession.ProtoReflect.Descriptor instead.
 func (*ProofOfPossession) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{15}
+	return file_finality_providers_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ProofOfPossession) GetBtcSig() []byte {
@@ -1105,7 +1603,7 @@ type SchnorrRandPair struct {
 func (x *SchnorrRandPair) Reset() {
 	*x = SchnorrRandPair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[16]
+		mi := &file_finality_providers_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1118,7 +1616,7 @@ func (x *SchnorrRandPair) String() string {
 func (*SchnorrRandPair) ProtoMessage() {}
 
 func (x *SchnorrRandPair) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[16]
+	mi := &file_finality_providers_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1629,7 @@ func (x *SchnorrRandPair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchnorrRandPair.ProtoReflect.Descriptor instead.
 func (*SchnorrRandPair) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{16}
+	return file_finality_providers_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SchnorrRandPair) GetPubRand() []byte {
@@ -1166,7 +1664,7 @@ type SignMessageFromChainKeyRequest struct {
 func (x *SignMessageFromChainKeyRequest) Reset() {
 	*x = SignMessageFromChainKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[17]
+		mi := &file_finality_providers_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1179,7 +1677,7 @@ func (x *SignMessageFromChainKeyRequest) String() string {
 func (*SignMessageFromChainKeyRequest) ProtoMessage() {}
 
 func (x *SignMessageFromChainKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[17]
+	mi := &file_finality_providers_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1690,7 @@ func (x *SignMessageFromChainKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignMessageFromChainKeyRequest.ProtoReflect.Descriptor instead.
 func (*SignMessageFromChainKeyRequest) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{17}
+	return file_finality_providers_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SignMessageFromChainKeyRequest) GetMsgToSign() []byte {
@@ -1229,26 +1727,251 @@ type SignMessageFromChainKeyResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SignMessageFromChainKeyResponse) Reset() {
+	*x = SignMessageFromChainKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_finality_providers_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignMessageFromChainKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignMessageFromChainKeyResponse) ProtoMessage() {}
+
+func (x *SignMessageFromChainKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignMessageFromChainKeyResponse.ProtoReflect.Descriptor instead.
+func (*SignMessageFromChainKeyResponse) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SignMessageFromChainKeyResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// FinalityProviderInfo is the basic information of a finality provider mainly for external usage
+type EditFinalityProviderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// btc_pk is the hex string of the BTC secp256k1 PK of the finality provider encoded in BIP-340 spec
+	BtcPk string `protobuf:"bytes,1,opt,name=btc_pk,json=btcPk,proto3" json:"btc_pk,omitempty"`
+	// description defines the description terms for the finality provider
+	Description *Description `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// commission defines the updated commission rate of the finality provider
+	Commission string `protobuf:"bytes,4,opt,name=commission,proto3" json:"commission,omitempty"`
+}
+
+func (x *EditFinalityProviderRequest) Reset() {
+	*x = EditFinalityProviderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_finality_providers_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditFinalityProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditFinalityProviderRequest) ProtoMessage() {}
+
+func (x *EditFinalityProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditFinalityProviderRequest.ProtoReflect.Descriptor instead.
+func (*EditFinalityProviderRequest) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EditFinalityProviderRequest) GetBtcPk() string {
+	if x != nil {
+		return x.BtcPk
+	}
+	return ""
+}
+
+func (x *EditFinalityProviderRequest) GetDescription() *Description {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *EditFinalityProviderRequest) GetCommission() string {
+	if x != nil {
+		return x.Commission
+	}
+	return ""
+}
+
+type RemoveMerkleProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// btc_pk_hex is the hex string of the BTC secp256k1 PK of the finality provider encoded in BIP-340 spec
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// chain_id is the identifier of the consumer chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// target_height to to delete all proofs
+	TargetHeight uint64 `protobuf:"varint,3,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
+}
+
+func (x *RemoveMerkleProofRequest) Reset() {
+	*x = RemoveMerkleProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_finality_providers_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMerkleProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMerkleProofRequest) ProtoMessage() {}
+
+func (x *RemoveMerkleProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMerkleProofRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMerkleProofRequest) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveMerkleProofRequest) GetBtcPkHex() string {
+	if x != nil {
+		return x.BtcPkHex
+	}
+	return ""
+}
+
+func (x *RemoveMerkleProofRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *RemoveMerkleProofRequest) GetTargetHeight() uint64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+// Define an empty response message
+type EmptyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyResponse) Reset() {
+	*x = EmptyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_finality_providers_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyResponse) ProtoMessage() {}
+
+func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{24}
+}
+
+// VoteRecord is the record of the vote ledger of a finality provider at a height
+type VoteRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the height of the block
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// status is the outcome of the vote at the height
+	Status VoteStatus `protobuf:"varint,2,opt,name=status,proto3,enum=proto.VoteStatus" json:"status,omitempty"`
+	// timestamp is the time of the record, in Unix seconds
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *SignMessageFromChainKeyResponse) Reset() {
-	*x = SignMessageFromChainKeyResponse{}
+func (x *VoteRecord) Reset() {
+	*x = VoteRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[18]
+		mi := &file_finality_providers_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignMessageFromChainKeyResponse) String() string {
+func (x *VoteRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignMessageFromChainKeyResponse) ProtoMessage() {}
+func (*VoteRecord) ProtoMessage() {}
 
-func (x *SignMessageFromChainKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[18]
+func (x *VoteRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1259,49 +1982,61 @@ func (x *SignMessageFromChainKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignMessageFromChainKeyResponse.ProtoReflect.Descriptor instead.
-func (*SignMessageFromChainKeyResponse) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use VoteRecord.ProtoReflect.Descriptor instead.
+func (*VoteRecord) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SignMessageFromChainKeyResponse) GetSignature() []byte {
+func (x *VoteRecord) GetHeight() uint64 {
 	if x != nil {
-		return x.Signature
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-// FinalityProviderInfo is the basic information of a finality provider mainly for external usage
-type EditFinalityProviderRequest struct {
+func (x *VoteRecord) GetStatus() VoteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VoteStatus_VOTED
+}
+
+func (x *VoteRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type QueryFinalityProviderUptimeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// btc_pk is the hex string of the BTC secp256k1 PK of the finality provider encoded in BIP-340 spec
-	BtcPk string `protobuf:"bytes,1,opt,name=btc_pk,json=btcPk,proto3" json:"btc_pk,omitempty"`
-	// description defines the description terms for the finality provider
-	Description *Description `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// commission defines the updated commission rate of the finality provider
-	Commission string `protobuf:"bytes,4,opt,name=commission,proto3" json:"commission,omitempty"`
+	// btc_pk_hex is the hex string of the BTC secp256k1 PK of the finality provider encoded in BIP-340 spec
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// chain_id is the identifier of the chain, which is the one the finality provider is
+	// registered to if empty
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (x *EditFinalityProviderRequest) Reset() {
-	*x = EditFinalityProviderRequest{}
+func (x *QueryFinalityProviderUptimeRequest) Reset() {
+	*x = QueryFinalityProviderUptimeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[19]
+		mi := &file_finality_providers_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditFinalityProviderRequest) String() string {
+func (x *QueryFinalityProviderUptimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditFinalityProviderRequest) ProtoMessage() {}
+func (*QueryFinalityProviderUptimeRequest) ProtoMessage() {}
 
-func (x *EditFinalityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[19]
+func (x *QueryFinalityProviderUptimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,62 +2047,82 @@ func (x *EditFinalityProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditFinalityProviderRequest.ProtoReflect.Descriptor instead.
-func (*EditFinalityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QueryFinalityProviderUptimeRequest.ProtoReflect.Descriptor instead.
+func (*QueryFinalityProviderUptimeRequest) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *EditFinalityProviderRequest) GetBtcPk() string {
+func (x *QueryFinalityProviderUptimeRequest) GetBtcPkHex() string {
 	if x != nil {
-		return x.BtcPk
+		return x.BtcPkHex
 	}
 	return ""
 }
 
-func (x *EditFinalityProviderRequest) GetDescription() *Description {
-	if x != nil {
-		return x.Description
-	}
-	return nil
-}
-
-func (x *EditFinalityProviderRequest) GetCommission() string {
+func (x *QueryFinalityProviderUptimeRequest) GetChainId() string {
 	if x != nil {
-		return x.Commission
+		return x.ChainId
 	}
 	return ""
 }
 
-type RemoveMerkleProofRequest struct {
+type QueryFinalityProviderUptimeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// btc_pk_hex is the hex string of the BTC secp256k1 PK of the finality provider encoded in BIP-340 spec
 	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
-	// chain_id is the identifier of the consumer chain
+	// chain_id is the identifier of the chain
 	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// target_height to to delete all proofs
-	TargetHeight uint64 `protobuf:"varint,3,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
-}
-
-func (x *RemoveMerkleProofRequest) Reset() {
-	*x = RemoveMerkleProofRequest{}
+	// signed_blocks_window is the number of blocks with voting power in the window
+	SignedBlocksWindow int64 `protobuf:"varint,3,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// min_signed_per_window is the number of blocks of the window the finality
+	// provider has to vote for to not be jailed
+	MinSignedPerWindow int64 `protobuf:"varint,4,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`
+	// start_height is the lowest height of the window
+	StartHeight uint64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the highest height of the window, which is the last height
+	// in the vote ledger
+	EndHeight uint64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// voted is the number of blocks of the window the finality provider voted for
+	Voted int64 `protobuf:"varint,7,opt,name=voted,proto3" json:"voted,omitempty"`
+	// failed is the number of blocks of the window the submission failed for
+	Failed int64 `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	// finalized_without_vote is the number of blocks of the window finalized before
+	// the finality provider voted
+	FinalizedWithoutVote int64 `protobuf:"varint,9,opt,name=finalized_without_vote,json=finalizedWithoutVote,proto3" json:"finalized_without_vote,omitempty"`
+	// unrecorded is the number of heights of the window without a record in the
+	// vote ledger, e.g., as the finality provider was down; they are counted as missed
+	Unrecorded int64 `protobuf:"varint,10,opt,name=unrecorded,proto3" json:"unrecorded,omitempty"`
+	// missed is the number of blocks of the window the finality provider missed
+	Missed int64 `protobuf:"varint,11,opt,name=missed,proto3" json:"missed,omitempty"`
+	// max_missed is the number of blocks of the window the finality provider can
+	// miss without being jailed
+	MaxMissed int64 `protobuf:"varint,12,opt,name=max_missed,json=maxMissed,proto3" json:"max_missed,omitempty"`
+	// at_risk is true if the missed blocks exceed the warning threshold of max_missed
+	AtRisk bool `protobuf:"varint,13,opt,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`
+	// missed_heights are the heights of the window the finality provider missed
+	MissedHeights []uint64 `protobuf:"varint,14,rep,packed,name=missed_heights,json=missedHeights,proto3" json:"missed_heights,omitempty"`
+}
+
+func (x *QueryFinalityProviderUptimeResponse) Reset() {
+	*x = QueryFinalityProviderUptimeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[20]
+		mi := &file_finality_providers_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveMerkleProofRequest) String() string {
+func (x *QueryFinalityProviderUptimeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMerkleProofRequest) ProtoMessage() {}
+func (*QueryFinalityProviderUptimeResponse) ProtoMessage() {}
 
-func (x *RemoveMerkleProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[20]
+func (x *QueryFinalityProviderUptimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finality_providers_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,69 +2133,107 @@ func (x *RemoveMerkleProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMerkleProofRequest.ProtoReflect.Descriptor instead.
-func (*RemoveMerkleProofRequest) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use QueryFinalityProviderUptimeResponse.ProtoReflect.Descriptor instead.
+func (*QueryFinalityProviderUptimeResponse) Descriptor() ([]byte, []int) {
+	return file_finality_providers_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RemoveMerkleProofRequest) GetBtcPkHex() string {
+func (x *QueryFinalityProviderUptimeResponse) GetBtcPkHex() string {
 	if x != nil {
 		return x.BtcPkHex
 	}
 	return ""
 }
 
-func (x *RemoveMerkleProofRequest) GetChainId() string {
+func (x *QueryFinalityProviderUptimeResponse) GetChainId() string {
 	if x != nil {
 		return x.ChainId
 	}
 	return ""
 }
 
-func (x *RemoveMerkleProofRequest) GetTargetHeight() uint64 {
+func (x *QueryFinalityProviderUptimeResponse) GetSignedBlocksWindow() int64 {
 	if x != nil {
-		return x.TargetHeight
+		return x.SignedBlocksWindow
 	}
 	return 0
 }
 
-// Define an empty response message
-type EmptyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *QueryFinalityProviderUptimeResponse) GetMinSignedPerWindow() int64 {
+	if x != nil {
+		return x.MinSignedPerWindow
+	}
+	return 0
 }
 
-func (x *EmptyResponse) Reset() {
-	*x = EmptyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_finality_providers_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryFinalityProviderUptimeResponse) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *QueryFinalityProviderUptimeResponse) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
 	}
+	return 0
 }
 
-func (x *EmptyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryFinalityProviderUptimeResponse) GetVoted() int64 {
+	if x != nil {
+		return x.Voted
+	}
+	return 0
 }
 
-func (*EmptyResponse) ProtoMessage() {}
+func (x *QueryFinalityProviderUptimeResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
 
-func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finality_providers_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *QueryFinalityProviderUptimeResponse) GetFinalizedWithoutVote() int64 {
+	if x != nil {
+		return x.FinalizedWithoutVote
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
-func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_finality_providers_proto_rawDescGZIP(), []int{21}
+func (x *QueryFinalityProviderUptimeResponse) GetUnrecorded() int64 {
+	if x != nil {
+		return x.Unrecorded
+	}
+	return 0
+}
+
+func (x *QueryFinalityProviderUptimeResponse) GetMissed() int64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *QueryFinalityProviderUptimeResponse) GetMaxMissed() int64 {
+	if x != nil {
+		return x.MaxMissed
+	}
+	return 0
+}
+
+func (x *QueryFinalityProviderUptimeResponse) GetAtRisk() bool {
+	if x != nil {
+		return x.AtRisk
+	}
+	return false
+}
+
+func (x *QueryFinalityProviderUptimeResponse) GetMissedHeights() []uint64 {
+	if x != nil {
+		return x.MissedHeights
+	}
+	return nil
 }
 
 var File_finality_providers_proto protoreflect.FileDescriptor
@@ -1454,234 +2247,382 @@ var file_finality_providers_proto_rawDesc = []byte{
 	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
-	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68,
-	0x72, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x6f, 0x74, 0x73, 0x5f, 0x70,
-	0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6f, 0x74,
-	0x73, 0x50, 0x6b, 0x48, 0x65, 0x78, 0x22, 0x83, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x66, 0x69, 0x6e,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x93, 0x01, 0x0a,
-	0x1b, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06,
-	0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x74,
-	0x63, 0x50, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
-	0x70, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
-	0x70, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
-	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69,
-	0x67, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x10,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6b, 0x5f, 0x68, 0x65, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x64, 0x53, 0x6b, 0x48, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
-	0x73, 0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x53, 0x6b, 0x48, 0x65, 0x78, 0x22, 0x36, 0x0a, 0x1d, 0x55, 0x6e, 0x6a, 0x61,
-	0x69, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x74, 0x63,
-	0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x74, 0x63, 0x50, 0x6b,
-	0x22, 0x39, 0x0a, 0x1e, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x5f,
+	0x72, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x52, 0x61, 0x6e,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x52, 0x61, 0x6e, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x75, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xca, 0x03, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b,
+	0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50,
+	0x6b, 0x48, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4e, 0x6f, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x74,
+	0x78, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x54, 0x78, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x74,
+	0x61, 0x22, 0xbb, 0x05, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x52, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x48, 0x65,
+	0x78, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x69, 0x70, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x74, 0x69, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x67, 0x61, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x67, 0x61, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x08,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x12, 0x3d, 0x0a, 0x1b,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x18, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6d, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x6f, 0x75, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x75, 0x6e,
+	0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x65, 0x64, 0x52, 0x75, 0x6e, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x4f, 0x0a, 0x0b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xfc, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0b, 0x65, 0x6f, 0x74, 0x73, 0x5f, 0x70, 0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6f, 0x74, 0x73, 0x50, 0x6b, 0x48, 0x65, 0x78, 0x22,
+	0x83, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
 	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x35, 0x0a, 0x1c, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62,
-	0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x74, 0x63,
-	0x50, 0x6b, 0x22, 0x69, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x66, 0x69, 0x6e,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x22, 0x0a,
-	0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x6f, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x11, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x70, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x06, 0x66, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x74,
-	0x63, 0x5f, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x74, 0x63, 0x50,
-	0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65,
-	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
-	0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc5, 0x02, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x31, 0x0a, 0x07, 0x66, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x66, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x5f, 0x68, 0x65, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x48, 0x65, 0x78,
-	0x12, 0x34, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65,
-	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xa2,
-	0x01, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x29,
-	0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f,
-	0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x74, 0x63, 0x5f,
-	0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x74, 0x63, 0x53, 0x69,
-	0x67, 0x22, 0x47, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x52, 0x61, 0x6e, 0x64,
-	0x50, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x72, 0x61, 0x6e, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x75, 0x62, 0x52, 0x61, 0x6e, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x73, 0x65, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x53,
-	0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0b, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x54, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x19, 0x0a,
-	0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73,
-	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
-	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x64, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x64, 0x50, 0x61, 0x74,
-	0x68, 0x22, 0x3f, 0x0a, 0x1f, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x1b, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x93, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x70, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x2a, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x1c,
+	0x41, 0x64, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6b, 0x48, 0x65, 0x78, 0x12,
+	0x20, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x6b, 0x48, 0x65,
+	0x78, 0x22, 0x36, 0x0a, 0x1d, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c,
 	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x12, 0x34, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x28, 0x09, 0x52, 0x05, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x22, 0x39, 0x0a, 0x1e, 0x55, 0x6e, 0x6a,
+	0x61, 0x69, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x35, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x22, 0x69, 0x0a, 0x1d, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x11,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6f, 0x0a, 0x21, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x10,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x31, 0x0a, 0x07, 0x66, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x66, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xc5, 0x02, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x66, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x0a, 0x62,
+	0x74, 0x63, 0x5f, 0x70, 0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x48, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
 	0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x4d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x2d, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x78,
-	0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x74,
-	0x63, 0x5f, 0x70, 0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x62, 0x74, 0x63, 0x50, 0x6b, 0x48, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xa4, 0x01, 0x0a, 0x16, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52,
-	0x45, 0x44, 0x10, 0x00, 0x1a, 0x0e, 0x8a, 0x9d, 0x20, 0x0a, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
-	0x45, 0x52, 0x45, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01,
-	0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x12, 0x1a, 0x0a, 0x08,
-	0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x1a, 0x0c, 0x8a, 0x9d, 0x20, 0x08,
-	0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x4c, 0x41, 0x53,
-	0x48, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x0b, 0x8a, 0x9d, 0x20, 0x07, 0x53, 0x4c, 0x41, 0x53, 0x48,
-	0x45, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x0a,
-	0x8a, 0x9d, 0x20, 0x06, 0x4a, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
-	0x32, 0xf4, 0x05, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x65, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46,
+	0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x6f, 0x74,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xa2, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x11,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x74, 0x63, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x62, 0x74, 0x63, 0x53, 0x69, 0x67, 0x22, 0x47, 0x0a, 0x0f, 0x53, 0x63,
+	0x68, 0x6e, 0x6f, 0x72, 0x72, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x75, 0x62, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x75, 0x62, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x5f,
+	0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x65, 0x63, 0x52,
+	0x61, 0x6e, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x6f,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6d, 0x73, 0x67,
+	0x54, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x64, 0x50, 0x61, 0x74, 0x68, 0x22, 0x3f, 0x0a, 0x1f, 0x53, 0x69,
+	0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x1b,
+	0x45, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62,
+	0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x74, 0x63,
+	0x50, 0x6b, 0x12, 0x34, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x6b, 0x48, 0x65,
+	0x78, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x6d, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x5d, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x74, 0x63, 0x5f, 0x70,
+	0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x74, 0x63,
+	0x50, 0x6b, 0x48, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x22, 0x80, 0x04, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x74, 0x63, 0x5f,
+	0x70, 0x6b, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x74,
+	0x63, 0x50, 0x6b, 0x48, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65,
+	0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x75, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x74, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x25, 0x0a, 0x0e,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x2a, 0xa4, 0x01, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x0e,
+	0x8a, 0x9d, 0x20, 0x0a, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x02, 0x1a, 0x0c, 0x8a, 0x9d, 0x20, 0x08, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x1a,
+	0x0b, 0x8a, 0x9d, 0x20, 0x07, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x45, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x4a, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x4a, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xa4, 0x01, 0x0a, 0x0a, 0x56,
+	0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x4f, 0x54,
+	0x45, 0x44, 0x10, 0x00, 0x1a, 0x09, 0x8a, 0x9d, 0x20, 0x05, 0x56, 0x4f, 0x54, 0x45, 0x44, 0x12,
+	0x2a, 0x0a, 0x10, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x50, 0x4f,
+	0x57, 0x45, 0x52, 0x10, 0x01, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x53, 0x4b, 0x49, 0x50, 0x50,
+	0x45, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x12, 0x16, 0x0a, 0x06, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x12, 0x36, 0x0a, 0x16, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x03, 0x1a,
+	0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x32, 0xea, 0x06, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x65, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x46,
 	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x55, 0x6e, 0x6a, 0x61,
+	0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x55, 0x6e, 0x6a,
+	0x61, 0x69, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x6a, 0x61,
 	0x69, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x6a, 0x61, 0x69,
-	0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x62, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61,
-	0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x62, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x14, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x62, 0x79, 0x6c, 0x6f, 0x6e, 0x6c, 0x61, 0x62,
-	0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2d, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2d,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x14, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x45,
+	0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x62,
+	0x79, 0x6c, 0x6f, 0x6e, 0x6c, 0x61, 0x62, 0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x2d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1696,61 +2637,75 @@ func file_finality_providers_proto_rawDescGZIP() []byte {
 	return file_finality_providers_proto_rawDescData
 }
 
-var file_finality_providers_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_finality_providers_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_finality_providers_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_finality_providers_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_finality_providers_proto_goTypes = []interface{}{
-	(FinalityProviderStatus)(0),               // 0: proto.FinalityProviderStatus
-	(*GetInfoRequest)(nil),                    // 1: proto.GetInfoRequest
-	(*GetInfoResponse)(nil),                   // 2: proto.GetInfoResponse
-	(*CreateFinalityProviderRequest)(nil),     // 3: proto.CreateFinalityProviderRequest
-	(*CreateFinalityProviderResponse)(nil),    // 4: proto.CreateFinalityProviderResponse
-	(*AddFinalitySignatureRequest)(nil),       // 5: proto.AddFinalitySignatureRequest
-	(*AddFinalitySignatureResponse)(nil),      // 6: proto.AddFinalitySignatureResponse
-	(*UnjailFinalityProviderRequest)(nil),     // 7: proto.UnjailFinalityProviderRequest
-	(*UnjailFinalityProviderResponse)(nil),    // 8: proto.UnjailFinalityProviderResponse
-	(*QueryFinalityProviderRequest)(nil),      // 9: proto.QueryFinalityProviderRequest
-	(*QueryFinalityProviderResponse)(nil),     // 10: proto.QueryFinalityProviderResponse
-	(*QueryFinalityProviderListRequest)(nil),  // 11: proto.QueryFinalityProviderListRequest
-	(*QueryFinalityProviderListResponse)(nil), // 12: proto.QueryFinalityProviderListResponse
-	(*FinalityProvider)(nil),                  // 13: proto.FinalityProvider
-	(*FinalityProviderInfo)(nil),              // 14: proto.FinalityProviderInfo
-	(*Description)(nil),                       // 15: proto.Description
-	(*ProofOfPossession)(nil),                 // 16: proto.ProofOfPossession
-	(*SchnorrRandPair)(nil),                   // 17: proto.SchnorrRandPair
-	(*SignMessageFromChainKeyRequest)(nil),    // 18: proto.SignMessageFromChainKeyRequest
-	(*SignMessageFromChainKeyResponse)(nil),   // 19: proto.SignMessageFromChainKeyResponse
-	(*EditFinalityProviderRequest)(nil),       // 20: proto.EditFinalityProviderRequest
-	(*RemoveMerkleProofRequest)(nil),          // 21: proto.RemoveMerkleProofRequest
-	(*EmptyResponse)(nil),                     // 22: proto.EmptyResponse
+	(FinalityProviderStatus)(0),                 // 0: proto.FinalityProviderStatus
+	(VoteStatus)(0),                             // 1: proto.VoteStatus
+	(*GetInfoRequest)(nil),                      // 2: proto.GetInfoRequest
+	(*GetInfoResponse)(nil),                     // 3: proto.GetInfoResponse
+	(*CatchUpStatus)(nil),                       // 4: proto.CatchUpStatus
+	(*PubRandStatus)(nil),                       // 5: proto.PubRandStatus
+	(*HeightRange)(nil),                         // 6: proto.HeightRange
+	(*CreateFinalityProviderRequest)(nil),       // 7: proto.CreateFinalityProviderRequest
+	(*CreateFinalityProviderResponse)(nil),      // 8: proto.CreateFinalityProviderResponse
+	(*AddFinalitySignatureRequest)(nil),         // 9: proto.AddFinalitySignatureRequest
+	(*AddFinalitySignatureResponse)(nil),        // 10: proto.AddFinalitySignatureResponse
+	(*UnjailFinalityProviderRequest)(nil),       // 11: proto.UnjailFinalityProviderRequest
+	(*UnjailFinalityProviderResponse)(nil),      // 12: proto.UnjailFinalityProviderResponse
+	(*QueryFinalityProviderRequest)(nil),        // 13: proto.QueryFinalityProviderRequest
+	(*QueryFinalityProviderResponse)(nil),       // 14: proto.QueryFinalityProviderResponse
+	(*QueryFinalityProviderListRequest)(nil),    // 15: proto.QueryFinalityProviderListRequest
+	(*QueryFinalityProviderListResponse)(nil),   // 16: proto.QueryFinalityProviderListResponse
+	(*FinalityProvider)(nil),                    // 17: proto.FinalityProvider
+	(*FinalityProviderInfo)(nil),                // 18: proto.FinalityProviderInfo
+	(*Description)(nil),                         // 19: proto.Description
+	(*ProofOfPossession)(nil),                   // 20: proto.ProofOfPossession
+	(*SchnorrRandPair)(nil),                     // 21: proto.SchnorrRandPair
+	(*SignMessageFromChainKeyRequest)(nil),      // 22: proto.SignMessageFromChainKeyRequest
+	(*SignMessageFromChainKeyResponse)(nil),     // 23: proto.SignMessageFromChainKeyResponse
+	(*EditFinalityProviderRequest)(nil),         // 24: proto.EditFinalityProviderRequest
+	(*RemoveMerkleProofRequest)(nil),            // 25: proto.RemoveMerkleProofRequest
+	(*EmptyResponse)(nil),                       // 26: proto.EmptyResponse
+	(*VoteRecord)(nil),                          // 27: proto.VoteRecord
+	(*QueryFinalityProviderUptimeRequest)(nil),  // 28: proto.QueryFinalityProviderUptimeRequest
+	(*QueryFinalityProviderUptimeResponse)(nil), // 29: proto.QueryFinalityProviderUptimeResponse
 }
 var file_finality_providers_proto_depIdxs = []int32{
-	14, // 0: proto.CreateFinalityProviderResponse.finality_provider:type_name -> proto.FinalityProviderInfo
-	14, // 1: proto.QueryFinalityProviderResponse.finality_provider:type_name -> proto.FinalityProviderInfo
-	14, // 2: proto.QueryFinalityProviderListResponse.finality_providers:type_name -> proto.FinalityProviderInfo
-	0,  // 3: proto.FinalityProvider.status:type_name -> proto.FinalityProviderStatus
-	15, // 4: proto.FinalityProviderInfo.description:type_name -> proto.Description
-	15, // 5: proto.EditFinalityProviderRequest.description:type_name -> proto.Description
-	1,  // 6: proto.FinalityProviders.GetInfo:input_type -> proto.GetInfoRequest
-	3,  // 7: proto.FinalityProviders.CreateFinalityProvider:input_type -> proto.CreateFinalityProviderRequest
-	5,  // 8: proto.FinalityProviders.AddFinalitySignature:input_type -> proto.AddFinalitySignatureRequest
-	7,  // 9: proto.FinalityProviders.UnjailFinalityProvider:input_type -> proto.UnjailFinalityProviderRequest
-	9,  // 10: proto.FinalityProviders.QueryFinalityProvider:input_type -> proto.QueryFinalityProviderRequest
-	11, // 11: proto.FinalityProviders.QueryFinalityProviderList:input_type -> proto.QueryFinalityProviderListRequest
-	20, // 12: proto.FinalityProviders.EditFinalityProvider:input_type -> proto.EditFinalityProviderRequest
-	21, // 13: proto.FinalityProviders.UnsafeRemoveMerkleProof:input_type -> proto.RemoveMerkleProofRequest
-	2,  // 14: proto.FinalityProviders.GetInfo:output_type -> proto.GetInfoResponse
-	4,  // 15: proto.FinalityProviders.CreateFinalityProvider:output_type -> proto.CreateFinalityProviderResponse
-	6,  // 16: proto.FinalityProviders.AddFinalitySignature:output_type -> proto.AddFinalitySignatureResponse
-	8,  // 17: proto.FinalityProviders.UnjailFinalityProvider:output_type -> proto.UnjailFinalityProviderResponse
-	10, // 18: proto.FinalityProviders.QueryFinalityProvider:output_type -> proto.QueryFinalityProviderResponse
-	12, // 19: proto.FinalityProviders.QueryFinalityProviderList:output_type -> proto.QueryFinalityProviderListResponse
-	22, // 20: proto.FinalityProviders.EditFinalityProvider:output_type -> proto.EmptyResponse
-	22, // 21: proto.FinalityProviders.UnsafeRemoveMerkleProof:output_type -> proto.EmptyResponse
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	5,  // 0: proto.GetInfoResponse.pub_rand_status:type_name -> proto.PubRandStatus
+	4,  // 1: proto.GetInfoResponse.catch_up_status:type_name -> proto.CatchUpStatus
+	6,  // 2: proto.PubRandStatus.gaps:type_name -> proto.HeightRange
+	6,  // 3: proto.PubRandStatus.overlaps:type_name -> proto.HeightRange
+	18, // 4: proto.CreateFinalityProviderResponse.finality_provider:type_name -> proto.FinalityProviderInfo
+	18, // 5: proto.QueryFinalityProviderResponse.finality_provider:type_name -> proto.FinalityProviderInfo
+	18, // 6: proto.QueryFinalityProviderListResponse.finality_providers:type_name -> proto.FinalityProviderInfo
+	0,  // 7: proto.FinalityProvider.status:type_name -> proto.FinalityProviderStatus
+	19, // 8: proto.FinalityProviderInfo.description:type_name -> proto.Description
+	19, // 9: proto.EditFinalityProviderRequest.description:type_name -> proto.Description
+	1,  // 10: proto.VoteRecord.status:type_name -> proto.VoteStatus
+	2,  // 11: proto.FinalityProviders.GetInfo:input_type -> proto.GetInfoRequest
+	7,  // 12: proto.FinalityProviders.CreateFinalityProvider:input_type -> proto.CreateFinalityProviderRequest
+	9,  // 13: proto.FinalityProviders.AddFinalitySignature:input_type -> proto.AddFinalitySignatureRequest
+	11, // 14: proto.FinalityProviders.UnjailFinalityProvider:input_type -> proto.UnjailFinalityProviderRequest
+	13, // 15: proto.FinalityProviders.QueryFinalityProvider:input_type -> proto.QueryFinalityProviderRequest
+	15, // 16: proto.FinalityProviders.QueryFinalityProviderList:input_type -> proto.QueryFinalityProviderListRequest
+	24, // 17: proto.FinalityProviders.EditFinalityProvider:input_type -> proto.EditFinalityProviderRequest
+	25, // 18: proto.FinalityProviders.UnsafeRemoveMerkleProof:input_type -> proto.RemoveMerkleProofRequest
+	28, // 19: proto.FinalityProviders.QueryFinalityProviderUptime:input_type -> proto.QueryFinalityProviderUptimeRequest
+	3,  // 20: proto.FinalityProviders.GetInfo:output_type -> proto.GetInfoResponse
+	8,  // 21: proto.FinalityProviders.CreateFinalityProvider:output_type -> proto.CreateFinalityProviderResponse
+	10, // 22: proto.FinalityProviders.AddFinalitySignature:output_type -> proto.AddFinalitySignatureResponse
+	12, // 23: proto.FinalityProviders.UnjailFinalityProvider:output_type -> proto.UnjailFinalityProviderResponse
+	14, // 24: proto.FinalityProviders.QueryFinalityProvider:output_type -> proto.QueryFinalityProviderResponse
+	16, // 25: proto.FinalityProviders.QueryFinalityProviderList:output_type -> proto.QueryFinalityProviderListResponse
+	26, // 26: proto.FinalityProviders.EditFinalityProvider:output_type -> proto.EmptyResponse
+	26, // 27: proto.FinalityProviders.UnsafeRemoveMerkleProof:output_type -> proto.EmptyResponse
+	29, // 28: proto.FinalityProviders.QueryFinalityProviderUptime:output_type -> proto.QueryFinalityProviderUptimeResponse
+	20, // [20:29] is the sub-list for method output_type
+	11, // [11:20] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_finality_providers_proto_init() }
@@ -1784,7 +2739,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFinalityProviderRequest); i {
+			switch v := v.(*CatchUpStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1796,7 +2751,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFinalityProviderResponse); i {
+			switch v := v.(*PubRandStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1808,7 +2763,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFinalitySignatureRequest); i {
+			switch v := v.(*HeightRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1820,7 +2775,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFinalitySignatureResponse); i {
+			switch v := v.(*CreateFinalityProviderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1832,7 +2787,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnjailFinalityProviderRequest); i {
+			switch v := v.(*CreateFinalityProviderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1844,7 +2799,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnjailFinalityProviderResponse); i {
+			switch v := v.(*AddFinalitySignatureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1856,7 +2811,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFinalityProviderRequest); i {
+			switch v := v.(*AddFinalitySignatureResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1868,7 +2823,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFinalityProviderResponse); i {
+			switch v := v.(*UnjailFinalityProviderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1880,7 +2835,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFinalityProviderListRequest); i {
+			switch v := v.(*UnjailFinalityProviderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1892,7 +2847,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFinalityProviderListResponse); i {
+			switch v := v.(*QueryFinalityProviderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1904,7 +2859,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalityProvider); i {
+			switch v := v.(*QueryFinalityProviderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1916,7 +2871,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalityProviderInfo); i {
+			switch v := v.(*QueryFinalityProviderListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1928,7 +2883,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Description); i {
+			switch v := v.(*QueryFinalityProviderListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1940,7 +2895,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofOfPossession); i {
+			switch v := v.(*FinalityProvider); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1952,7 +2907,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SchnorrRandPair); i {
+			switch v := v.(*FinalityProviderInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1964,7 +2919,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignMessageFromChainKeyRequest); i {
+			switch v := v.(*Description); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1976,7 +2931,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignMessageFromChainKeyResponse); i {
+			switch v := v.(*ProofOfPossession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1988,7 +2943,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditFinalityProviderRequest); i {
+			switch v := v.(*SchnorrRandPair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2000,7 +2955,7 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMerkleProofRequest); i {
+			switch v := v.(*SignMessageFromChainKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2012,6 +2967,42 @@ func file_finality_providers_proto_init() {
 			}
 		}
 		file_finality_providers_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignMessageFromChainKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_finality_providers_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditFinalityProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_finality_providers_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMerkleProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_finality_providers_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyResponse); i {
 			case 0:
 				return &v.state
@@ -2023,14 +3014,50 @@ func file_finality_providers_proto_init() {
 				return nil
 			}
 		}
+		file_finality_providers_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_finality_providers_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFinalityProviderUptimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_finality_providers_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFinalityProviderUptimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_finality_providers_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      2,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
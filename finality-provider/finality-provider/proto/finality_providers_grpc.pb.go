@@ -19,14 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FinalityProviders_GetInfo_FullMethodName                   = "/proto.FinalityProviders/GetInfo"
-	FinalityProviders_CreateFinalityProvider_FullMethodName    = "/proto.FinalityProviders/CreateFinalityProvider"
-	FinalityProviders_AddFinalitySignature_FullMethodName      = "/proto.FinalityProviders/AddFinalitySignature"
-	FinalityProviders_UnjailFinalityProvider_FullMethodName    = "/proto.FinalityProviders/UnjailFinalityProvider"
-	FinalityProviders_QueryFinalityProvider_FullMethodName     = "/proto.FinalityProviders/QueryFinalityProvider"
-	FinalityProviders_QueryFinalityProviderList_FullMethodName = "/proto.FinalityProviders/QueryFinalityProviderList"
-	FinalityProviders_EditFinalityProvider_FullMethodName      = "/proto.FinalityProviders/EditFinalityProvider"
-	FinalityProviders_UnsafeRemoveMerkleProof_FullMethodName   = "/proto.FinalityProviders/UnsafeRemoveMerkleProof"
+	FinalityProviders_GetInfo_FullMethodName                     = "/proto.FinalityProviders/GetInfo"
+	FinalityProviders_CreateFinalityProvider_FullMethodName      = "/proto.FinalityProviders/CreateFinalityProvider"
+	FinalityProviders_AddFinalitySignature_FullMethodName        = "/proto.FinalityProviders/AddFinalitySignature"
+	FinalityProviders_UnjailFinalityProvider_FullMethodName      = "/proto.FinalityProviders/UnjailFinalityProvider"
+	FinalityProviders_QueryFinalityProvider_FullMethodName       = "/proto.FinalityProviders/QueryFinalityProvider"
+	FinalityProviders_QueryFinalityProviderList_FullMethodName   = "/proto.FinalityProviders/QueryFinalityProviderList"
+	FinalityProviders_EditFinalityProvider_FullMethodName        = "/proto.FinalityProviders/EditFinalityProvider"
+	FinalityProviders_UnsafeRemoveMerkleProof_FullMethodName     = "/proto.FinalityProviders/UnsafeRemoveMerkleProof"
+	FinalityProviders_QueryFinalityProviderUptime_FullMethodName = "/proto.FinalityProviders/QueryFinalityProviderUptime"
 )
 
 // FinalityProvidersClient is the client API for FinalityProviders service.
@@ -51,6 +52,9 @@ type FinalityProvidersClient interface {
 	EditFinalityProvider(ctx context.Context, in *EditFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// UnsafeRemoveMerkleProof removes merkle proofs up to target height
 	UnsafeRemoveMerkleProof(ctx context.Context, in *RemoveMerkleProofRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// QueryFinalityProviderUptime queries the votes of the finality provider over
+	// the rolling missed-blocks window from the local vote ledger
+	QueryFinalityProviderUptime(ctx context.Context, in *QueryFinalityProviderUptimeRequest, opts ...grpc.CallOption) (*QueryFinalityProviderUptimeResponse, error)
 }
 
 type finalityProvidersClient struct {
@@ -133,6 +137,15 @@ func (c *finalityProvidersClient) UnsafeRemoveMerkleProof(ctx context.Context, i
 	return out, nil
 }
 
+func (c *finalityProvidersClient) QueryFinalityProviderUptime(ctx context.Context, in *QueryFinalityProviderUptimeRequest, opts ...grpc.CallOption) (*QueryFinalityProviderUptimeResponse, error) {
+	out := new(QueryFinalityProviderUptimeResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_QueryFinalityProviderUptime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FinalityProvidersServer is the server API for FinalityProviders service.
 // All implementations must embed UnimplementedFinalityProvidersServer
 // for forward compatibility
@@ -155,6 +168,9 @@ type FinalityProvidersServer interface {
 	EditFinalityProvider(context.Context, *EditFinalityProviderRequest) (*EmptyResponse, error)
 	// UnsafeRemoveMerkleProof removes merkle proofs up to target height
 	UnsafeRemoveMerkleProof(context.Context, *RemoveMerkleProofRequest) (*EmptyResponse, error)
+	// QueryFinalityProviderUptime queries the votes of the finality provider over
+	// the rolling missed-blocks window from the local vote ledger
+	QueryFinalityProviderUptime(context.Context, *QueryFinalityProviderUptimeRequest) (*QueryFinalityProviderUptimeResponse, error)
 	mustEmbedUnimplementedFinalityProvidersServer()
 }
 
@@ -186,6 +202,9 @@ func (UnimplementedFinalityProvidersServer) EditFinalityProvider(context.Context
 func (UnimplementedFinalityProvidersServer) UnsafeRemoveMerkleProof(context.Context, *RemoveMerkleProofRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsafeRemoveMerkleProof not implemented")
 }
+func (UnimplementedFinalityProvidersServer) QueryFinalityProviderUptime(context.Context, *QueryFinalityProviderUptimeRequest) (*QueryFinalityProviderUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFinalityProviderUptime not implemented")
+}
 func (UnimplementedFinalityProvidersServer) mustEmbedUnimplementedFinalityProvidersServer() {}
 
 // UnsafeFinalityProvidersServer may be embedded to opt out of forward compatibility for this service.
@@ -343,6 +362,24 @@ func _FinalityProviders_UnsafeRemoveMerkleProof_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FinalityProviders_QueryFinalityProviderUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).QueryFinalityProviderUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_QueryFinalityProviderUptime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).QueryFinalityProviderUptime(ctx, req.(*QueryFinalityProviderUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FinalityProviders_ServiceDesc is the grpc.ServiceDesc for FinalityProviders service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -382,6 +419,10 @@ var FinalityProviders_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnsafeRemoveMerkleProof",
 			Handler:    _FinalityProviders_UnsafeRemoveMerkleProof_Handler,
 		},
+		{
+			MethodName: "QueryFinalityProviderUptime",
+			Handler:    _FinalityProviders_QueryFinalityProviderUptime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finality_providers.proto",
@@ -3,6 +3,7 @@ package service
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -37,6 +38,7 @@ type FinalityProviderApp struct {
 	kr           keyring.Keyring
 	fps          *store.FinalityProviderStore
 	pubRandStore *store.PubRandProofStore
+	voteLedger   *store.VoteLedgerStore
 	config       *fpcfg.Config
 	logger       *zap.Logger
 	input        *strings.Reader
@@ -44,6 +46,12 @@ type FinalityProviderApp struct {
 	fpIns       *FinalityProviderInstance
 	eotsManager eotsmanager.EOTSManager
 
+	// consumerCCs are the client controllers of the consumer chains, keyed by
+	// chain ID, on each of which the finality provider runs another instance
+	consumerCCs   map[string]clientcontroller.ClientController
+	consumerMu    sync.RWMutex
+	consumerFpIns map[string]*FinalityProviderInstance
+
 	metrics *metrics.FpMetrics
 
 	createFinalityProviderRequestChan chan *CreateFinalityProviderRequest
@@ -65,16 +73,35 @@ func NewFinalityProviderAppFromConfig(
 		return nil, fmt.Errorf("failed to start rpc client for the consumer chain %s: %w", cfg.ChainType, err)
 	}
 
-	// if the EOTSManagerAddress is empty, run a local EOTS manager;
-	// otherwise connect a remote one with a gRPC client
-	em, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
+	// if the EOTSManagerThresholdAddrs are set, coordinate the remote EOTS managers
+	// holding the shares of threshold EOTS keys; otherwise connect a remote one
+	em, err := client.NewEOTSManagerClient(cfg.EOTSManagerAddress, cfg.EOTSManagerThresholdAddrs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create EOTS manager client: %w", err)
 	}
 
-	logger.Info("successfully connected to a remote EOTS manager", zap.String("address", cfg.EOTSManagerAddress))
+	if len(cfg.EOTSManagerThresholdAddrs) > 0 {
+		logger.Info("successfully connected to the threshold EOTS managers", zap.Strings("addresses", cfg.EOTSManagerThresholdAddrs))
+	} else {
+		logger.Info("successfully connected to a remote EOTS manager", zap.String("address", cfg.EOTSManagerAddress))
+	}
+
+	consumerCCs := make(map[string]clientcontroller.ClientController, len(cfg.ConsumerChains))
+	for i := range cfg.ConsumerChains {
+		consumerCfg := &cfg.ConsumerChains[i]
+		consumerCC, err := clientcontroller.NewCosmwasmController(consumerCfg, cfg.BabylonConfig, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create rpc client for the consumer chain %s: %w", consumerCfg.ChainID, err)
+		}
+
+		if err := consumerCC.Start(); err != nil {
+			return nil, fmt.Errorf("failed to start rpc client for the consumer chain %s: %w", consumerCfg.ChainID, err)
+		}
+
+		consumerCCs[consumerCfg.ChainID] = consumerCC
+	}
 
-	return NewFinalityProviderApp(cfg, cc, em, db, logger)
+	return NewFinalityProviderAppWithConsumers(cfg, cc, consumerCCs, em, db, logger)
 }
 
 func NewFinalityProviderApp(
@@ -83,6 +110,20 @@ func NewFinalityProviderApp(
 	em eotsmanager.EOTSManager,
 	db kvdb.Backend,
 	logger *zap.Logger,
+) (*FinalityProviderApp, error) {
+	return NewFinalityProviderAppWithConsumers(config, cc, nil, em, db, logger)
+}
+
+// NewFinalityProviderAppWithConsumers returns a FinalityProviderApp which runs a finality
+// provider instance on the chain of the given client controller, and another one on each
+// consumer chain of the given consumer client controllers, keyed by chain ID
+func NewFinalityProviderAppWithConsumers(
+	config *fpcfg.Config,
+	cc clientcontroller.ClientController,
+	consumerCCs map[string]clientcontroller.ClientController,
+	em eotsmanager.EOTSManager,
+	db kvdb.Backend,
+	logger *zap.Logger,
 ) (*FinalityProviderApp, error) {
 	fpStore, err := store.NewFinalityProviderStore(db)
 	if err != nil {
@@ -92,6 +133,10 @@ func NewFinalityProviderApp(
 	if err != nil {
 		return nil, fmt.Errorf("failed to initiate public randomness store: %w", err)
 	}
+	voteLedger, err := store.NewVoteLedgerStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initiate vote ledger store: %w", err)
+	}
 
 	input := strings.NewReader("")
 	kr, err := fpkr.CreateKeyring(
@@ -110,12 +155,15 @@ func NewFinalityProviderApp(
 		cc:                                cc,
 		fps:                               fpStore,
 		pubRandStore:                      pubRandStore,
+		voteLedger:                        voteLedger,
 		kr:                                kr,
 		config:                            config,
 		logger:                            logger,
 		input:                             input,
 		fpIns:                             nil,
 		eotsManager:                       em,
+		consumerCCs:                       consumerCCs,
+		consumerFpIns:                     make(map[string]*FinalityProviderInstance),
 		metrics:                           fpMetrics,
 		quit:                              make(chan struct{}),
 		unjailFinalityProviderRequestChan: make(chan *UnjailFinalityProviderRequest),
@@ -136,6 +184,27 @@ func (app *FinalityProviderApp) GetPubRandProofStore() *store.PubRandProofStore
 	return app.pubRandStore
 }
 
+func (app *FinalityProviderApp) GetVoteLedgerStore() *store.VoteLedgerStore {
+	return app.voteLedger
+}
+
+// GetFinalityProviderUptime returns the votes of the finality provider over the rolling
+// missed-blocks window on the given chain, which is the one it is registered to if empty
+func (app *FinalityProviderApp) GetFinalityProviderUptime(
+	fpPk *bbntypes.BIP340PubKey,
+	chainID string,
+) (*proto.QueryFinalityProviderUptimeResponse, error) {
+	if chainID == "" {
+		storedFp, err := app.fps.GetFinalityProvider(fpPk.MustToBTCPK())
+		if err != nil {
+			return nil, err
+		}
+		chainID = storedFp.ChainID
+	}
+
+	return ComputeUptime(app.voteLedger, fpPk, chainID, app.config.UptimeConfig)
+}
+
 func (app *FinalityProviderApp) GetFinalityProviderInfo(fpPk *bbntypes.BIP340PubKey) (*proto.FinalityProviderInfo, error) {
 	storedFp, err := app.fps.GetFinalityProvider(fpPk.MustToBTCPK())
 	if err != nil {
@@ -171,6 +240,54 @@ func (app *FinalityProviderApp) ListAllFinalityProvidersInfo() ([]*proto.Finalit
 	return fpsInfo, nil
 }
 
+// GetPubRandStatuses returns the status of the public randomness commits of
+// the running finality provider instances
+func (app *FinalityProviderApp) GetPubRandStatuses() []*proto.PubRandStatus {
+	var statuses []*proto.PubRandStatus
+	if app.fpIns != nil && app.fpIns.IsRunning() {
+		statuses = append(statuses, app.fpIns.GetPubRandStatus())
+	}
+
+	app.consumerMu.RLock()
+	defer app.consumerMu.RUnlock()
+	chainIDs := make([]string, 0, len(app.consumerFpIns))
+	for chainID := range app.consumerFpIns {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	for _, chainID := range chainIDs {
+		if fpIns := app.consumerFpIns[chainID]; fpIns.IsRunning() {
+			statuses = append(statuses, fpIns.GetPubRandStatus())
+		}
+	}
+
+	return statuses
+}
+
+// GetCatchUpStatuses returns the progress of the catch-up mode of the running
+// finality provider instances
+func (app *FinalityProviderApp) GetCatchUpStatuses() []*proto.CatchUpStatus {
+	var statuses []*proto.CatchUpStatus
+	if app.fpIns != nil && app.fpIns.IsRunning() {
+		statuses = append(statuses, app.fpIns.GetCatchUpStatus())
+	}
+
+	app.consumerMu.RLock()
+	defer app.consumerMu.RUnlock()
+	chainIDs := make([]string, 0, len(app.consumerFpIns))
+	for chainID := range app.consumerFpIns {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	for _, chainID := range chainIDs {
+		if fpIns := app.consumerFpIns[chainID]; fpIns.IsRunning() {
+			statuses = append(statuses, fpIns.GetCatchUpStatus())
+		}
+	}
+
+	return statuses
+}
+
 // GetFinalityProviderInstance returns the finality-provider instance with the given Babylon public key
 func (app *FinalityProviderApp) GetFinalityProviderInstance() (*FinalityProviderInstance, error) {
 	if app.fpIns == nil {
@@ -180,6 +297,31 @@ func (app *FinalityProviderApp) GetFinalityProviderInstance() (*FinalityProvider
 	return app.fpIns, nil
 }
 
+// GetConsumerFinalityProviderInstance returns the finality-provider instance running on
+// the consumer chain with the given chain ID
+func (app *FinalityProviderApp) GetConsumerFinalityProviderInstance(chainID string) (*FinalityProviderInstance, error) {
+	app.consumerMu.RLock()
+	defer app.consumerMu.RUnlock()
+
+	fpIns, ok := app.consumerFpIns[chainID]
+	if !ok {
+		return nil, fmt.Errorf("finality provider does not exist on consumer chain %s", chainID)
+	}
+
+	return fpIns, nil
+}
+
+// getFinalityProviderInstanceOnChain returns the finality-provider instance running on
+// the chain with the given chain ID, which is either the one it is registered to or
+// a consumer chain
+func (app *FinalityProviderApp) getFinalityProviderInstanceOnChain(chainID string) (*FinalityProviderInstance, error) {
+	if _, ok := app.consumerCCs[chainID]; ok {
+		return app.GetConsumerFinalityProviderInstance(chainID)
+	}
+
+	return app.GetFinalityProviderInstance()
+}
+
 func (app *FinalityProviderApp) Logger() *zap.Logger {
 	return app.logger
 }
@@ -280,6 +422,11 @@ func (app *FinalityProviderApp) Start() error {
 	app.startOnce.Do(func() {
 		app.logger.Info("Starting FinalityProviderApp")
 
+		startErr = app.setJailingParams()
+		if startErr != nil {
+			return
+		}
+
 		startErr = app.SyncAllFinalityProvidersStatus()
 		if startErr != nil {
 			return
@@ -295,6 +442,36 @@ func (app *FinalityProviderApp) Start() error {
 	return startErr
 }
 
+// setJailingParams sets the missed-blocks window of the uptime config to the
+// finality params of Babylon, unless both are overridden in the config
+func (app *FinalityProviderApp) setJailingParams() error {
+	uptimeCfg := app.config.UptimeConfig
+	if uptimeCfg.IsOverridden() {
+		app.logger.Info("using the missed-blocks window of the config",
+			zap.Int64("signed_blocks_window", uptimeCfg.SignedBlocksWindow),
+			zap.Float64("min_signed_per_window", uptimeCfg.MinSignedPerWindow),
+		)
+
+		return nil
+	}
+
+	signedBlocksWindow, minSignedPerWindow, err := app.cc.QueryJailingParams()
+	if err != nil {
+		return fmt.Errorf("failed to query the jailing params: %w", err)
+	}
+
+	if err := uptimeCfg.SetJailingParams(signedBlocksWindow, minSignedPerWindow); err != nil {
+		return fmt.Errorf("invalid missed-blocks window: %w", err)
+	}
+
+	app.logger.Info("using the missed-blocks window of the finality params",
+		zap.Int64("signed_blocks_window", uptimeCfg.SignedBlocksWindow),
+		zap.Float64("min_signed_per_window", uptimeCfg.MinSignedPerWindow),
+	)
+
+	return nil
+}
+
 func (app *FinalityProviderApp) Stop() error {
 	var stopErr error
 	app.stopOnce.Do(func() {
@@ -316,6 +493,25 @@ func (app *FinalityProviderApp) Stop() error {
 			app.logger.Info("finality provider is stopped", zap.String("pk", pkHex))
 		}
 
+		app.consumerMu.Lock()
+		for chainID, fpi := range app.consumerFpIns {
+			if !fpi.IsRunning() {
+				continue
+			}
+
+			pkHex := fpi.GetBtcPkHex()
+			app.logger.Info("stopping finality provider on consumer chain",
+				zap.String("pk", pkHex), zap.String("chain_id", chainID))
+
+			if err := fpi.Stop(); err != nil {
+				stopErr = fmt.Errorf("failed to close the fp instance on consumer chain %s: %w", chainID, err)
+				app.consumerMu.Unlock()
+
+				return
+			}
+		}
+		app.consumerMu.Unlock()
+
 		app.logger.Debug("Stopping EOTS manager")
 		if err := app.eotsManager.Close(); err != nil {
 			stopErr = fmt.Errorf("failed to close the EOTS manager: %w", err)
@@ -498,7 +694,7 @@ func (app *FinalityProviderApp) startFinalityProviderInstance(
 	pkHex := pk.MarshalHex()
 	if app.fpIns == nil {
 		fpIns, err := NewFinalityProviderInstance(
-			pk, app.config, app.fps, app.pubRandStore, app.cc, app.eotsManager,
+			pk, app.config, app.fps, app.pubRandStore, app.voteLedger, app.cc, app.eotsManager,
 			app.metrics, passphrase, app.criticalErrChan, app.logger,
 		)
 		if err != nil {
@@ -511,7 +707,61 @@ func (app *FinalityProviderApp) startFinalityProviderInstance(
 			"please restart the daemon to switch to another instance", app.fpIns.btcPk.MarshalHex())
 	}
 
-	return app.fpIns.Start()
+	if err := app.fpIns.Start(); err != nil {
+		return err
+	}
+
+	return app.startConsumerFinalityProviderInstances(pk, passphrase)
+}
+
+// startConsumerFinalityProviderInstances starts an instance of the finality provider
+// on each consumer chain, skipping the ones it is slashed on
+func (app *FinalityProviderApp) startConsumerFinalityProviderInstances(
+	pk *bbntypes.BIP340PubKey,
+	passphrase string,
+) error {
+	app.consumerMu.Lock()
+	defer app.consumerMu.Unlock()
+
+	pkHex := pk.MarshalHex()
+	for chainID, consumerCC := range app.consumerCCs {
+		fpIns, ok := app.consumerFpIns[chainID]
+		if !ok {
+			sfp, err := app.fps.GetFinalityProviderOnChain(pk.MustToBTCPK(), chainID)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve the finality provider %s from DB: %w", pkHex, err)
+			}
+			if sfp.Status == proto.FinalityProviderStatus_SLASHED {
+				app.logger.Warn("the finality provider is slashed on the consumer chain, skipping",
+					zap.String("pk", pkHex), zap.String("chain_id", chainID))
+
+				continue
+			}
+
+			fpIns, err = NewConsumerFinalityProviderInstance(
+				pk, chainID, app.config, app.fps, app.pubRandStore, app.voteLedger, consumerCC, app.eotsManager,
+				app.metrics, passphrase, app.criticalErrChan, app.logger,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to create finality provider instance %s on consumer chain %s: %w", pkHex, chainID, err)
+			}
+
+			app.consumerFpIns[chainID] = fpIns
+		}
+
+		if fpIns.IsRunning() {
+			continue
+		}
+
+		if err := fpIns.Start(); err != nil {
+			return fmt.Errorf("failed to start finality provider instance %s on consumer chain %s: %w", pkHex, chainID, err)
+		}
+
+		app.logger.Info("finality provider is started on consumer chain",
+			zap.String("pk", pkHex), zap.String("chain_id", chainID))
+	}
+
+	return nil
 }
 
 func (app *FinalityProviderApp) IsFinalityProviderRunning(fpPk *bbntypes.BIP340PubKey) bool {
@@ -542,8 +792,39 @@ func (app *FinalityProviderApp) removeFinalityProviderInstance() error {
 	return nil
 }
 
+// removeConsumerFinalityProviderInstance stops and removes the finality provider
+// instance on the consumer chain with the given chain ID
+func (app *FinalityProviderApp) removeConsumerFinalityProviderInstance(chainID string) error {
+	app.consumerMu.Lock()
+	defer app.consumerMu.Unlock()
+
+	fpi, ok := app.consumerFpIns[chainID]
+	if !ok {
+		return fmt.Errorf("the finality provider instance does not exist on consumer chain %s", chainID)
+	}
+	if fpi.IsRunning() {
+		if err := fpi.Stop(); err != nil {
+			return fmt.Errorf("failed to stop the finality provider instance %s on consumer chain %s", fpi.GetBtcPkHex(), chainID)
+		}
+	}
+
+	delete(app.consumerFpIns, chainID)
+
+	return nil
+}
+
 func (app *FinalityProviderApp) setFinalityProviderSlashed(fpi *FinalityProviderInstance) {
 	fpi.MustSetStatus(proto.FinalityProviderStatus_SLASHED)
+
+	// being slashed on a consumer chain only terminates the instance on it
+	if chainID := string(fpi.GetChainID()); app.consumerCCs[chainID] != nil {
+		if err := app.removeConsumerFinalityProviderInstance(chainID); err != nil {
+			panic(fmt.Errorf("failed to terminate a slashed finality-provider %s on consumer chain %s: %w", fpi.GetBtcPkHex(), chainID, err))
+		}
+
+		return
+	}
+
 	if err := app.removeFinalityProviderInstance(); err != nil {
 		panic(fmt.Errorf("failed to terminate a slashed finality-provider %s: %w", fpi.GetBtcPkHex(), err))
 	}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"go.uber.org/zap"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// CatchUpBatchSizer sizes the batches of finality signatures submitted in the
+// catch-up mode. The batch doubles after each accepted transaction up to the
+// highest size not known to exceed the gas or size limits of the chain, and
+// halves once a transaction exceeds them.
+type CatchUpBatchSizer struct {
+	size uint32
+	// ceiling is the highest size not known to exceed the limits
+	ceiling uint32
+}
+
+func NewCatchUpBatchSizer(initialSize, maxSize uint32) *CatchUpBatchSizer {
+	return &CatchUpBatchSizer{
+		size:    initialSize,
+		ceiling: maxSize,
+	}
+}
+
+// Size returns the number of finality signatures of the next transaction
+func (s *CatchUpBatchSizer) Size() uint32 {
+	return s.size
+}
+
+// Grow grows the batch after a transaction of the current size is accepted
+func (s *CatchUpBatchSizer) Grow() {
+	s.size = min(s.size*2, s.ceiling)
+}
+
+// Shrink shrinks the batch after a transaction of the given size exceeds the
+// limits of the chain, and returns false if it cannot be shrunk further
+func (s *CatchUpBatchSizer) Shrink(exceededSize uint32) bool {
+	if exceededSize <= 1 {
+		return false
+	}
+	s.ceiling = min(s.ceiling, exceededSize-1)
+	s.size = max(exceededSize/2, 1)
+
+	return true
+}
+
+// catchUpState keeps the progress of the catch-up mode of a finality provider
+type catchUpState struct {
+	mu     sync.Mutex
+	status *proto.CatchUpStatus
+	// caughtUpHeight is the highest height processed by the catch-up mode,
+	// whose blocks are not voted for again once polled
+	caughtUpHeight uint64
+}
+
+func newCatchUpState() *catchUpState {
+	return &catchUpState{status: &proto.CatchUpStatus{}}
+}
+
+func (s *catchUpState) start(startHeight, targetHeight uint64, batchSize uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.status = &proto.CatchUpStatus{
+		Active:       true,
+		StartHeight:  startHeight,
+		TargetHeight: targetHeight,
+		BatchSize:    batchSize,
+		StartedAt:    time.Now().Unix(),
+	}
+}
+
+func (s *catchUpState) finish() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.status.Active = false
+	s.status.FinishedAt = time.Now().Unix()
+	s.status.Eta = 0
+}
+
+// update records the progress of the catch-up up to the given processed height
+func (s *catchUpState) update(processedHeight uint64, update func(status *proto.CatchUpStatus)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	update(s.status)
+	if processedHeight > s.status.ProcessedHeight {
+		s.status.ProcessedHeight = processedHeight
+	}
+	if processedHeight > s.caughtUpHeight {
+		s.caughtUpHeight = processedHeight
+	}
+
+	// predict the end of the catch-up from its rate so far
+	elapsed := time.Now().Unix() - s.status.StartedAt
+	if elapsed > 0 && s.status.ProcessedHeight >= s.status.StartHeight && s.status.TargetHeight > s.status.ProcessedHeight {
+		processed := s.status.ProcessedHeight + 1 - s.status.StartHeight
+		remaining := s.status.TargetHeight - s.status.ProcessedHeight
+		s.status.Eta = time.Now().Unix() + int64(float64(remaining)*float64(elapsed)/float64(processed))
+	}
+}
+
+func (s *catchUpState) getCaughtUpHeight() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.caughtUpHeight
+}
+
+func (s *catchUpState) getStatus() *proto.CatchUpStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return pm.Clone(s.status).(*proto.CatchUpStatus)
+}
+
+// shouldCatchUp returns true and the tip height of the chain if the next block
+// to vote is far enough behind the tip to enter the catch-up mode
+func (fp *FinalityProviderInstance) shouldCatchUp(nextHeight uint64) (bool, uint64) {
+	if !fp.cfg.CatchUpConfig.Enabled {
+		return false, 0
+	}
+
+	tipHeight := fp.poller.TipHeight()
+	if tipHeight < nextHeight+fp.cfg.CatchUpConfig.LagThreshold {
+		return false, 0
+	}
+
+	return true, tipHeight
+}
+
+// CatchUp votes on the blocks from startHeight to targetHeight in the catch-up
+// mode. It skips the blocks already finalized, which are queried in bulk, and
+// submits the finality signatures in batches as large as the gas and size
+// limits of the chain allow, signing the blocks of each batch in parallel.
+func (fp *FinalityProviderInstance) CatchUp(startHeight, targetHeight uint64) error {
+	cfg := fp.cfg.CatchUpConfig
+	sizer := NewCatchUpBatchSizer(cfg.InitialBatchSize, cfg.MaxBatchSize)
+
+	fp.logger.Info("the finality provider is behind the tip of the chain, entering the catch-up mode",
+		zap.String("pk", fp.GetBtcPkHex()),
+		zap.Uint64("start_height", startHeight),
+		zap.Uint64("target_height", targetHeight),
+	)
+	fp.catchUp.start(startHeight, targetHeight, sizer.Size())
+	defer fp.catchUp.finish()
+
+	height := startHeight
+	for height <= targetHeight {
+		select {
+		case <-fp.quit:
+			return ErrFinalityProviderShutDown
+		default:
+		}
+
+		// the blocks are finalized in order, so all the blocks up to the latest
+		// finalized one are skipped without querying them
+		finalizedHeight, err := fp.latestFinalizedHeightWithRetry()
+		if err != nil {
+			return fmt.Errorf("failed to get the last finalized height: %w", err)
+		}
+		if finalizedHeight >= height {
+			skipTo := min(finalizedHeight, targetHeight)
+			fp.catchUp.update(skipTo, func(status *proto.CatchUpStatus) {
+				status.SkippedFinalized += skipTo - height + 1
+			})
+			height = skipTo + 1
+
+			continue
+		}
+
+		blocks, err := fp.queryBlocksWithRetry(height, targetHeight)
+		if err != nil {
+			return fmt.Errorf("failed to query the blocks from height %d: %w", height, err)
+		}
+		lastHeight := blocks[len(blocks)-1].Height
+
+		var (
+			toProcess        []*types.BlockInfo
+			skippedFinalized uint64
+		)
+		for _, b := range blocks {
+			if b.Finalized {
+				skippedFinalized++
+
+				continue
+			}
+			toProcess = append(toProcess, b)
+		}
+
+		toVote, err := fp.processBlocksToVote(toProcess)
+		if err != nil {
+			return err
+		}
+		fp.catchUp.update(0, func(status *proto.CatchUpStatus) {
+			status.SkippedFinalized += skippedFinalized
+			status.SkippedNoPower += uint64(len(toProcess) - len(toVote))
+		})
+
+		if err := fp.submitCatchUpBatches(toVote, sizer); err != nil {
+			return err
+		}
+
+		fp.catchUp.update(lastHeight, func(*proto.CatchUpStatus) {})
+		height = lastHeight + 1
+	}
+
+	status := fp.catchUp.getStatus()
+	fp.logger.Info("the finality provider has caught up with the chain",
+		zap.String("pk", fp.GetBtcPkHex()),
+		zap.Uint64("target_height", targetHeight),
+		zap.Uint64("voted", status.Voted),
+		zap.Uint64("skipped_finalized", status.SkippedFinalized),
+		zap.Uint64("skipped_no_power", status.SkippedNoPower),
+		zap.Uint64("num_txs", status.NumTxs),
+	)
+
+	return nil
+}
+
+// submitCatchUpBatches submits the finality signatures over the given blocks in
+// batches sized by the given sizer
+func (fp *FinalityProviderInstance) submitCatchUpBatches(blocks []*types.BlockInfo, sizer *CatchUpBatchSizer) error {
+	for len(blocks) > 0 {
+		n := min(int(sizer.Size()), len(blocks))
+		batch := blocks[:n]
+
+		res, err := fp.SubmitBatchFinalitySignatures(batch)
+		if err != nil && clientcontroller.IsBatchTooLarge(err) && sizer.Shrink(uint32(n)) {
+			fp.logger.Debug("the batch of finality signatures exceeds the limits of the chain, shrinking it",
+				zap.String("pk", fp.GetBtcPkHex()),
+				zap.Int("exceeded_size", n),
+				zap.Uint32("batch_size", sizer.Size()),
+				zap.Error(err),
+			)
+			fp.catchUp.update(0, func(status *proto.CatchUpStatus) {
+				status.BatchSize = sizer.Size()
+			})
+
+			continue
+		}
+		if err != nil {
+			// retry as in the normal mode, which also handles the signatures
+			// already submitted and the blocks finalized meanwhile
+			res, err = fp.retrySubmitSigsUntilFinalized(batch)
+		}
+		if err != nil {
+			fp.metrics.IncrementFpTotalFailedVotes(fp.GetBtcPkHex())
+			if !errors.Is(err, ErrFinalityProviderShutDown) {
+				fp.recordVotes(batch, proto.VoteStatus_FAILED)
+			}
+
+			return err
+		}
+
+		if res != nil {
+			fp.logger.Info("successfully submitted a batch of finality signatures in the catch-up mode",
+				zap.String("pk", fp.GetBtcPkHex()),
+				zap.Uint64("start_height", batch[0].Height),
+				zap.Uint64("end_height", batch[n-1].Height),
+				zap.String("tx_hash", res.TxHash),
+			)
+			sizer.Grow()
+		}
+		fp.catchUp.update(batch[n-1].Height, func(status *proto.CatchUpStatus) {
+			if res != nil {
+				status.Voted += uint64(n)
+				status.NumTxs++
+			}
+			status.BatchSize = sizer.Size()
+		})
+
+		blocks = blocks[n:]
+	}
+
+	return nil
+}
+
+func (fp *FinalityProviderInstance) queryBlocksWithRetry(startHeight, endHeight uint64) ([]*types.BlockInfo, error) {
+	var blocks []*types.BlockInfo
+	if err := retry.Do(func() error {
+		res, err := fp.cc.QueryBlocks(startHeight, endHeight, fp.cfg.PollerConfig.PollSize)
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			return fmt.Errorf("no blocks found for range %d-%d", startHeight, endHeight)
+		}
+		blocks = res
+
+		return nil
+	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+		fp.logger.Debug(
+			"failed to query the consumer chain for the blocks",
+			zap.Uint("attempt", n+1),
+			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint64("start_height", startHeight),
+			zap.Uint64("end_height", endHeight),
+			zap.Error(err),
+		)
+	})); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// GetCatchUpStatus returns the progress of the last catch-up of the finality provider
+func (fp *FinalityProviderInstance) GetCatchUpStatus() *proto.CatchUpStatus {
+	status := fp.catchUp.getStatus()
+	status.BtcPkHex = fp.GetBtcPkHex()
+	status.ChainId = string(fp.GetChainID())
+
+	return status
+}
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+func TestCatchUpBatchSizer(t *testing.T) {
+	t.Parallel()
+	s := service.NewCatchUpBatchSizer(10, 100)
+	require.Equal(t, uint32(10), s.Size())
+
+	s.Grow()
+	s.Grow()
+	require.Equal(t, uint32(40), s.Size())
+	s.Grow()
+	s.Grow()
+	require.Equal(t, uint32(100), s.Size())
+
+	// the batch never grows back to a size exceeding the limits
+	require.True(t, s.Shrink(100))
+	require.Equal(t, uint32(50), s.Size())
+	s.Grow()
+	require.Equal(t, uint32(99), s.Size())
+
+	require.True(t, s.Shrink(2))
+	require.Equal(t, uint32(1), s.Size())
+	require.False(t, s.Shrink(1))
+}
+
+func FuzzCatchUp(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		startHeight := uint64(r.Int63n(100) + 1)
+		finalizedHeight := startHeight + uint64(r.Int63n(100))
+		targetHeight := finalizedHeight + uint64(r.Int63n(150)+1)
+		// the number of finality signatures a transaction can fit
+		maxSigsPerTx := r.Intn(40) + 1
+
+		mockClientController := testutil.PrepareMockedClientController(t, r, startHeight, targetHeight, 0)
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(uint64(1)).
+			Return([]*types.BlockInfo{{Height: finalizedHeight}}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any()).
+			Return(uint64(1), nil).AnyTimes()
+		_, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, true, startHeight, 1000)
+		defer cleanUp()
+
+		mockClientController.EXPECT().CommitPubRandList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil).Times(1)
+		_, err := fpIns.CommitPubRand(startHeight)
+		require.NoError(t, err)
+
+		pollSize := fpIns.GetConfig().PollerConfig.PollSize
+		mockClientController.EXPECT().QueryBlocks(gomock.Any(), targetHeight, pollSize).
+			DoAndReturn(func(start, end uint64, limit uint32) ([]*types.BlockInfo, error) {
+				var blocks []*types.BlockInfo
+				for h := start; h <= end && len(blocks) < int(limit); h++ {
+					blocks = append(blocks, &types.BlockInfo{Height: h, Hash: testutil.GenRandomByteArray(r, 32)})
+				}
+
+				return blocks, nil
+			}).AnyTimes()
+
+		var votedHeights []uint64
+		mockClientController.EXPECT().SubmitBatchFinalitySigs(fpIns.GetBtcPk(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ *btcec.PublicKey, blocks []*types.BlockInfo, _ []*btcec.FieldVal, _ [][]byte, sigs []*btcec.ModNScalar) (*types.TxResponse, error) {
+				require.Len(t, sigs, len(blocks))
+				if len(blocks) > maxSigsPerTx {
+					return nil, fmt.Errorf("tx gas limit %d exceeds block max gas %d", len(blocks), maxSigsPerTx)
+				}
+				for _, b := range blocks {
+					votedHeights = append(votedHeights, b.Height)
+				}
+
+				return &types.TxResponse{TxHash: testutil.GenRandomHexStr(r, 32)}, nil
+			}).AnyTimes()
+
+		err = fpIns.CatchUp(startHeight, targetHeight)
+		require.NoError(t, err)
+
+		// the finalized blocks are skipped and the others are voted for once in order
+		require.Len(t, votedHeights, int(targetHeight-finalizedHeight))
+		for i, h := range votedHeights {
+			require.Equal(t, finalizedHeight+1+uint64(i), h)
+		}
+		require.Equal(t, targetHeight, fpIns.GetLastVotedHeight())
+
+		status := fpIns.GetCatchUpStatus()
+		require.False(t, status.Active)
+		require.Equal(t, finalizedHeight-startHeight+1, status.SkippedFinalized)
+		require.Equal(t, targetHeight-finalizedHeight, status.Voted)
+		require.Equal(t, targetHeight, status.ProcessedHeight)
+	})
+}
@@ -37,6 +37,7 @@ type ChainPoller struct {
 	blockInfoChan chan *types.BlockInfo
 	logger        *zap.Logger
 	nextHeight    uint64
+	tipHeight     uint64
 }
 
 func NewChainPoller(
@@ -205,6 +206,8 @@ func (cp *ChainPoller) pollChain() {
 				zap.Error(err),
 			)
 		} else {
+			cp.setTipHeight(latestBlock.Height)
+
 			// start polling in the first iteration
 			blockToRetrieve := cp.NextHeight()
 			var blocks []*types.BlockInfo
@@ -269,9 +272,49 @@ func (cp *ChainPoller) NextHeight() uint64 {
 	return cp.nextHeight
 }
 
+// setNextHeight sets the next height to poll, unless the poller is skipped past
+// it meanwhile
 func (cp *ChainPoller) setNextHeight(height uint64) {
 	cp.mu.Lock()
 	defer cp.mu.Unlock()
 
+	if height > cp.nextHeight {
+		cp.nextHeight = height
+	}
+}
+
+// SkipToHeight makes the poller continue from the given height if it is higher
+// than the next height to poll, and drops the buffered blocks, which are all
+// lower than it. The blocks being polled at the time can still be delivered.
+func (cp *ChainPoller) SkipToHeight(height uint64) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if height <= cp.nextHeight {
+		return
+	}
 	cp.nextHeight = height
+
+	for {
+		select {
+		case <-cp.blockInfoChan:
+		default:
+			return
+		}
+	}
+}
+
+// TipHeight returns the height of the tip of the chain as of the last poll
+func (cp *ChainPoller) TipHeight() uint64 {
+	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+
+	return cp.tipHeight
+}
+
+func (cp *ChainPoller) setTipHeight(height uint64) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.tipHeight = height
 }
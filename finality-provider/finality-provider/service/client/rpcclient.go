@@ -163,3 +163,15 @@ func (c *FinalityProviderServiceGRpcClient) UnsafeRemoveMerkleProof(
 
 	return nil
 }
+
+// QueryFinalityProviderUptime - gets the votes of the finality provider over the rolling missed-blocks window
+func (c *FinalityProviderServiceGRpcClient) QueryFinalityProviderUptime(
+	ctx context.Context, fpPk *bbntypes.BIP340PubKey, chainID string) (*proto.QueryFinalityProviderUptimeResponse, error) {
+	req := &proto.QueryFinalityProviderUptimeRequest{BtcPkHex: fpPk.MarshalHex(), ChainId: chainID}
+	res, err := c.client.QueryFinalityProviderUptime(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
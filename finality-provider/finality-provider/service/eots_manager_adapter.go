@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"sync"
 
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -66,3 +67,40 @@ func (fp *FinalityProviderInstance) signFinalitySig(b *types.BlockInfo) (*bbntyp
 
 	return bbntypes.NewSchnorrEOTSSigFromModNScalar(sig), nil
 }
+
+// signFinalitySigs signs the finality signatures over the given blocks, with up
+// to SigningWorkers signatures requested from the EOTS manager in parallel
+func (fp *FinalityProviderInstance) signFinalitySigs(blocks []*types.BlockInfo) ([]*btcec.ModNScalar, error) {
+	sigList := make([]*btcec.ModNScalar, len(blocks))
+	errs := make([]error, len(blocks))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, fp.cfg.CatchUpConfig.SigningWorkers)
+	for i, b := range blocks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, b *types.BlockInfo) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			eotsSig, err := fp.signFinalitySig(b)
+			if err != nil {
+				errs[i] = err
+
+				return
+			}
+			sigList[i] = eotsSig.ToModNScalar()
+		}(i, b)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sigList, nil
+}
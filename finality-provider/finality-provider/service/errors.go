@@ -12,6 +12,8 @@ const instanceTerminatingMsg = "terminating the finality-provider instance due t
 type CriticalError struct {
 	err     error
 	fpBtcPk *bbntypes.BIP340PubKey
+	// chainID is the chain the finality provider instance runs on
+	chainID string
 }
 
 func (ce *CriticalError) Error() string {
@@ -53,22 +53,25 @@ func (app *FinalityProviderApp) monitorCriticalErr() {
 	for {
 		select {
 		case criticalErr = <-app.criticalErrChan:
-			fpi, err := app.GetFinalityProviderInstance()
+			fpi, err := app.getFinalityProviderInstanceOnChain(criticalErr.chainID)
 			if err != nil {
 				app.logger.Debug("the finality-provider instance is already shutdown",
-					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()))
+					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()),
+					zap.String("chain_id", criticalErr.chainID))
 
 				continue
 			}
 			if errors.Is(criticalErr.err, ErrFinalityProviderSlashed) {
 				app.setFinalityProviderSlashed(fpi)
 				app.logger.Debug("the finality-provider has been slashed",
-					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()))
+					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()),
+					zap.String("chain_id", criticalErr.chainID))
 
 				continue
 			}
 			app.logger.Fatal(instanceTerminatingMsg,
-				zap.String("pk", criticalErr.fpBtcPk.MarshalHex()), zap.Error(criticalErr.err))
+				zap.String("pk", criticalErr.fpBtcPk.MarshalHex()),
+				zap.String("chain_id", criticalErr.chainID), zap.Error(criticalErr.err))
 		case <-app.quit:
 			app.logger.Info("exiting monitor critical error loop")
 
@@ -28,9 +28,12 @@ import (
 type FinalityProviderInstance struct {
 	btcPk *bbntypes.BIP340PubKey
 
-	fpState      *fpState
-	pubRandState *pubRandState
-	cfg          *fpcfg.Config
+	fpState        *fpState
+	pubRandState   *pubRandState
+	pubRandPlanner *PubRandPlanner
+	catchUp        *catchUpState
+	voteLedger     *store.VoteLedgerStore
+	cfg            *fpcfg.Config
 
 	logger  *zap.Logger
 	em      eotsmanager.EOTSManager
@@ -56,6 +59,7 @@ func NewFinalityProviderInstance(
 	cfg *fpcfg.Config,
 	s *store.FinalityProviderStore,
 	prStore *store.PubRandProofStore,
+	vlStore *store.VoteLedgerStore,
 	cc clientcontroller.ClientController,
 	em eotsmanager.EOTSManager,
 	metrics *metrics.FpMetrics,
@@ -72,7 +76,39 @@ func NewFinalityProviderInstance(
 		return nil, fmt.Errorf("the finality provider instance is already slashed")
 	}
 
-	return newFinalityProviderInstanceFromStore(sfp, cfg, s, prStore, cc, em, metrics, passphrase, errChan, logger)
+	return newFinalityProviderInstanceFromStore(sfp, cfg, s, prStore, vlStore, cc, em, metrics, passphrase, errChan, logger)
+}
+
+// NewConsumerFinalityProviderInstance returns a FinalityProviderInstance of the finality
+// provider with the given public key, which votes on the consumer chain with the given
+// chain ID through the given client controller. The finality provider should be
+// registered before, and keeps its own status, last voted height and public randomness
+// on each chain.
+func NewConsumerFinalityProviderInstance(
+	fpPk *bbntypes.BIP340PubKey,
+	chainID string,
+	cfg *fpcfg.Config,
+	s *store.FinalityProviderStore,
+	prStore *store.PubRandProofStore,
+	vlStore *store.VoteLedgerStore,
+	cc clientcontroller.ClientController,
+	em eotsmanager.EOTSManager,
+	metrics *metrics.FpMetrics,
+	passphrase string,
+	errChan chan<- *CriticalError,
+	logger *zap.Logger,
+) (*FinalityProviderInstance, error) {
+	sfp, err := s.GetFinalityProviderOnChain(fpPk.MustToBTCPK(), chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the finality provider %s from DB: %w", fpPk.MarshalHex(), err)
+	}
+
+	if sfp.Status == proto.FinalityProviderStatus_SLASHED {
+		return nil, fmt.Errorf("the finality provider instance is already slashed on chain %s", chainID)
+	}
+
+	return newFinalityProviderInstanceFromStore(sfp, cfg, s, prStore, vlStore, cc, em, metrics, passphrase, errChan,
+		logger.With(zap.String("chain_id", chainID)))
 }
 
 // Helper function to create FinalityProviderInstance from store data
@@ -81,6 +117,7 @@ func newFinalityProviderInstanceFromStore(
 	cfg *fpcfg.Config,
 	s *store.FinalityProviderStore,
 	prStore *store.PubRandProofStore,
+	vlStore *store.VoteLedgerStore,
 	cc clientcontroller.ClientController,
 	em eotsmanager.EOTSManager,
 	metrics *metrics.FpMetrics,
@@ -92,6 +129,9 @@ func newFinalityProviderInstanceFromStore(
 		btcPk:           bbntypes.NewBIP340PubKeyFromBTCPK(sfp.BtcPk),
 		fpState:         newFpState(sfp, s),
 		pubRandState:    newPubRandState(prStore),
+		pubRandPlanner:  NewPubRandPlanner(cfg.PubRandPlannerConfig, cfg.NumPubRand, cfg.TimestampingDelayBlocks),
+		catchUp:         newCatchUpState(),
+		voteLedger:      vlStore,
 		cfg:             cfg,
 		logger:          logger,
 		isStarted:       atomic.NewBool(false),
@@ -168,7 +208,7 @@ func (fp *FinalityProviderInstance) IsRunning() bool {
 // NOTE: it retrieves the the status from the db to
 // ensure status is up-to-date
 func (fp *FinalityProviderInstance) IsJailed() bool {
-	storedFp, err := fp.fpState.s.GetFinalityProvider(fp.GetBtcPk())
+	storedFp, err := fp.fpState.s.GetFinalityProviderOnChain(fp.GetBtcPk(), string(fp.GetChainID()))
 	if err != nil {
 		panic(fmt.Errorf("failed to retrieve the finality provider %s from db: %w", fp.GetBtcPkHex(), err))
 	}
@@ -196,6 +236,27 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 				fp.logger.Warn("the finality-provider is jailed",
 					zap.String("pk", fp.GetBtcPkHex()),
 				)
+				// a jailed finality provider has no voting power
+				fp.recordVotes(pollerBlocks, proto.VoteStatus_SKIPPED_NO_POWER)
+
+				continue
+			}
+
+			if catchUp, tipHeight := fp.shouldCatchUp(pollerBlocks[0].Height); catchUp {
+				// the poller continues after the blocks covered by the catch-up
+				fp.poller.SkipToHeight(tipHeight + 1)
+				if err := fp.CatchUp(pollerBlocks[0].Height, tipHeight); err != nil {
+					if errors.Is(err, ErrFinalityProviderJailed) {
+						fp.MustSetStatus(proto.FinalityProviderStatus_JAILED)
+						fp.logger.Debug("the finality-provider has been jailed",
+							zap.String("pk", fp.GetBtcPkHex()))
+
+						continue
+					}
+					if !errors.Is(err, ErrFinalityProviderShutDown) {
+						fp.reportCriticalErr(err)
+					}
+				}
 
 				continue
 			}
@@ -221,6 +282,9 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			res, err := fp.retrySubmitSigsUntilFinalized(processedBlocks)
 			if err != nil {
 				fp.metrics.IncrementFpTotalFailedVotes(fp.GetBtcPkHex())
+				if !errors.Is(err, ErrFinalityProviderShutDown) {
+					fp.recordVotes(processedBlocks, proto.VoteStatus_FAILED)
+				}
 				if errors.Is(err, ErrFinalityProviderJailed) {
 					fp.MustSetStatus(proto.FinalityProviderStatus_JAILED)
 					fp.logger.Debug("the finality-provider has been jailed",
@@ -260,6 +324,7 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 // it also updates the fp instance status according to the block's voting power
 func (fp *FinalityProviderInstance) processBlocksToVote(blocks []*types.BlockInfo) ([]*types.BlockInfo, error) {
 	processedBlocks := make([]*types.BlockInfo, 0, len(blocks))
+	var noPowerBlocks []*types.BlockInfo
 
 	var power uint64
 	var err error
@@ -275,6 +340,10 @@ func (fp *FinalityProviderInstance) processBlocksToVote(blocks []*types.BlockInf
 
 			continue
 		}
+		if blk.Height <= fp.catchUp.getCaughtUpHeight() {
+			// the block is polled before the catch-up processed it
+			continue
+		}
 
 		// check whether the finality provider has voting power
 		power, err = fp.GetVotingPowerWithRetry(blk.Height)
@@ -291,6 +360,7 @@ func (fp *FinalityProviderInstance) processBlocksToVote(blocks []*types.BlockInf
 			// the finality provider does not have voting power
 			// and it will never will at this block, so continue
 			fp.metrics.IncrementFpTotalBlocksWithoutVotingPower(fp.GetBtcPkHex())
+			noPowerBlocks = append(noPowerBlocks, &blk)
 
 			continue
 		}
@@ -298,6 +368,8 @@ func (fp *FinalityProviderInstance) processBlocksToVote(blocks []*types.BlockInf
 		processedBlocks = append(processedBlocks, &blk)
 	}
 
+	fp.recordVotes(noPowerBlocks, proto.VoteStatus_SKIPPED_NO_POWER)
+
 	// update fp status according to the power for the last block
 	if power > 0 && fp.GetStatus() != proto.FinalityProviderStatus_ACTIVE {
 		fp.MustSetStatus(proto.FinalityProviderStatus_ACTIVE)
@@ -349,6 +421,18 @@ func (fp *FinalityProviderInstance) randomnessCommitmentLoop() {
 			txRes, err := fp.CommitPubRand(startHeight)
 			if err != nil {
 				fp.metrics.IncrementFpTotalFailedRandomness(fp.GetBtcPkHex())
+				// a rejected commit is recommitted in the next iteration
+				if failed := fp.pubRandPlanner.OnCommitFailed(); failed < fp.cfg.PubRandPlannerConfig.MaxFailedCommits {
+					fp.logger.Warn(
+						"failed to commit public randomness, will recommit",
+						zap.String("pk", fp.GetBtcPkHex()),
+						zap.Uint64("start_height", startHeight),
+						zap.Uint32("failed_commits", failed),
+						zap.Error(err),
+					)
+
+					continue
+				}
 				fp.reportCriticalErr(err)
 
 				continue
@@ -372,32 +456,26 @@ func (fp *FinalityProviderInstance) randomnessCommitmentLoop() {
 // ShouldCommitRandomness determines whether a new randomness commit should be made
 // Note: there's a delay from the commit is submitted to it is available to use due
 // to timestamping. Therefore, the start height of the commit should consider an
-// estimated delay.
+// estimated delay, which is planned by the public randomness planner.
 // If randomness should be committed, start height of the commit will be returned
 func (fp *FinalityProviderInstance) ShouldCommitRandomness() (bool, uint64, error) {
-	lastCommittedHeight, err := fp.GetLastCommittedHeight()
+	tipHeight, err := fp.updatePubRandPlanner()
 	if err != nil {
-		return false, 0, fmt.Errorf("failed to get last committed height: %w", err)
+		return false, 0, err
 	}
 
-	tipBlock, err := fp.getLatestBlockWithRetry()
-	if err != nil {
-		return false, 0, fmt.Errorf("failed to get the last block: %w", err)
+	plan := fp.pubRandPlanner.Plan(tipHeight)
+	lastCommittedHeight := fp.pubRandPlanner.Status().LastCommittedHeight
+	if plan.Recommit {
+		fp.logger.Warn(
+			"the submitted public randomness commit is not seen on chain, recommitting",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.Uint64("tip_height", tipHeight),
+			zap.Uint64("last_committed_height", lastCommittedHeight),
+		)
 	}
-	tipHeight := tipBlock.Height
-
-	tipHeightWithDelay := tipHeight + uint64(fp.cfg.TimestampingDelayBlocks)
-
-	var startHeight uint64
-	switch {
-	case lastCommittedHeight < tipHeightWithDelay:
-		// the start height should consider the timestamping delay
-		// as it is only available to use after tip height + estimated timestamping delay
-		startHeight = tipHeightWithDelay
-	case lastCommittedHeight < tipHeightWithDelay+uint64(fp.cfg.NumPubRand):
-		startHeight = lastCommittedHeight + 1
-	default:
-		// the randomness is sufficient, no need to make another commit
+	if !plan.ShouldCommit {
+		// the randomness is sufficient, or the last commit is not yet seen on chain
 		fp.logger.Debug(
 			"the finality-provider has sufficient public randomness, skip committing more",
 			zap.String("pk", fp.GetBtcPkHex()),
@@ -407,6 +485,7 @@ func (fp *FinalityProviderInstance) ShouldCommitRandomness() (bool, uint64, erro
 
 		return false, 0, nil
 	}
+	startHeight := plan.StartHeight
 
 	fp.logger.Debug(
 		"the finality-provider should commit randomness",
@@ -431,6 +510,7 @@ func (fp *FinalityProviderInstance) reportCriticalErr(err error) {
 	fp.criticalErrChan <- &CriticalError{
 		err:     err,
 		fpBtcPk: fp.GetBtcPkBIP340(),
+		chainID: string(fp.GetChainID()),
 	}
 }
 
@@ -466,6 +546,9 @@ func (fp *FinalityProviderInstance) retrySubmitSigsUntilFinalized(targetBlocks [
 			}
 
 			if clientcontroller.IsExpected(err) {
+				// the finality signatures are already submitted
+				fp.recordVotes(targetBlocks, proto.VoteStatus_VOTED)
+
 				return nil, nil
 			}
 
@@ -491,6 +574,7 @@ func (fp *FinalityProviderInstance) retrySubmitSigsUntilFinalized(targetBlocks [
 			)
 
 			fp.metrics.IncrementFpTotalFailedVotes(fp.GetBtcPkHex())
+			fp.recordVotes(targetBlocks, proto.VoteStatus_FINALIZED_WITHOUT_VOTE)
 
 			// TODO: returning nil here is to safely break the loop
 			//  the error still exists
@@ -549,6 +633,7 @@ func (fp *FinalityProviderInstance) CommitPubRand(startHeight uint64) (*types.Tx
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit public randomness to the consumer chain: %w", err)
 	}
+	fp.pubRandPlanner.OnCommitSubmitted(startHeight)
 
 	// Update metrics
 	fp.metrics.RecordFpRandomnessTime(fp.GetBtcPkHex())
@@ -666,13 +751,9 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	}
 
 	// sign blocks
-	sigList := make([]*btcec.ModNScalar, 0, len(blocks))
-	for _, b := range blocks {
-		eotsSig, err := fp.signFinalitySig(b)
-		if err != nil {
-			return nil, err
-		}
-		sigList = append(sigList, eotsSig.ToModNScalar())
+	sigList, err := fp.signFinalitySigs(blocks)
+	if err != nil {
+		return nil, err
 	}
 
 	// send finality signature to the consumer chain
@@ -691,6 +772,7 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	// update DB
 	highBlock := blocks[len(blocks)-1]
 	fp.MustUpdateStateAfterFinalitySigSubmission(highBlock.Height)
+	fp.recordVotes(blocks, proto.VoteStatus_VOTED)
 
 	return res, nil
 }
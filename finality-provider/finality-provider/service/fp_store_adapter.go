@@ -39,7 +39,7 @@ func (fps *fpState) setStatus(s proto.FinalityProviderStatus) error {
 	fps.fp.Status = s
 	fps.mu.Unlock()
 
-	return fps.s.SetFpStatus(fps.fp.BtcPk, s)
+	return fps.s.SetFpStatusOnChain(fps.fp.BtcPk, fps.fp.ChainID, s)
 }
 
 func (fps *fpState) setLastVotedHeight(height uint64) error {
@@ -47,7 +47,7 @@ func (fps *fpState) setLastVotedHeight(height uint64) error {
 	fps.fp.LastVotedHeight = height
 	fps.mu.Unlock()
 
-	return fps.s.SetFpLastVotedHeight(fps.fp.BtcPk, height)
+	return fps.s.SetFpLastVotedHeightOnChain(fps.fp.BtcPk, fps.fp.ChainID, height)
 }
 
 func (fp *FinalityProviderInstance) GetStoreFinalityProvider() *store.StoredFinalityProvider {
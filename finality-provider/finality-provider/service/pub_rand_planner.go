@@ -0,0 +1,396 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+)
+
+// PubRandPlanner plans the public randomness commits of a finality provider.
+// It tracks the commits of the finality provider on chain and the epochs they
+// are BTC-timestamped in, detects gaps and overlaps between them, and estimates
+// the block rate to predict when the committed randomness runs out.
+// A commit that is rejected, or not seen on chain for too long, is recommitted.
+type PubRandPlanner struct {
+	mu sync.Mutex
+
+	cfg                     *fpcfg.PubRandPlannerConfig
+	numPubRand              uint64
+	timestampingDelayBlocks uint64
+
+	// commits maps the start height of each tracked commit to the commit
+	commits            map[uint64]*trackedPubRandCommit
+	lastFinalizedEpoch uint64
+	// observedDelayBlocks is the delay between the submission of the last
+	// commit and its BTC timestamping
+	observedDelayBlocks uint64
+	pending             *pendingPubRandCommit
+	failedCommits       uint32
+	tipSamples          []tipSample
+}
+
+type trackedPubRandCommit struct {
+	startHeight uint64
+	numPubRand  uint64
+	epochNum    uint64
+	// submittedHeight is the tip height the commit was submitted at, or zero
+	// if the commit was not submitted by this planner
+	submittedHeight uint64
+	timestamped     bool
+}
+
+func (c *trackedPubRandCommit) endHeight() uint64 {
+	return c.startHeight + c.numPubRand - 1
+}
+
+type pendingPubRandCommit struct {
+	startHeight     uint64
+	submittedHeight uint64
+}
+
+type tipSample struct {
+	height uint64
+	time   time.Time
+}
+
+// PubRandPlan is the decision of the planner on the next commit
+type PubRandPlan struct {
+	// ShouldCommit is true if a new commit should be made
+	ShouldCommit bool
+	// StartHeight is the start height of the new commit
+	StartHeight uint64
+	// Recommit is true if the new commit replaces a stuck one
+	Recommit bool
+}
+
+// NewPubRandPlanner returns a planner of the commits of numPubRand randomness,
+// assuming at least the given delay between a commit and its BTC timestamping
+func NewPubRandPlanner(cfg *fpcfg.PubRandPlannerConfig, numPubRand, timestampingDelayBlocks uint32) *PubRandPlanner {
+	return &PubRandPlanner{
+		cfg:                     cfg,
+		numPubRand:              uint64(numPubRand),
+		timestampingDelayBlocks: uint64(timestampingDelayBlocks),
+		commits:                 make(map[uint64]*trackedPubRandCommit),
+	}
+}
+
+// Update updates the planner with the latest commits on chain, the last epoch
+// finalized by BTC timestamping and the tip height of the chain
+func (p *PubRandPlanner) Update(
+	commits map[uint64]*ftypes.PubRandCommitResponse,
+	lastFinalizedEpoch uint64,
+	tipHeight uint64,
+	now time.Time,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if n := len(p.tipSamples); n == 0 || tipHeight > p.tipSamples[n-1].height {
+		p.tipSamples = append(p.tipSamples, tipSample{height: tipHeight, time: now})
+		if len(p.tipSamples) > int(p.cfg.BlockRateSamples) {
+			p.tipSamples = p.tipSamples[len(p.tipSamples)-int(p.cfg.BlockRateSamples):]
+		}
+	}
+
+	if lastFinalizedEpoch > p.lastFinalizedEpoch {
+		p.lastFinalizedEpoch = lastFinalizedEpoch
+	}
+
+	for startHeight, c := range commits {
+		if c.NumPubRand == 0 {
+			continue
+		}
+		if _, ok := p.commits[startHeight]; ok {
+			continue
+		}
+		tracked := &trackedPubRandCommit{
+			startHeight: startHeight,
+			numPubRand:  c.NumPubRand,
+			epochNum:    c.EpochNum,
+		}
+		if p.pending != nil && p.pending.startHeight == startHeight {
+			tracked.submittedHeight = p.pending.submittedHeight
+			p.pending = nil
+		}
+		p.commits[startHeight] = tracked
+	}
+
+	var highest *trackedPubRandCommit
+	for _, c := range p.commits {
+		if !c.timestamped && c.epochNum <= p.lastFinalizedEpoch {
+			c.timestamped = true
+			// only the commits submitted by the planner tell the delay
+			if c.submittedHeight > 0 && tipHeight >= c.submittedHeight {
+				p.observedDelayBlocks = tipHeight - c.submittedHeight
+			}
+		}
+		if highest == nil || c.endHeight() > highest.endHeight() {
+			highest = c
+		}
+	}
+
+	// the commits below the tip height are no longer useful, while the
+	// highest one is kept to know the last committed height
+	for startHeight, c := range p.commits {
+		if c.endHeight() < tipHeight && c != highest {
+			delete(p.commits, startHeight)
+		}
+	}
+}
+
+// Plan decides whether a new commit should be made at the given tip height.
+// The start height of a commit accounts for the delay between the commit and
+// its BTC timestamping, as the randomness can only be used once timestamped.
+func (p *PubRandPlanner) Plan(tipHeight uint64) *PubRandPlan {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	plan := &PubRandPlan{}
+	if p.pending != nil {
+		if tipHeight < p.pending.submittedHeight+p.cfg.StuckCommitBlocks {
+			// wait for the submitted commit to be seen on chain
+			return plan
+		}
+		// the commit is stuck, so plan it again from the commits on chain
+		p.pending = nil
+		plan.Recommit = true
+	}
+
+	lastCommittedHeight := p.lastCommittedHeight()
+	tipHeightWithDelay := tipHeight + p.delayBlocks()
+
+	switch {
+	case lastCommittedHeight < tipHeightWithDelay:
+		// the start height should consider the timestamping delay
+		// as it is only available to use after tip height + estimated timestamping delay
+		plan.StartHeight = tipHeightWithDelay
+	case lastCommittedHeight < tipHeightWithDelay+p.numPubRand:
+		plan.StartHeight = lastCommittedHeight + 1
+	default:
+		// the randomness is sufficient, no need to make another commit
+		return plan
+	}
+	plan.ShouldCommit = true
+
+	return plan
+}
+
+// OnCommitSubmitted records that a commit was submitted at the current tip height
+func (p *PubRandPlanner) OnCommitSubmitted(startHeight uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pending = &pendingPubRandCommit{
+		startHeight:     startHeight,
+		submittedHeight: p.tipHeight(),
+	}
+	p.failedCommits = 0
+}
+
+// OnCommitFailed records that a commit was rejected and returns the number of
+// consecutive rejected commits
+func (p *PubRandPlanner) OnCommitFailed() uint32 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.failedCommits++
+
+	return p.failedCommits
+}
+
+// Status returns the status of the commits as of the last update
+func (p *PubRandPlanner) Status() *proto.PubRandStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tipHeight := p.tipHeight()
+	status := &proto.PubRandStatus{
+		TipHeight:               tipHeight,
+		LastCommittedHeight:     p.lastCommittedHeight(),
+		LastFinalizedEpoch:      p.lastFinalizedEpoch,
+		TimestampingDelayBlocks: p.delayBlocks(),
+		FailedCommits:           p.failedCommits,
+	}
+	if p.pending != nil {
+		status.PendingCommitStartHeight = p.pending.startHeight
+	}
+
+	commits := make([]*trackedPubRandCommit, 0, len(p.commits))
+	for _, c := range p.commits {
+		if c.endHeight() >= tipHeight {
+			commits = append(commits, c)
+		}
+	}
+	sort.Slice(commits, func(i, j int) bool {
+		return commits[i].startHeight < commits[j].startHeight
+	})
+	status.NumCommits = uint32(len(commits))
+
+	for i := 1; i < len(commits); i++ {
+		prevEnd := commits[i-1].endHeight()
+		switch {
+		case commits[i].startHeight > prevEnd+1:
+			status.Gaps = append(status.Gaps, &proto.HeightRange{
+				StartHeight: prevEnd + 1,
+				EndHeight:   commits[i].startHeight - 1,
+			})
+		case commits[i].startHeight <= prevEnd:
+			status.Overlaps = append(status.Overlaps, &proto.HeightRange{
+				StartHeight: commits[i].startHeight,
+				EndHeight:   min(prevEnd, commits[i].endHeight()),
+			})
+		}
+	}
+
+	status.CoveredHeight = coveredHeight(commits, tipHeight, false)
+	status.TimestampedHeight = coveredHeight(commits, tipHeight, true)
+
+	if blockTime := p.blockTime(); blockTime > 0 {
+		lastUpdate := p.tipSamples[len(p.tipSamples)-1].time
+		status.BlockTimeMs = blockTime.Milliseconds()
+		status.RunoutTime = runoutTime(lastUpdate, blockTime, tipHeight, status.CoveredHeight)
+		status.TimestampedRunoutTime = runoutTime(lastUpdate, blockTime, tipHeight, status.TimestampedHeight)
+	}
+
+	return status
+}
+
+func (p *PubRandPlanner) lastCommittedHeight() uint64 {
+	var lastCommittedHeight uint64
+	for _, c := range p.commits {
+		if c.endHeight() > lastCommittedHeight {
+			lastCommittedHeight = c.endHeight()
+		}
+	}
+
+	return lastCommittedHeight
+}
+
+// delayBlocks returns the configured timestamping delay, or the observed one
+// if it is longer
+func (p *PubRandPlanner) delayBlocks() uint64 {
+	return max(p.timestampingDelayBlocks, p.observedDelayBlocks)
+}
+
+func (p *PubRandPlanner) tipHeight() uint64 {
+	if len(p.tipSamples) == 0 {
+		return 0
+	}
+
+	return p.tipSamples[len(p.tipSamples)-1].height
+}
+
+// blockTime returns the average block time over the tip samples, or zero if
+// there are not enough samples
+func (p *PubRandPlanner) blockTime() time.Duration {
+	if len(p.tipSamples) < 2 {
+		return 0
+	}
+	first, last := p.tipSamples[0], p.tipSamples[len(p.tipSamples)-1]
+
+	return last.time.Sub(first.time) / time.Duration(last.height-first.height)
+}
+
+// coveredHeight returns the highest height up to which the given commits,
+// sorted by start height, cover the heights from the tip height without gaps,
+// or the height below the tip height if the tip height is not covered
+func coveredHeight(commits []*trackedPubRandCommit, tipHeight uint64, timestampedOnly bool) uint64 {
+	covered := tipHeight - 1
+	if tipHeight == 0 {
+		covered = 0
+	}
+	for _, c := range commits {
+		if timestampedOnly && !c.timestamped {
+			continue
+		}
+		if c.startHeight > covered+1 {
+			break
+		}
+		if c.endHeight() > covered {
+			covered = c.endHeight()
+		}
+	}
+
+	return covered
+}
+
+func runoutTime(lastUpdate time.Time, blockTime time.Duration, tipHeight, coveredHeight uint64) int64 {
+	var remainingBlocks uint64
+	if coveredHeight >= tipHeight {
+		remainingBlocks = coveredHeight - tipHeight + 1
+	}
+
+	return lastUpdate.Add(blockTime * time.Duration(remainingBlocks)).Unix()
+}
+
+// updatePubRandPlanner updates the planner with the commits of the finality
+// provider on chain and the tip height, and warns about the issues found in
+// the commits. The tip height is returned.
+func (fp *FinalityProviderInstance) updatePubRandPlanner() (uint64, error) {
+	commits, err := fp.lastCommittedPublicRandWithRetry(fp.cfg.PubRandPlannerConfig.CommitLookback)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the committed public randomness: %w", err)
+	}
+
+	tipBlock, err := fp.getLatestBlockWithRetry()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the last block: %w", err)
+	}
+
+	// the planner keeps the last known finalized epoch if the query fails
+	lastFinalizedEpoch, err := fp.cc.QueryLastFinalizedEpoch()
+	if err != nil {
+		fp.logger.Debug(
+			"failed to query the last finalized epoch",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.Error(err),
+		)
+	}
+
+	fp.pubRandPlanner.Update(commits, lastFinalizedEpoch, tipBlock.Height, time.Now())
+
+	status := fp.pubRandPlanner.Status()
+	if len(status.Gaps) > 0 {
+		fp.logger.Warn(
+			"there are gaps between the public randomness commits, the finality provider cannot vote at these heights",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.Uint64("tip_height", status.TipHeight),
+			zap.Any("gaps", status.Gaps),
+		)
+	}
+	if len(status.Overlaps) > 0 {
+		fp.logger.Warn(
+			"there are overlapping public randomness commits",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.Uint64("tip_height", status.TipHeight),
+			zap.Any("overlaps", status.Overlaps),
+		)
+	}
+	if status.CoveredHeight >= status.TipHeight && status.TimestampedHeight < status.TipHeight {
+		fp.logger.Warn(
+			"the public randomness at the tip height is not yet BTC-timestamped, the votes will be rejected until it is",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.Uint64("tip_height", status.TipHeight),
+			zap.Uint64("last_finalized_epoch", status.LastFinalizedEpoch),
+		)
+	}
+
+	return tipBlock.Height, nil
+}
+
+// GetPubRandStatus returns the status of the public randomness commits of the
+// finality provider as of the last attempt to commit
+func (fp *FinalityProviderInstance) GetPubRandStatus() *proto.PubRandStatus {
+	status := fp.pubRandPlanner.Status()
+	status.BtcPkHex = fp.GetBtcPkHex()
+	status.ChainId = string(fp.GetChainID())
+
+	return status
+}
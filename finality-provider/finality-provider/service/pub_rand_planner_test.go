@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+)
+
+func TestPubRandPlanner(t *testing.T) {
+	t.Parallel()
+	cfg := config.DefaultPubRandPlannerConfig()
+	cfg.StuckCommitBlocks = 10
+	numPubRand, delayBlocks := uint32(100), uint32(20)
+	planner := service.NewPubRandPlanner(&cfg, numPubRand, delayBlocks)
+	now := time.Unix(1000, 0)
+
+	// no commit yet, so the commit starts after the timestamping delay
+	planner.Update(nil, 0, 50, now)
+	plan := planner.Plan(50)
+	require.True(t, plan.ShouldCommit)
+	require.Equal(t, uint64(70), plan.StartHeight)
+
+	// wait for the submitted commit to be seen on chain
+	planner.OnCommitSubmitted(plan.StartHeight)
+	now = now.Add(10 * time.Second)
+	planner.Update(nil, 0, 55, now)
+	require.False(t, planner.Plan(55).ShouldCommit)
+	require.Equal(t, uint64(70), planner.Status().PendingCommitStartHeight)
+
+	// the commit is stuck, so it is recommitted
+	now = now.Add(10 * time.Second)
+	planner.Update(nil, 0, 60, now)
+	plan = planner.Plan(60)
+	require.True(t, plan.ShouldCommit)
+	require.True(t, plan.Recommit)
+	require.Equal(t, uint64(80), plan.StartHeight)
+
+	// the commit is seen on chain but not yet timestamped
+	planner.OnCommitSubmitted(plan.StartHeight)
+	now = now.Add(10 * time.Second)
+	commits := map[uint64]*ftypes.PubRandCommitResponse{
+		80: {NumPubRand: 100, EpochNum: 2},
+	}
+	planner.Update(commits, 1, 80, now)
+	status := planner.Status()
+	require.Zero(t, status.PendingCommitStartHeight)
+	require.Equal(t, uint64(179), status.LastCommittedHeight)
+	require.Equal(t, uint64(179), status.CoveredHeight)
+	require.Equal(t, uint64(79), status.TimestampedHeight)
+	require.Equal(t, int64(1000), status.BlockTimeMs)
+	require.Equal(t, now.Add(100*time.Second).Unix(), status.RunoutTime)
+	require.Equal(t, now.Unix(), status.TimestampedRunoutTime)
+	// the committed randomness ends before the delay plus a commit, so the
+	// next commit follows the last one
+	plan = planner.Plan(80)
+	require.True(t, plan.ShouldCommit)
+	require.Equal(t, uint64(180), plan.StartHeight)
+
+	// the commit is timestamped later than the configured delay, so the
+	// observed delay is used
+	now = now.Add(30 * time.Second)
+	planner.Update(commits, 2, 110, now)
+	status = planner.Status()
+	require.Equal(t, uint64(179), status.TimestampedHeight)
+	require.Equal(t, uint64(50), status.TimestampingDelayBlocks)
+	plan = planner.Plan(110)
+	require.True(t, plan.ShouldCommit)
+	require.Equal(t, uint64(180), plan.StartHeight)
+
+	// the gaps and overlaps between the commits are detected
+	commits[200] = &ftypes.PubRandCommitResponse{NumPubRand: 100, EpochNum: 2}
+	commits[250] = &ftypes.PubRandCommitResponse{NumPubRand: 100, EpochNum: 3}
+	planner.Update(commits, 2, 120, now.Add(10*time.Second))
+	status = planner.Status()
+	require.Equal(t, []*proto.HeightRange{{StartHeight: 180, EndHeight: 199}}, status.Gaps)
+	require.Equal(t, []*proto.HeightRange{{StartHeight: 250, EndHeight: 299}}, status.Overlaps)
+	require.Equal(t, uint64(179), status.CoveredHeight)
+	require.Equal(t, uint64(349), status.LastCommittedHeight)
+	// the randomness is sufficient
+	require.False(t, planner.Plan(120).ShouldCommit)
+
+	// the rejected commits are counted until one is submitted
+	require.Equal(t, uint32(1), planner.OnCommitFailed())
+	require.Equal(t, uint32(2), planner.OnCommitFailed())
+	planner.OnCommitSubmitted(350)
+	require.Zero(t, planner.Status().FailedCommits)
+}
@@ -77,7 +77,9 @@ func (r *rpcServer) RegisterWithGrpcServer(grpcServer *grpc.Server) error {
 // GetInfo returns general information relating to the active daemon
 func (r *rpcServer) GetInfo(context.Context, *proto.GetInfoRequest) (*proto.GetInfoResponse, error) {
 	return &proto.GetInfoResponse{
-		Version: version.RPC(),
+		Version:       version.RPC(),
+		PubRandStatus: r.app.GetPubRandStatuses(),
+		CatchUpStatus: r.app.GetCatchUpStatuses(),
 	}, nil
 }
 
@@ -284,6 +286,18 @@ func (r *rpcServer) UnsafeRemoveMerkleProof(_ context.Context, req *proto.Remove
 	return nil, nil
 }
 
+// QueryFinalityProviderUptime - queries the votes of the finality provider over the
+// rolling missed-blocks window from the vote ledger
+func (r *rpcServer) QueryFinalityProviderUptime(_ context.Context, req *proto.QueryFinalityProviderUptimeRequest) (
+	*proto.QueryFinalityProviderUptimeResponse, error) {
+	fpPk, err := parseEotsPk(req.BtcPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.app.GetFinalityProviderUptime(fpPk, req.ChainId)
+}
+
 func parseEotsPk(eotsPkHex string) (*bbntypes.BIP340PubKey, error) {
 	if eotsPkHex == "" {
 		return nil, fmt.Errorf("eots-pk cannot be empty")
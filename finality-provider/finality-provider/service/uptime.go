@@ -0,0 +1,155 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// ComputeUptime computes the votes of the finality provider over the rolling
+// missed-blocks window from its vote ledger on the given chain. The window covers
+// the latest SignedBlocksWindow heights the finality provider had voting power at,
+// ending at the highest height in the ledger. The heights in between without a
+// record, e.g., as the finality provider was down, are counted as missed.
+func ComputeUptime(
+	vl *store.VoteLedgerStore,
+	fpPk *bbntypes.BIP340PubKey,
+	chainID string,
+	cfg *fpcfg.UptimeConfig,
+) (*proto.QueryFinalityProviderUptimeResponse, error) {
+	if cfg.SignedBlocksWindow == 0 {
+		return nil, fmt.Errorf("the missed-blocks window is not set yet")
+	}
+
+	window := cfg.SignedBlocksWindow
+	minSigned := cfg.MinSignedPerWindowInt()
+	res := &proto.QueryFinalityProviderUptimeResponse{
+		BtcPkHex:           fpPk.MarshalHex(),
+		ChainId:            chainID,
+		SignedBlocksWindow: window,
+		MinSignedPerWindow: minSigned,
+		MaxMissed:          window - minSigned,
+	}
+
+	var (
+		counted       int64
+		prevHeight    uint64
+		missedHeights []uint64
+	)
+	addMissed := func(height uint64) {
+		missedHeights = append(missedHeights, height)
+		res.Missed++
+		res.StartHeight = height
+		counted++
+	}
+
+	err := vl.IterateVoteRecordsReverse([]byte(chainID), fpPk.MustMarshal(), func(r *proto.VoteRecord) bool {
+		if res.EndHeight == 0 {
+			res.EndHeight = r.Height
+		}
+
+		// count the unrecorded heights between this and the previous record
+		for h := prevHeight - 1; prevHeight > 0 && h > r.Height && counted < window; h-- {
+			res.Unrecorded++
+			addMissed(h)
+		}
+		if counted >= window {
+			return false
+		}
+		prevHeight = r.Height
+
+		switch r.Status {
+		case proto.VoteStatus_SKIPPED_NO_POWER:
+			// the heights without voting power are not part of the window
+		case proto.VoteStatus_VOTED:
+			res.Voted++
+			res.StartHeight = r.Height
+			counted++
+		case proto.VoteStatus_FAILED:
+			res.Failed++
+			addMissed(r.Height)
+		case proto.VoteStatus_FINALIZED_WITHOUT_VOTE:
+			res.FinalizedWithoutVote++
+			addMissed(r.Height)
+		}
+
+		return counted < window
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the vote ledger: %w", err)
+	}
+
+	// list the missed heights in ascending order
+	res.MissedHeights = make([]uint64, 0, len(missedHeights))
+	for i := len(missedHeights) - 1; i >= 0; i-- {
+		res.MissedHeights = append(res.MissedHeights, missedHeights[i])
+	}
+
+	res.AtRisk = res.Missed > 0 && float64(res.Missed) >= cfg.WarnThreshold*float64(res.MaxMissed)
+
+	return res, nil
+}
+
+// recordVotes records the given outcome of the votes at the given blocks in the
+// vote ledger, and warns if the finality provider is at risk of being jailed
+func (fp *FinalityProviderInstance) recordVotes(blocks []*types.BlockInfo, status proto.VoteStatus) {
+	if len(blocks) == 0 {
+		return
+	}
+
+	now := time.Now().Unix()
+	records := make([]*proto.VoteRecord, 0, len(blocks))
+	for _, b := range blocks {
+		records = append(records, &proto.VoteRecord{
+			Height:    b.Height,
+			Status:    status,
+			Timestamp: now,
+		})
+	}
+
+	// the vote ledger is only used for reporting, so failing to update it
+	// should not stop the finality provider from voting
+	if err := fp.voteLedger.SetVoteRecords(
+		fp.GetChainID(), fp.btcPk.MustMarshal(), records, fp.cfg.UptimeConfig.VoteLedgerRetainBlocks,
+	); err != nil {
+		fp.logger.Error("failed to record the votes in the vote ledger",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.String("status", status.String()),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	uptime, err := fp.GetUptime()
+	if err != nil {
+		fp.logger.Error("failed to compute the uptime", zap.String("pk", fp.GetBtcPkHex()), zap.Error(err))
+
+		return
+	}
+
+	fp.metrics.RecordFpMissedBlocksInWindow(fp.GetBtcPkHex(), uptime.Missed)
+
+	if uptime.AtRisk && status != proto.VoteStatus_VOTED && status != proto.VoteStatus_SKIPPED_NO_POWER {
+		fp.logger.Warn("the finality provider is at risk of being jailed for missing blocks",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.String("chain_id", uptime.ChainId),
+			zap.Int64("missed", uptime.Missed),
+			zap.Int64("max_missed", uptime.MaxMissed),
+			zap.Int64("signed_blocks_window", uptime.SignedBlocksWindow),
+		)
+	}
+}
+
+// GetUptime returns the votes of the finality provider over the rolling
+// missed-blocks window on the chain it runs on
+func (fp *FinalityProviderInstance) GetUptime() (*proto.QueryFinalityProviderUptimeResponse, error) {
+	return ComputeUptime(fp.voteLedger, fp.btcPk, string(fp.GetChainID()), fp.cfg.UptimeConfig)
+}
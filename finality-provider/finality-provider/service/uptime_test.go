@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+func TestComputeUptime(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(10))
+
+	dbCfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+	db, err := dbCfg.GetDBBackend()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	vl, err := store.NewVoteLedgerStore(db)
+	require.NoError(t, err)
+
+	chainID := "test-chain"
+	fpPk := testutil.GenRandomFinalityProvider(r, t).GetBIP340BTCPK()
+
+	uptimeCfg := config.DefaultUptimeConfig()
+	uptimeCfg.SignedBlocksWindow = 10
+	uptimeCfg.MinSignedPerWindow = 0.6
+	uptimeCfg.WarnThreshold = 0.5
+
+	// heights 1-2 voted, 3 skipped, 4 failed, 5-6 unrecorded, 7 finalized
+	// without vote, 8 skipped, 9-14 voted
+	statuses := map[uint64]proto.VoteStatus{
+		1: proto.VoteStatus_VOTED, 2: proto.VoteStatus_VOTED,
+		3: proto.VoteStatus_SKIPPED_NO_POWER, 4: proto.VoteStatus_FAILED,
+		7: proto.VoteStatus_FINALIZED_WITHOUT_VOTE, 8: proto.VoteStatus_SKIPPED_NO_POWER,
+	}
+	for h := uint64(9); h <= 14; h++ {
+		statuses[h] = proto.VoteStatus_VOTED
+	}
+	records := make([]*proto.VoteRecord, 0, len(statuses))
+	for h, status := range statuses {
+		records = append(records, &proto.VoteRecord{Height: h, Status: status})
+	}
+	require.NoError(t, vl.SetVoteRecords([]byte(chainID), fpPk.MustMarshal(), records, 1000))
+
+	uptime, err := service.ComputeUptime(vl, fpPk, chainID, &uptimeCfg)
+	require.NoError(t, err)
+
+	// the window of 10 blocks with voting power spans heights 4-14
+	require.Equal(t, int64(10), uptime.SignedBlocksWindow)
+	require.Equal(t, int64(6), uptime.MinSignedPerWindow)
+	require.Equal(t, int64(4), uptime.MaxMissed)
+	require.Equal(t, uint64(4), uptime.StartHeight)
+	require.Equal(t, uint64(14), uptime.EndHeight)
+	require.Equal(t, int64(6), uptime.Voted)
+	require.Equal(t, int64(1), uptime.Failed)
+	require.Equal(t, int64(1), uptime.FinalizedWithoutVote)
+	require.Equal(t, int64(2), uptime.Unrecorded)
+	require.Equal(t, int64(4), uptime.Missed)
+	require.Equal(t, []uint64{4, 5, 6, 7}, uptime.MissedHeights)
+	require.True(t, uptime.AtRisk)
+
+	// an empty ledger has no missed blocks
+	otherPk := testutil.GenRandomFinalityProvider(r, t).GetBIP340BTCPK()
+	uptime, err = service.ComputeUptime(vl, otherPk, chainID, &uptimeCfg)
+	require.NoError(t, err)
+	require.Zero(t, uptime.Missed)
+	require.False(t, uptime.AtRisk)
+}
+
+func TestSetJailingParams(t *testing.T) {
+	t.Parallel()
+
+	// the window is unknown until set from the finality params
+	uptimeCfg := config.DefaultUptimeConfig()
+	require.NoError(t, uptimeCfg.Validate())
+	require.False(t, uptimeCfg.IsOverridden())
+	_, err := service.ComputeUptime(nil, nil, "test-chain", &uptimeCfg)
+	require.Error(t, err)
+
+	require.NoError(t, uptimeCfg.SetJailingParams(1000, 0.7))
+	require.Equal(t, int64(1000), uptimeCfg.SignedBlocksWindow)
+	require.Equal(t, int64(700), uptimeCfg.MinSignedPerWindowInt())
+
+	// the values of the config override the finality params
+	uptimeCfg = config.DefaultUptimeConfig()
+	uptimeCfg.MinSignedPerWindow = 0.9
+	require.NoError(t, uptimeCfg.SetJailingParams(1000, 0.7))
+	require.Equal(t, int64(1000), uptimeCfg.SignedBlocksWindow)
+	require.Equal(t, 0.9, uptimeCfg.MinSignedPerWindow)
+	require.True(t, uptimeCfg.IsOverridden())
+
+	// a window larger than the vote ledger is rejected
+	uptimeCfg = config.DefaultUptimeConfig()
+	uptimeCfg.VoteLedgerRetainBlocks = 100
+	require.Error(t, uptimeCfg.SetJailingParams(1000, 0.7))
+}
@@ -17,4 +17,10 @@ var (
 
 	// ErrPubRandProofNotFound The finality provider we try update is not found in db
 	ErrPubRandProofNotFound = errors.New("public randomness proof not found")
+
+	// ErrCorruptedVoteLedgerDB For some reason, db on disk representation have changed
+	ErrCorruptedVoteLedgerDB = errors.New("vote ledger db is corrupted")
+
+	// ErrVoteRecordNotFound The vote record at the height is not found in db
+	ErrVoteRecordNotFound = errors.New("vote record not found")
 )
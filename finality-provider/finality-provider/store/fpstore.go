@@ -18,6 +18,9 @@ import (
 var (
 	// mapping pk -> proto.FinalityProvider
 	finalityProviderBucketName = []byte("finalityProviders")
+	// mapping (chainID || pk) -> proto.FinalityProvider, keeping the state of the
+	// finality provider on the consumer chains other than the one it is registered to
+	consumerFinalityProviderBucketName = []byte("consumerFinalityProviders")
 )
 
 type FinalityProviderStore struct {
@@ -36,7 +39,11 @@ func NewFinalityProviderStore(db kvdb.Backend) (*FinalityProviderStore, error) {
 
 func (s *FinalityProviderStore) initBuckets() error {
 	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
-		_, err := tx.CreateTopLevelBucket(finalityProviderBucketName)
+		if _, err := tx.CreateTopLevelBucket(finalityProviderBucketName); err != nil {
+			return err
+		}
+
+		_, err := tx.CreateTopLevelBucket(consumerFinalityProviderBucketName)
 
 		return err
 	})
@@ -271,3 +278,147 @@ func (s *FinalityProviderStore) SetFpDescription(btcPk *btcec.PublicKey, desc *s
 
 	return s.setFinalityProviderState(btcPk, setDescription)
 }
+
+// GetFinalityProviderOnChain returns the finality provider with its state on the
+// given chain. If the chain is not the one the finality provider is registered to,
+// the status and last voted height are the ones on the given consumer chain.
+func (s *FinalityProviderStore) GetFinalityProviderOnChain(btcPk *btcec.PublicKey, chainID string) (*StoredFinalityProvider, error) {
+	var storedFp *StoredFinalityProvider
+	pkBytes := schnorr.SerializePubKey(btcPk)
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		fpBucket := tx.ReadBucket(finalityProviderBucketName)
+		if fpBucket == nil {
+			return ErrCorruptedFinalityProviderDB
+		}
+		consumerBucket := tx.ReadBucket(consumerFinalityProviderBucketName)
+		if consumerBucket == nil {
+			return ErrCorruptedFinalityProviderDB
+		}
+
+		fpProto, _, err := getFinalityProviderOnChain(fpBucket, consumerBucket, pkBytes, chainID)
+		if err != nil {
+			return err
+		}
+
+		storedFp, err = protoFpToStoredFinalityProvider(fpProto)
+
+		return err
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return storedFp, nil
+}
+
+// SetFpStatusOnChain sets the status of the finality provider on the given chain
+func (s *FinalityProviderStore) SetFpStatusOnChain(btcPk *btcec.PublicKey, chainID string, status proto.FinalityProviderStatus) error {
+	setFpStatus := func(fp *proto.FinalityProvider) error {
+		fp.Status = status
+
+		return nil
+	}
+
+	return s.setFinalityProviderStateOnChain(btcPk, chainID, setFpStatus)
+}
+
+// SetFpLastVotedHeightOnChain sets the last voted height of the finality provider
+// on the given chain, only if it is larger than the stored one
+func (s *FinalityProviderStore) SetFpLastVotedHeightOnChain(btcPk *btcec.PublicKey, chainID string, lastVotedHeight uint64) error {
+	setFpLastVotedHeight := func(fp *proto.FinalityProvider) error {
+		if fp.LastVotedHeight < lastVotedHeight {
+			fp.LastVotedHeight = lastVotedHeight
+		}
+
+		return nil
+	}
+
+	return s.setFinalityProviderStateOnChain(btcPk, chainID, setFpLastVotedHeight)
+}
+
+func (s *FinalityProviderStore) setFinalityProviderStateOnChain(
+	btcPk *btcec.PublicKey,
+	chainID string,
+	stateTransitionFn func(provider *proto.FinalityProvider) error,
+) error {
+	pkBytes := schnorr.SerializePubKey(btcPk)
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		fpBucket := tx.ReadWriteBucket(finalityProviderBucketName)
+		if fpBucket == nil {
+			return ErrCorruptedFinalityProviderDB
+		}
+		consumerBucket := tx.ReadWriteBucket(consumerFinalityProviderBucketName)
+		if consumerBucket == nil {
+			return ErrCorruptedFinalityProviderDB
+		}
+
+		fpProto, registeredChain, err := getFinalityProviderOnChain(fpBucket, consumerBucket, pkBytes, chainID)
+		if err != nil {
+			return err
+		}
+
+		if err := stateTransitionFn(fpProto); err != nil {
+			return err
+		}
+
+		if registeredChain {
+			return saveFinalityProvider(fpBucket, fpProto)
+		}
+
+		marshalled, err := pm.Marshal(fpProto)
+		if err != nil {
+			return err
+		}
+
+		return consumerBucket.Put(getConsumerFpKey(chainID, pkBytes), marshalled)
+	})
+}
+
+// getFinalityProviderOnChain returns the finality provider with its state on the
+// given chain, which starts as REGISTERED on a consumer chain it has not voted on,
+// and whether the chain is the one it is registered to
+func getFinalityProviderOnChain(
+	fpBucket, consumerBucket walletdb.ReadBucket,
+	pkBytes []byte,
+	chainID string,
+) (*proto.FinalityProvider, bool, error) {
+	fpBytes := fpBucket.Get(pkBytes)
+	if fpBytes == nil {
+		return nil, false, ErrFinalityProviderNotFound
+	}
+
+	var fpProto proto.FinalityProvider
+	if err := pm.Unmarshal(fpBytes, &fpProto); err != nil {
+		return nil, false, ErrCorruptedFinalityProviderDB
+	}
+	if fpProto.ChainId == chainID {
+		return &fpProto, true, nil
+	}
+
+	fpProto.ChainId = chainID
+	fpProto.LastVotedHeight = 0
+	fpProto.Status = proto.FinalityProviderStatus_REGISTERED
+
+	if consumerBytes := consumerBucket.Get(getConsumerFpKey(chainID, pkBytes)); consumerBytes != nil {
+		var consumerFp proto.FinalityProvider
+		if err := pm.Unmarshal(consumerBytes, &consumerFp); err != nil {
+			return nil, false, ErrCorruptedFinalityProviderDB
+		}
+		fpProto.LastVotedHeight = consumerFp.LastVotedHeight
+		fpProto.Status = consumerFp.Status
+	}
+
+	return &fpProto, false, nil
+}
+
+// the consumer finality provider key is (chainID || pk)
+func getConsumerFpKey(chainID string, pkBytes []byte) []byte {
+	key := make([]byte, 0, len(chainID)+len(pkBytes))
+	key = append(key, chainID...)
+	key = append(key, pkBytes...)
+
+	return key
+}
@@ -198,3 +198,68 @@ func TestUpdateFpStatusFromVotingPower(t *testing.T) {
 		})
 	}
 }
+
+// FuzzFinalityProviderStateOnChain tests the state of a finality provider on the
+// consumer chains is kept apart from the one on the chain it is registered to
+func FuzzFinalityProviderStateOnChain(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		fpdb, err := cfg.GetDBBackend()
+		require.NoError(t, err)
+		vs, err := fpstore.NewFinalityProviderStore(fpdb)
+		require.NoError(t, err)
+
+		defer func() {
+			err := fpdb.Close()
+			require.NoError(t, err)
+		}()
+
+		fp := testutil.GenRandomFinalityProvider(r, t)
+		fpAddr, err := sdk.AccAddressFromBech32(fp.FPAddr)
+		require.NoError(t, err)
+
+		err = vs.CreateFinalityProvider(fpAddr, fp.BtcPk, fp.Description, fp.Commission, fp.ChainID)
+		require.NoError(t, err)
+
+		consumerChainID := fp.ChainID + "-consumer"
+
+		// the finality provider starts as registered on a consumer chain
+		consumerFp, err := vs.GetFinalityProviderOnChain(fp.BtcPk, consumerChainID)
+		require.NoError(t, err)
+		require.Equal(t, consumerChainID, consumerFp.ChainID)
+		require.Equal(t, proto.FinalityProviderStatus_REGISTERED, consumerFp.Status)
+		require.Zero(t, consumerFp.LastVotedHeight)
+
+		homeHeight := uint64(r.Int63n(1000) + 1)
+		consumerHeight := uint64(r.Int63n(1000) + 1)
+		require.NoError(t, vs.SetFpLastVotedHeightOnChain(fp.BtcPk, fp.ChainID, homeHeight))
+		require.NoError(t, vs.SetFpStatusOnChain(fp.BtcPk, fp.ChainID, proto.FinalityProviderStatus_ACTIVE))
+		require.NoError(t, vs.SetFpLastVotedHeightOnChain(fp.BtcPk, consumerChainID, consumerHeight))
+		require.NoError(t, vs.SetFpStatusOnChain(fp.BtcPk, consumerChainID, proto.FinalityProviderStatus_JAILED))
+
+		// the last voted height only increases
+		require.NoError(t, vs.SetFpLastVotedHeightOnChain(fp.BtcPk, consumerChainID, consumerHeight-1))
+
+		homeFp, err := vs.GetFinalityProvider(fp.BtcPk)
+		require.NoError(t, err)
+		require.Equal(t, fp.ChainID, homeFp.ChainID)
+		require.Equal(t, homeHeight, homeFp.LastVotedHeight)
+		require.Equal(t, proto.FinalityProviderStatus_ACTIVE, homeFp.Status)
+
+		consumerFp, err = vs.GetFinalityProviderOnChain(fp.BtcPk, consumerChainID)
+		require.NoError(t, err)
+		require.Equal(t, consumerHeight, consumerFp.LastVotedHeight)
+		require.Equal(t, proto.FinalityProviderStatus_JAILED, consumerFp.Status)
+
+		// only the finality provider on the registered chain is listed
+		fpList, err := vs.GetAllStoredFinalityProviders()
+		require.NoError(t, err)
+		require.Len(t, fpList, 1)
+	})
+}
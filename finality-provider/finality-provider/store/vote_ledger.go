@@ -0,0 +1,168 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+)
+
+var (
+	// mapping: (chainID || pk || height) -> proto.VoteRecord
+	voteLedgerBucketName = []byte("vote_ledger")
+)
+
+// VoteLedgerStore keeps the outcome of the vote of the finality providers at
+// each height they processed
+type VoteLedgerStore struct {
+	db kvdb.Backend
+}
+
+// NewVoteLedgerStore returns a new store backed by db
+func NewVoteLedgerStore(db kvdb.Backend) (*VoteLedgerStore, error) {
+	store := &VoteLedgerStore{db}
+	if err := store.initBuckets(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *VoteLedgerStore) initBuckets() error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(voteLedgerBucketName)
+
+		return err
+	})
+}
+
+// SetVoteRecords records the given outcomes of the votes of the finality provider.
+// A VOTED record is final and never overwritten. The records at the heights lower
+// than the highest given height minus retainBlocks are pruned.
+func (s *VoteLedgerStore) SetVoteRecords(chainID, pk []byte, records []*proto.VoteRecord, retainBlocks uint64) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var highest uint64
+	for _, r := range records {
+		if r.Height > highest {
+			highest = r.Height
+		}
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(voteLedgerBucketName)
+		if bucket == nil {
+			return ErrCorruptedVoteLedgerDB
+		}
+
+		for _, r := range records {
+			key := getKey(chainID, pk, r.Height)
+			if existing := bucket.Get(key); existing != nil {
+				var existingRecord proto.VoteRecord
+				if err := pm.Unmarshal(existing, &existingRecord); err != nil {
+					return ErrCorruptedVoteLedgerDB
+				}
+				if existingRecord.Status == proto.VoteStatus_VOTED {
+					continue
+				}
+			}
+
+			recordBytes, err := pm.Marshal(r)
+			if err != nil {
+				return fmt.Errorf("failed to marshal the vote record: %w", err)
+			}
+			if err := bucket.Put(key, recordBytes); err != nil {
+				return err
+			}
+		}
+
+		if highest <= retainBlocks {
+			return nil
+		}
+
+		// prune the records below the retained heights
+		prefix := getPrefixKey(chainID, pk)
+		pruneUpTo := getKey(chainID, pk, highest-retainBlocks)
+		var keysToDelete [][]byte
+		c := bucket.ReadCursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix) && bytes.Compare(k, pruneUpTo) < 0; k, _ = c.Next() {
+			keysToDelete = append(keysToDelete, k)
+		}
+		for _, k := range keysToDelete {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetVoteRecord returns the vote record of the finality provider at the given height
+func (s *VoteLedgerStore) GetVoteRecord(chainID, pk []byte, height uint64) (*proto.VoteRecord, error) {
+	var record *proto.VoteRecord
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(voteLedgerBucketName)
+		if bucket == nil {
+			return ErrCorruptedVoteLedgerDB
+		}
+
+		recordBytes := bucket.Get(getKey(chainID, pk, height))
+		if recordBytes == nil {
+			return ErrVoteRecordNotFound
+		}
+
+		record = &proto.VoteRecord{}
+
+		return pm.Unmarshal(recordBytes, record)
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// IterateVoteRecordsReverse calls fn on the vote records of the finality provider
+// from the highest height down, until fn returns false
+func (s *VoteLedgerStore) IterateVoteRecordsReverse(chainID, pk []byte, fn func(r *proto.VoteRecord) bool) error {
+	prefix := getPrefixKey(chainID, pk)
+
+	return s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(voteLedgerBucketName)
+		if bucket == nil {
+			return ErrCorruptedVoteLedgerDB
+		}
+
+		c := bucket.ReadCursor()
+		// seek to the first key not lower than the highest possible height,
+		// and step back into the prefix if it is past it
+		lastKey := getKey(chainID, pk, math.MaxUint64)
+		k, v := c.Seek(lastKey)
+		switch {
+		case k == nil:
+			k, v = c.Last()
+		case !bytes.Equal(k, lastKey):
+			k, v = c.Prev()
+		}
+
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Prev() {
+			var record proto.VoteRecord
+			if err := pm.Unmarshal(v, &record); err != nil {
+				return ErrCorruptedVoteLedgerDB
+			}
+			if !fn(&record) {
+				return nil
+			}
+		}
+
+		return nil
+	}, func() {})
+}
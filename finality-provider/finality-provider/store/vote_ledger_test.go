@@ -0,0 +1,94 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzVoteLedger tests recording, iterating and pruning the vote records
+func FuzzVoteLedger(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		db, err := cfg.GetDBBackend()
+		require.NoError(t, err)
+		vl, err := store.NewVoteLedgerStore(db)
+		require.NoError(t, err)
+
+		defer func() {
+			err := db.Close()
+			require.NoError(t, err)
+		}()
+
+		chainID := []byte("test-chain")
+		pk := testutil.GenRandomFinalityProvider(r, t).GetBIP340BTCPK().MustMarshal()
+		otherPk := testutil.GenRandomFinalityProvider(r, t).GetBIP340BTCPK().MustMarshal()
+
+		numRecords := uint64(r.Intn(100) + 10)
+		retainBlocks := uint64(r.Intn(int(numRecords))) + 1
+		records := make([]*proto.VoteRecord, 0, numRecords)
+		for h := uint64(1); h <= numRecords; h++ {
+			records = append(records, &proto.VoteRecord{
+				Height: h,
+				Status: proto.VoteStatus(r.Intn(4)),
+			})
+		}
+		err = vl.SetVoteRecords(chainID, pk, records, numRecords)
+		require.NoError(t, err)
+		err = vl.SetVoteRecords(chainID, otherPk, records[:1], numRecords)
+		require.NoError(t, err)
+
+		// a VOTED record is never overwritten
+		for _, record := range records {
+			err = vl.SetVoteRecords(chainID, pk, []*proto.VoteRecord{{Height: record.Height, Status: proto.VoteStatus_FAILED}}, numRecords)
+			require.NoError(t, err)
+			stored, err := vl.GetVoteRecord(chainID, pk, record.Height)
+			require.NoError(t, err)
+			if record.Status == proto.VoteStatus_VOTED {
+				require.Equal(t, proto.VoteStatus_VOTED, stored.Status)
+			} else {
+				require.Equal(t, proto.VoteStatus_FAILED, stored.Status)
+			}
+		}
+
+		// the records are iterated from the highest height down
+		var heights []uint64
+		err = vl.IterateVoteRecordsReverse(chainID, pk, func(r *proto.VoteRecord) bool {
+			heights = append(heights, r.Height)
+
+			return true
+		})
+		require.NoError(t, err)
+		require.Len(t, heights, int(numRecords))
+		for i, h := range heights {
+			require.Equal(t, numRecords-uint64(i), h)
+		}
+
+		// the records below the retained heights are pruned
+		err = vl.SetVoteRecords(chainID, pk, []*proto.VoteRecord{{Height: numRecords + 1}}, retainBlocks)
+		require.NoError(t, err)
+		lowestRetained := numRecords + 1 - retainBlocks
+		_, err = vl.GetVoteRecord(chainID, pk, lowestRetained)
+		require.NoError(t, err)
+		if lowestRetained > 1 {
+			_, err = vl.GetVoteRecord(chainID, pk, lowestRetained-1)
+			require.ErrorIs(t, err, store.ErrVoteRecordNotFound)
+		}
+
+		// the records of other finality providers are kept
+		_, err = vl.GetVoteRecord(chainID, otherPk, 1)
+		require.NoError(t, err)
+	})
+}
@@ -29,6 +29,7 @@ type FpMetrics struct {
 	fpTotalCommittedRandomness      *prometheus.GaugeVec
 	fpTotalFailedVotes              *prometheus.CounterVec
 	fpTotalFailedRandomness         *prometheus.CounterVec
+	fpMissedBlocksInWindow          *prometheus.GaugeVec
 	// time keeper
 	mu                     sync.Mutex
 	previousVoteByFp       map[string]*time.Time
@@ -135,6 +136,13 @@ func NewFpMetrics() *FpMetrics {
 				},
 				[]string{"fp_btc_pk_hex"},
 			),
+			fpMissedBlocksInWindow: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "fp_missed_blocks_in_window",
+					Help: "The number of blocks missed by a finality provider in the rolling missed-blocks window.",
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
 			mu: sync.Mutex{},
 		}
 
@@ -154,6 +162,7 @@ func NewFpMetrics() *FpMetrics {
 		prometheus.MustRegister(fpMetricsInstance.fpLastCommittedRandomnessHeight)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedVotes)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedRandomness)
+		prometheus.MustRegister(fpMetricsInstance.fpMissedBlocksInWindow)
 	})
 
 	return fpMetricsInstance
@@ -244,6 +253,11 @@ func (fm *FpMetrics) IncrementFpTotalFailedRandomness(fpBtcPkHex string) {
 	fm.fpTotalFailedRandomness.WithLabelValues(fpBtcPkHex).Inc()
 }
 
+// RecordFpMissedBlocksInWindow records the number of blocks missed by a finality provider in the missed-blocks window
+func (fm *FpMetrics) RecordFpMissedBlocksInWindow(fpBtcPkHex string, missed int64) {
+	fm.fpMissedBlocksInWindow.WithLabelValues(fpBtcPkHex).Set(float64(missed))
+}
+
 // RecordFpVoteTime records the time of a finality sig vote by a finality provider
 func (fm *FpMetrics) RecordFpVoteTime(fpBtcPkHex string) {
 	fm.mu.Lock()
@@ -0,0 +1,40 @@
+#!/bin/bash
+# Runs the tests of the PKCS#11 EOTS key backend against a throwaway SoftHSM token.
+# Requires softhsm2 (apt install softhsm2, or brew install softhsm on Mac).
+set -o errexit -o nounset -o pipefail
+
+SOFTHSM_LIB=${SOFTHSM_LIB:-}
+if [ -z "$SOFTHSM_LIB" ]; then
+    for lib in /usr/lib/softhsm/libsofthsm2.so \
+        /usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so \
+        /usr/local/lib/softhsm/libsofthsm2.so \
+        /opt/homebrew/lib/softhsm/libsofthsm2.so; do
+        if [ -f "$lib" ]; then
+            SOFTHSM_LIB=$lib
+            break
+        fi
+    done
+fi
+if [ -z "$SOFTHSM_LIB" ]; then
+    echo "libsofthsm2.so is not found; set SOFTHSM_LIB to its path" >&2
+    exit 1
+fi
+
+TOKEN_DIR=$(mktemp -d)
+trap 'rm -rf "$TOKEN_DIR"' EXIT
+
+export SOFTHSM2_CONF="$TOKEN_DIR/softhsm2.conf"
+cat > "$SOFTHSM2_CONF" <<CONF
+directories.tokendir = $TOKEN_DIR
+objectstore.backend = file
+log.level = ERROR
+CONF
+
+export EOTSD_TEST_PKCS11_LIB=$SOFTHSM_LIB
+export EOTSD_TEST_PKCS11_TOKEN=eotsd-test
+export EOTSD_TEST_PKCS11_PIN=1234
+
+softhsm2-util --init-token --free --label "$EOTSD_TEST_PKCS11_TOKEN" \
+    --so-pin 5678 --pin "$EOTSD_TEST_PKCS11_PIN"
+
+go test -v -count=1 ./eotsmanager/pkcs11/...
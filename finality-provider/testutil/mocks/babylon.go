@@ -219,6 +219,22 @@ func (mr *MockClientControllerMockRecorder) QueryFinalityProviderVotingPower(fpP
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFinalityProviderVotingPower", reflect.TypeOf((*MockClientController)(nil).QueryFinalityProviderVotingPower), fpPk, blockHeight)
 }
 
+// QueryJailingParams mocks base method.
+func (m *MockClientController) QueryJailingParams() (int64, float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryJailingParams")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(float64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryJailingParams indicates an expected call of QueryJailingParams.
+func (mr *MockClientControllerMockRecorder) QueryJailingParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryJailingParams", reflect.TypeOf((*MockClientController)(nil).QueryJailingParams))
+}
+
 // QueryLastCommittedPublicRand mocks base method.
 func (m *MockClientController) QueryLastCommittedPublicRand(fpPk *btcec.PublicKey, count uint64) (map[uint64]*types0.PubRandCommitResponse, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +250,21 @@ func (mr *MockClientControllerMockRecorder) QueryLastCommittedPublicRand(fpPk, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLastCommittedPublicRand", reflect.TypeOf((*MockClientController)(nil).QueryLastCommittedPublicRand), fpPk, count)
 }
 
+// QueryLastFinalizedEpoch mocks base method.
+func (m *MockClientController) QueryLastFinalizedEpoch() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryLastFinalizedEpoch")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryLastFinalizedEpoch indicates an expected call of QueryLastFinalizedEpoch.
+func (mr *MockClientControllerMockRecorder) QueryLastFinalizedEpoch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLastFinalizedEpoch", reflect.TypeOf((*MockClientController)(nil).QueryLastFinalizedEpoch))
+}
+
 // QueryLatestFinalizedBlocks mocks base method.
 func (m *MockClientController) QueryLatestFinalizedBlocks(count uint64) ([]*types1.BlockInfo, error) {
 	m.ctrl.T.Helper()
@@ -40,6 +40,8 @@ func PrepareMockedClientController(t *testing.T, r *rand.Rand, startHeight, curr
 	mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
 	mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
 	mockClientController.EXPECT().QueryFinalityActivationBlockHeight().Return(finalityActivationBlkHeight, nil).AnyTimes()
+	mockClientController.EXPECT().QueryLastFinalizedEpoch().Return(uint64(0), nil).AnyTimes()
+	mockClientController.EXPECT().QueryJailingParams().Return(int64(100), 0.5, nil).AnyTimes()
 
 	return mockClientController
 }
@@ -1,7 +1,9 @@
 package btcclient
 
 import (
+	"encoding/json"
 	"fmt"
+
 	notifier "github.com/lightningnetwork/lnd/chainntnfs"
 
 	"github.com/btcsuite/btcd/btcjson"
@@ -140,6 +142,50 @@ func (c *Client) GetRawTransaction(txHash *chainhash.Hash) (*btcutil.Tx, error)
 	return c.Client.GetRawTransaction(txHash)
 }
 
+// MempoolEntry is the size and fee of a tx in the mempool of the node
+type MempoolEntry struct {
+	VSize int64
+	Fee   btcutil.Amount
+}
+
+// mempoolEntryResult is the subset of the verbose getrawmempool entry used by
+// the fee estimation. Recent bitcoind versions only report the fee in fees.base
+type mempoolEntryResult struct {
+	VSize int64 `json:"vsize"`
+	Fees  struct {
+		Base float64 `json:"base"`
+	} `json:"fees"`
+}
+
+// GetMempoolEntries returns the size and fee of all the txs in the mempool of the node
+func (c *Client) GetMempoolEntries() ([]MempoolEntry, error) {
+	verbose, err := json.Marshal(true)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Client.RawRequest("getrawmempool", []json.RawMessage{verbose})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get raw mempool: %w", err)
+	}
+
+	var result map[string]mempoolEntryResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode raw mempool: %w", err)
+	}
+
+	entries := make([]MempoolEntry, 0, len(result))
+	for txid, entry := range result {
+		fee, err := btcutil.NewAmount(entry.Fees.Base)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee of mempool tx %s: %w", txid, err)
+		}
+		entries = append(entries, MempoolEntry{VSize: entry.VSize, Fee: fee})
+	}
+
+	return entries, nil
+}
+
 func notifierStateToWalletState(state notifier.TxConfStatus) TxStatus {
 	switch state {
 	case notifier.TxNotFoundIndex:
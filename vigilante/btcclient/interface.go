@@ -42,4 +42,6 @@ type BTCWallet interface {
 	SignRawTransactionWithWallet(tx *wire.MsgTx) (*wire.MsgTx, bool, error)
 	GetRawTransaction(txHash *chainhash.Hash) (*btcutil.Tx, error)
 	TxDetails(txHash *chainhash.Hash, pkScript []byte) (*notifier.TxConfirmation, TxStatus, error)
+	GetBestBlock() (uint32, error)
+	GetMempoolEntries() ([]MempoolEntry, error)
 }
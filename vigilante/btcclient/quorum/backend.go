@@ -0,0 +1,55 @@
+package quorum
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/babylonlabs-io/vigilante/config"
+)
+
+// Backend is a source of the BTC best chain, queried to cross-validate the BTC node
+// of the reporter
+type Backend interface {
+	// Name identifies the backend in the logs and metrics
+	Name() string
+	// BestHeight returns the height of the tip of the best chain of the backend
+	BestHeight() (uint32, error)
+	// BlockHash returns the hash of the block at the given height of the best chain
+	// of the backend
+	BlockHash(height uint32) (*chainhash.Hash, error)
+	Stop()
+}
+
+// NewBackends creates the backends of the config
+func NewBackends(cfg *config.QuorumConfig) ([]Backend, error) {
+	backends := make([]Backend, 0, len(cfg.Backends))
+	for i := range cfg.Backends {
+		backendCfg := &cfg.Backends[i]
+
+		var (
+			backend Backend
+			err     error
+		)
+		switch backendCfg.Type {
+		case config.BTCBackendRPC:
+			backend, err = NewRPCBackend(backendCfg)
+		case config.BTCBackendEsplora:
+			backend = NewEsploraBackend(backendCfg.Endpoint, cfg.Timeout)
+		case config.BTCBackendElectrum:
+			backend = NewElectrumBackend(backendCfg.Endpoint, backendCfg.TLS, cfg.Timeout)
+		default:
+			err = fmt.Errorf("unsupported backend type %q", backendCfg.Type)
+		}
+		if err != nil {
+			for _, b := range backends {
+				b.Stop()
+			}
+
+			return nil, fmt.Errorf("failed to create backend %d: %w", i, err)
+		}
+		backends = append(backends, backend)
+	}
+
+	return backends, nil
+}
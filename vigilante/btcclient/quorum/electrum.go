@@ -0,0 +1,133 @@
+package quorum
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonlabs-io/vigilante/config"
+)
+
+// maxElectrumResponseSize bounds the size of a response line of an Electrum server
+const maxElectrumResponseSize = 1 << 16
+
+// ElectrumBackend queries the best chain of an Electrum server over its newline
+// delimited JSON-RPC protocol, opening a connection per query
+type ElectrumBackend struct {
+	endpoint string
+	useTLS   bool
+	timeout  time.Duration
+}
+
+type electrumRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type electrumResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func NewElectrumBackend(endpoint string, useTLS bool, timeout time.Duration) *ElectrumBackend {
+	return &ElectrumBackend{
+		endpoint: endpoint,
+		useTLS:   useTLS,
+		timeout:  timeout,
+	}
+}
+
+func (b *ElectrumBackend) Name() string {
+	return config.BTCBackendElectrum + "/" + b.endpoint
+}
+
+func (b *ElectrumBackend) BestHeight() (uint32, error) {
+	var tip struct {
+		Height uint32 `json:"height"`
+	}
+	if err := b.call("blockchain.headers.subscribe", nil, &tip); err != nil {
+		return 0, err
+	}
+
+	return tip.Height, nil
+}
+
+func (b *ElectrumBackend) BlockHash(height uint32) (*chainhash.Hash, error) {
+	var headerHex string
+	if err := b.call("blockchain.block.header", []interface{}{height}, &headerHex); err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := hex.DecodeString(headerHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header hex: %w", err)
+	}
+	if len(headerBytes) != wire.MaxBlockHeaderPayload {
+		return nil, fmt.Errorf("invalid header length %d", len(headerBytes))
+	}
+	hash := chainhash.DoubleHashH(headerBytes)
+
+	return &hash, nil
+}
+
+func (b *ElectrumBackend) Stop() {}
+
+func (b *ElectrumBackend) call(method string, params []interface{}, result interface{}) error {
+	conn, err := b.dial()
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", b.endpoint, err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(b.timeout)); err != nil {
+		return err
+	}
+
+	if params == nil {
+		params = []interface{}{}
+	}
+	req, err := json.Marshal(&electrumRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Write(append(req, '\n')); err != nil {
+		return fmt.Errorf("failed to send %s: %w", method, err)
+	}
+
+	reader := bufio.NewReaderSize(conn, maxElectrumResponseSize)
+	line, err := reader.ReadSlice('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read the response of %s: %w", method, err)
+	}
+
+	var resp electrumResponse
+	if err := json.Unmarshal(line, &resp); err != nil {
+		return fmt.Errorf("invalid response of %s: %w", method, err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s failed with code %d: %s", method, resp.Error.Code, resp.Error.Message)
+	}
+
+	return json.Unmarshal(resp.Result, result)
+}
+
+func (b *ElectrumBackend) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: b.timeout}
+	if b.useTLS {
+		return tls.DialWithDialer(dialer, "tcp", b.endpoint, &tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	return dialer.Dial("tcp", b.endpoint)
+}
@@ -0,0 +1,80 @@
+package quorum
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/babylonlabs-io/vigilante/config"
+)
+
+// maxResponseSize bounds the size of the plain text responses of Esplora
+const maxResponseSize = 1 << 10
+
+// EsploraBackend queries the best chain of an Esplora-style REST API, e.g.
+// https://blockstream.info/api
+type EsploraBackend struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewEsploraBackend(baseURL string, timeout time.Duration) *EsploraBackend {
+	return &EsploraBackend{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (b *EsploraBackend) Name() string {
+	return config.BTCBackendEsplora + "/" + b.baseURL
+}
+
+func (b *EsploraBackend) BestHeight() (uint32, error) {
+	body, err := b.get("/blocks/tip/height")
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := strconv.ParseUint(body, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid tip height %q: %w", body, err)
+	}
+
+	return uint32(height), nil
+}
+
+func (b *EsploraBackend) BlockHash(height uint32) (*chainhash.Hash, error) {
+	body, err := b.get(fmt.Sprintf("/block-height/%d", height))
+	if err != nil {
+		return nil, err
+	}
+
+	return chainhash.NewHashFromStr(body)
+}
+
+func (b *EsploraBackend) Stop() {
+	b.client.CloseIdleConnections()
+}
+
+func (b *EsploraBackend) get(path string) (string, error) {
+	resp, err := b.client.Get(b.baseURL + path)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the response of %s: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s responded with status %d: %s", path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
@@ -0,0 +1,134 @@
+package quorum
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+type Vote int
+
+const (
+	// VoteAgree means the backend has the same block at the height
+	VoteAgree Vote = iota
+	// VoteDiverge means the backend has another block at the height
+	VoteDiverge
+	// VoteLagging means the tip of the backend is below the height
+	VoteLagging
+	// VoteUnreachable means the backend failed to answer in time
+	VoteUnreachable
+)
+
+func (v Vote) String() string {
+	switch v {
+	case VoteAgree:
+		return "agree"
+	case VoteDiverge:
+		return "diverge"
+	case VoteLagging:
+		return "lagging"
+	default:
+		return "unreachable"
+	}
+}
+
+// Result holds the votes of the backends on a block of the BTC node of the reporter
+type Result struct {
+	Votes map[string]Vote
+	// Agreeing is the number of votes for the block, including the one of the BTC node
+	Agreeing int
+	// Total is the number of voters, including the BTC node. The lagging and unreachable
+	// backends are not voters, as they cannot tell about the block
+	Total int
+	// MinVoters is the number of voters, including the BTC node, required for a majority
+	MinVoters int
+}
+
+// HasMajority returns whether there are enough voters and more than half of them
+// agree on the block
+func (r *Result) HasMajority() bool {
+	return r.Total >= r.MinVoters && 2*r.Agreeing > r.Total
+}
+
+// Quorum cross-validates the best chain of the BTC node of the reporter against
+// several backends. Since the hash of a block commits to its ancestors, agreeing on
+// the block at a height means agreeing on the whole chain up to it.
+type Quorum struct {
+	backends  []Backend
+	timeout   time.Duration
+	minVoters int
+}
+
+func New(backends []Backend, timeout time.Duration, minVoters int) *Quorum {
+	return &Quorum{
+		backends:  backends,
+		timeout:   timeout,
+		minVoters: minVoters,
+	}
+}
+
+// Check asks every backend for its block at the height of the given block of the BTC
+// node, in parallel. The backends not answering within the timeout are unreachable.
+// Both the unreachable backends and the ones whose tip is below the height are left
+// out of the total, so that a flaky backend does not count as disagreeing.
+func (q *Quorum) Check(height uint32, hash *chainhash.Hash) *Result {
+	type namedVote struct {
+		name string
+		vote Vote
+	}
+
+	// buffered so that the queries answering after the timeout do not block
+	votesChan := make(chan namedVote, len(q.backends))
+	for _, backend := range q.backends {
+		go func(b Backend) {
+			votesChan <- namedVote{b.Name(), voteOf(b, height, hash)}
+		}(backend)
+	}
+
+	result := &Result{
+		Votes:     make(map[string]Vote, len(q.backends)),
+		Agreeing:  1,
+		Total:     1,
+		MinVoters: q.minVoters,
+	}
+	for _, backend := range q.backends {
+		result.Votes[backend.Name()] = VoteUnreachable
+	}
+
+	timeout := time.After(q.timeout)
+	for received := 0; received < len(q.backends); received++ {
+		select {
+		case v := <-votesChan:
+			result.Votes[v.name] = v.vote
+			switch v.vote {
+			case VoteAgree:
+				result.Agreeing++
+				result.Total++
+			case VoteDiverge:
+				result.Total++
+			}
+		case <-timeout:
+			return result
+		}
+	}
+
+	return result
+}
+
+func voteOf(b Backend, height uint32, hash *chainhash.Hash) Vote {
+	backendHash, err := b.BlockHash(height)
+	if err != nil {
+		// the block may be missing as the backend is behind
+		if bestHeight, err := b.BestHeight(); err == nil && bestHeight < height {
+			return VoteLagging
+		}
+
+		return VoteUnreachable
+	}
+
+	if !backendHash.IsEqual(hash) {
+		return VoteDiverge
+	}
+
+	return VoteAgree
+}
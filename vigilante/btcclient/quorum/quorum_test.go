@@ -0,0 +1,238 @@
+package quorum_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bbndatagen "github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/vigilante/btcclient/quorum"
+)
+
+// fakeBackend serves a fixed chain of block hashes
+type fakeBackend struct {
+	name   string
+	chain  []chainhash.Hash
+	delay  time.Duration
+	broken bool
+}
+
+func (b *fakeBackend) Name() string { return b.name }
+
+func (b *fakeBackend) BestHeight() (uint32, error) {
+	if b.broken {
+		return 0, errors.New("unreachable")
+	}
+
+	return uint32(len(b.chain) - 1), nil
+}
+
+func (b *fakeBackend) BlockHash(height uint32) (*chainhash.Hash, error) {
+	time.Sleep(b.delay)
+	if b.broken {
+		return nil, errors.New("unreachable")
+	}
+	if int(height) >= len(b.chain) {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+
+	return &b.chain[height], nil
+}
+
+func (b *fakeBackend) Stop() {}
+
+func randomChain(r *rand.Rand, length int) []chainhash.Hash {
+	chain := make([]chainhash.Hash, length)
+	for i := range chain {
+		chain[i] = chainhash.Hash(bbndatagen.GenRandomByteArray(r, chainhash.HashSize))
+	}
+
+	return chain
+}
+
+func FuzzQuorumCheck(f *testing.F) {
+	bbndatagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+
+		chain := randomChain(r, r.Intn(50)+10)
+		height := uint32(len(chain) - 1)
+		fork := append(append([]chainhash.Hash{}, chain[:height]...), randomChain(r, 1)...)
+
+		var (
+			backends []quorum.Backend
+			expected = map[string]quorum.Vote{}
+			agreeing = 1
+			total    = 1
+		)
+		numBackends := r.Intn(6) + 1
+		for i := 0; i < numBackends; i++ {
+			b := &fakeBackend{name: fmt.Sprintf("backend-%d", i)}
+			vote := quorum.Vote(r.Intn(4))
+			switch vote {
+			case quorum.VoteAgree:
+				b.chain = chain
+				agreeing++
+			case quorum.VoteDiverge:
+				b.chain = fork
+			case quorum.VoteLagging:
+				b.chain = chain[:height]
+			case quorum.VoteUnreachable:
+				b.broken = true
+			}
+			if vote == quorum.VoteAgree || vote == quorum.VoteDiverge {
+				total++
+			}
+			backends = append(backends, b)
+			expected[b.name] = vote
+		}
+
+		minVoters := r.Intn(numBackends+1) + 1
+		result := quorum.New(backends, time.Second, minVoters).Check(height, &chain[height])
+		require.Equal(t, expected, result.Votes)
+		require.Equal(t, agreeing, result.Agreeing)
+		// the lagging and unreachable backends do not count against the majority
+		require.Equal(t, total, result.Total)
+		require.Equal(t, total >= minVoters && 2*agreeing > total, result.HasMajority())
+	})
+}
+
+func TestQuorumTimeout(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	chain := randomChain(r, 10)
+	backends := []quorum.Backend{
+		&fakeBackend{name: "fast", chain: chain},
+		&fakeBackend{name: "slow", chain: chain, delay: time.Second},
+	}
+
+	result := quorum.New(backends, 100*time.Millisecond, 2).Check(9, &chain[9])
+	require.Equal(t, quorum.VoteAgree, result.Votes["fast"])
+	require.Equal(t, quorum.VoteUnreachable, result.Votes["slow"])
+	require.Equal(t, 2, result.Agreeing)
+	require.Equal(t, 2, result.Total)
+	require.True(t, result.HasMajority())
+
+	// the BTC node alone is not enough voters
+	result = quorum.New(backends[1:], 100*time.Millisecond, 2).Check(9, &chain[9])
+	require.Equal(t, 1, result.Total)
+	require.False(t, result.HasMajority())
+}
+
+func TestEsploraBackend(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	chain := randomChain(r, 10)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/blocks/tip/height", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, "%d", len(chain)-1)
+	})
+	mux.HandleFunc("/api/block-height/", func(w http.ResponseWriter, req *http.Request) {
+		var height int
+		if _, err := fmt.Sscanf(req.URL.Path, "/api/block-height/%d", &height); err != nil || height >= len(chain) {
+			http.Error(w, "Block not found", http.StatusNotFound)
+
+			return
+		}
+		fmt.Fprint(w, chain[height].String())
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	backend := quorum.NewEsploraBackend(server.URL+"/api/", time.Second)
+	best, err := backend.BestHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint32(9), best)
+
+	hash, err := backend.BlockHash(5)
+	require.NoError(t, err)
+	require.Equal(t, chain[5], *hash)
+
+	_, err = backend.BlockHash(10)
+	require.ErrorContains(t, err, "404")
+}
+
+func TestElectrumBackend(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	headers := make([]wire.BlockHeader, 10)
+	for i := range headers {
+		headers[i].Version = 2
+		headers[i].Nonce = r.Uint32()
+		headers[i].Timestamp = time.Unix(r.Int63n(1<<31), 0)
+	}
+	headerHex := func(i int) string {
+		var buf bytes.Buffer
+		require.NoError(t, headers[i].Serialize(&buf))
+
+		return hex.EncodeToString(buf.Bytes())
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				defer c.Close()
+				var req struct {
+					ID     int           `json:"id"`
+					Method string        `json:"method"`
+					Params []json.Number `json:"params"`
+				}
+				line, err := bufio.NewReader(c).ReadBytes('\n')
+				if err != nil || json.Unmarshal(line, &req) != nil {
+					return
+				}
+				resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+				switch req.Method {
+				case "blockchain.headers.subscribe":
+					resp["result"] = map[string]interface{}{"height": len(headers) - 1, "hex": headerHex(len(headers) - 1)}
+				case "blockchain.block.header":
+					height, _ := req.Params[0].Int64()
+					if int(height) >= len(headers) {
+						resp["error"] = map[string]interface{}{"code": 1, "message": "height out of range"}
+					} else {
+						resp["result"] = headerHex(int(height))
+					}
+				}
+				out, _ := json.Marshal(resp)
+				_, _ = c.Write(append(out, '\n'))
+			}(conn)
+		}
+	}()
+
+	backend := quorum.NewElectrumBackend(lis.Addr().String(), false, time.Second)
+	best, err := backend.BestHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint32(9), best)
+
+	hash, err := backend.BlockHash(3)
+	require.NoError(t, err)
+	require.Equal(t, headers[3].BlockHash(), *hash)
+
+	_, err = backend.BlockHash(10)
+	require.ErrorContains(t, err, "height out of range")
+}
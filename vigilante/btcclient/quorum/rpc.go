@@ -0,0 +1,56 @@
+package quorum
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+
+	"github.com/babylonlabs-io/vigilante/config"
+)
+
+// RPCBackend queries the best chain of another bitcoind over RPC
+type RPCBackend struct {
+	endpoint string
+	client   *rpcclient.Client
+}
+
+func NewRPCBackend(cfg *config.BTCBackendConfig) (*RPCBackend, error) {
+	client, err := rpcclient.New(&rpcclient.ConnConfig{
+		Host:         cfg.Endpoint,
+		HTTPPostMode: true,
+		User:         cfg.Username,
+		Pass:         cfg.Password,
+		DisableTLS:   !cfg.TLS,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rpc client to %s: %w", cfg.Endpoint, err)
+	}
+
+	return &RPCBackend{endpoint: cfg.Endpoint, client: client}, nil
+}
+
+func (b *RPCBackend) Name() string {
+	return config.BTCBackendRPC + "/" + b.endpoint
+}
+
+func (b *RPCBackend) BestHeight() (uint32, error) {
+	height, err := b.client.GetBlockCount()
+	if err != nil {
+		return 0, err
+	}
+	if height < 0 || height > math.MaxUint32 {
+		return 0, fmt.Errorf("height %d is out of uint32 range", height)
+	}
+
+	return uint32(height), nil
+}
+
+func (b *RPCBackend) BlockHash(height uint32) (*chainhash.Hash, error) {
+	return b.client.GetBlockHash(int64(height))
+}
+
+func (b *RPCBackend) Stop() {
+	b.client.Shutdown()
+}
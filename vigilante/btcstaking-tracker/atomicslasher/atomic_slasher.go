@@ -2,11 +2,14 @@ package atomicslasher
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/avast/retry-go/v4"
 	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -26,11 +29,13 @@ type AtomicSlasher struct {
 	btcClient   btcclient.BTCClient
 	btcNotifier notifier.ChainNotifier
 	bbnAdapter  *BabylonAdapter
+	store       *store.BTCStakingTrackerStore
 
 	// config parameters
 	cfg               *config.BTCStakingTrackerConfig
 	retrySleepTime    time.Duration
 	maxRetrySleepTime time.Duration
+	maxRetryTimes     uint
 
 	// system states
 	btcTipHeight    atomic.Uint32
@@ -52,6 +57,7 @@ func New(
 	btcNotifier notifier.ChainNotifier,
 	bbnClient BabylonClient,
 	slashedFPSKChan chan *btcec.PrivateKey,
+	trackerStore *store.BTCStakingTrackerStore,
 	metrics *metrics.AtomicSlasherMetrics,
 ) *AtomicSlasher {
 	logger := parentLogger.With(zap.String("module", "atomic_slasher"))
@@ -62,25 +68,39 @@ func New(
 		cfg:               cfg,
 		retrySleepTime:    retrySleepTime,
 		maxRetrySleepTime: maxRetrySleepTime,
+		maxRetryTimes:     maxRetryTimes,
 		logger:            logger,
 		btcClient:         btcClient,
 		btcNotifier:       btcNotifier,
 		bbnAdapter:        bbnAdapter,
+		store:             trackerStore,
 		btcDelIndex:       NewBTCDelegationIndex(),
 		slashingTxChan:    make(chan *SlashingTxInfo, 100), // TODO: parameterise
 		slashedFPSKChan:   slashedFPSKChan,
 		metrics:           metrics,
 	}
-
-	// TODO: initialisation that slashes all culpable finality providers since
-	// the BTC height that the atomic slasher shuts down
 }
 
+// Start restores the tracked BTC delegations from the store, tracks the ones
+// created while the atomic slasher was down, and starts the routines. The slashing
+// txs included in the BTC blocks since the last processed height are found by
+// slashingTxTracker upon the first block notification, so the delegations have to
+// be tracked before, or the processed height would move past their slashing txs.
 func (as *AtomicSlasher) Start() error {
 	var startErr error
 	as.startOnce.Do(func() {
 		as.logger.Info("starting atomic slasher")
 
+		if startErr = as.loadTrackedDelegations(); startErr != nil {
+			return
+		}
+
+		if err := as.trackAllBTCDelegationsWithRetry(); err != nil {
+			startErr = fmt.Errorf("failed to track the BTC delegations: %w", err)
+
+			return
+		}
+
 		as.wg.Add(3)
 		go as.slashingTxTracker()
 		go as.btcDelegationTracker()
@@ -97,7 +117,6 @@ func (as *AtomicSlasher) Stop() error {
 	as.stopOnce.Do(func() {
 		as.logger.Info("stopping atomic slasher")
 		close(as.quit)
-		close(as.slashingTxChan)
 		as.wg.Wait()
 		as.logger.Info("stopping atomic slasher")
 	})
@@ -105,6 +124,49 @@ func (as *AtomicSlasher) Stop() error {
 	return stopErr
 }
 
+// NumTrackedDelegations returns the number of BTC delegations whose slashing txs
+// are watched for selective slashing
+func (as *AtomicSlasher) NumTrackedDelegations() int {
+	return as.btcDelIndex.Size()
+}
+
+// loadTrackedDelegations restores the BTC delegation index from the store
+func (as *AtomicSlasher) loadTrackedDelegations() error {
+	dels, err := as.store.TrackedDelegations()
+	if err != nil {
+		return fmt.Errorf("failed to load the tracked BTC delegations: %w", err)
+	}
+
+	for _, del := range dels {
+		if as.btcDelIndex.Add((*TrackedDelegation)(del)) {
+			as.metrics.TrackedBTCDelegationsGauge.Inc()
+		}
+	}
+	as.logger.Info("restored the tracked BTC delegations", zap.Int("num_delegations", len(dels)))
+
+	return nil
+}
+
+// trackAllBTCDelegationsWithRetry tracks the BTC delegations with backoff, so that
+// an unavailable Babylon node upon startup does not fail the atomic slasher
+func (as *AtomicSlasher) trackAllBTCDelegationsWithRetry() error {
+	ctx, cancel := as.quitContext()
+	defer cancel()
+
+	return retry.Do(
+		as.trackAllBTCDelegations,
+		retry.Context(ctx),
+		retry.Delay(as.retrySleepTime),
+		retry.MaxDelay(as.maxRetrySleepTime),
+		retry.Attempts(as.maxRetryTimes),
+		retry.LastErrorOnly(true),
+		retry.OnRetry(func(n uint, err error) {
+			as.logger.Warn("failed to track the BTC delegations, retrying",
+				zap.Uint("attempt", n+1), zap.Uint("max_attempts", as.maxRetryTimes), zap.Error(err))
+		}),
+	)
+}
+
 func (as *AtomicSlasher) quitContext() (context.Context, func()) {
 	ctx, cancel := context.WithCancel(context.Background())
 	as.wg.Add(1)
@@ -6,7 +6,11 @@ import (
 
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
 )
 
 // btcDelegationTracker is a routine that periodically polls BTC delegations
@@ -20,17 +24,7 @@ func (as *AtomicSlasher) btcDelegationTracker() {
 	for {
 		select {
 		case <-ticker.C:
-			err := as.bbnAdapter.HandleAllBTCDelegations(func(btcDel *bstypes.BTCDelegationResponse) error {
-				trackedDel, err := NewTrackedBTCDelegation(btcDel)
-				if err != nil {
-					return err
-				}
-				as.btcDelIndex.Add(trackedDel)
-				as.metrics.TrackedBTCDelegationsGauge.Inc()
-
-				return nil
-			})
-			if err != nil {
+			if err := as.trackAllBTCDelegations(); err != nil {
 				as.logger.Error("failed to handle all BTC delegations", zap.Error(err))
 			}
 		case <-as.quit:
@@ -39,11 +33,61 @@ func (as *AtomicSlasher) btcDelegationTracker() {
 	}
 }
 
+// trackAllBTCDelegations adds the BTC delegations not tracked yet to the store in a
+// single transaction, and then to the BTC delegation index
+func (as *AtomicSlasher) trackAllBTCDelegations() error {
+	var newDels []*TrackedDelegation
+	err := as.bbnAdapter.HandleAllBTCDelegations(func(btcDel *bstypes.BTCDelegationResponse) error {
+		trackedDel, err := NewTrackedBTCDelegation(btcDel)
+		if err != nil {
+			return err
+		}
+		if as.btcDelIndex.Get(trackedDel.StakingTxHash) != nil {
+			return nil
+		}
+		newDels = append(newDels, trackedDel)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(newDels) == 0 {
+		return nil
+	}
+
+	// the delegations are stored before being indexed, so that a failed write is
+	// retried rather than leaving delegations indexed but lost upon restart
+	storeDels := make([]*store.TrackedDelegation, len(newDels))
+	for i, del := range newDels {
+		storeDels[i] = (*store.TrackedDelegation)(del)
+	}
+	if err := as.store.PutTrackedDelegations(storeDels); err != nil {
+		return fmt.Errorf("failed to store %d new BTC delegations: %w", len(newDels), err)
+	}
+
+	for _, del := range newDels {
+		if as.btcDelIndex.Add(del) {
+			as.metrics.TrackedBTCDelegationsGauge.Inc()
+		}
+	}
+
+	return nil
+}
+
 // slashingTxTracker is a routine that keeps tracking new BTC blocks and
-// filtering out slashing tx and unbonding slashing tx
+// filtering out slashing tx and unbonding slashing tx. Upon each new block, it
+// scans the blocks since the last processed height as well, so that the slashing
+// txs included while the atomic slasher was down are not missed.
 func (as *AtomicSlasher) slashingTxTracker() {
 	defer as.wg.Done()
 
+	// resume the slashing txs that were found but not handled before the restart
+	if !as.enqueuePendingReports() {
+		return
+	}
+
 	blockNotifier, err := as.btcNotifier.RegisterBlockEpochNtfn(nil)
 	if err != nil {
 		as.logger.Error("failed to register block notifier", zap.Error(err))
@@ -64,39 +108,119 @@ func (as *AtomicSlasher) slashingTxTracker() {
 			if blockEpoch.Height < 0 {
 				panic(fmt.Errorf("received negative block height: %d", blockEpoch.Height))
 			}
-			as.btcTipHeight.Store(uint32(blockEpoch.Height))
+			height := uint32(blockEpoch.Height)
+			as.btcTipHeight.Store(height)
 			as.logger.Debug("Received new best btc block", zap.Int32("height", blockEpoch.Height))
-			// get full BTC block
-			// TODO: ensure the tx witness is retrieved as well
-			_, block, err := as.btcClient.GetBlockByHash(blockEpoch.Hash)
-			if err != nil {
+
+			if err := as.catchUp(height, blockEpoch.Hash); err != nil {
 				as.logger.Error(
-					"failed to get block by hash",
-					zap.String("block_hash", blockEpoch.Hash.String()),
+					"failed to process BTC blocks",
+					zap.Uint32("tip_height", height),
 					zap.Error(err),
 				)
+			}
+		case <-as.quit:
+			return
+		}
+	}
+}
 
-				continue
+// catchUp processes the BTC blocks after the last processed height up to the
+// given tip. The tip is retrieved by hash in case of a reorg, and the slashing
+// txs found again in the new blocks are skipped by the store.
+func (as *AtomicSlasher) catchUp(tipHeight uint32, tipHash *chainhash.Hash) error {
+	lastHeight, exists, err := as.store.LastProcessedHeight()
+	if err != nil {
+		return fmt.Errorf("failed to get the last processed BTC height: %w", err)
+	}
+
+	// on the first start, there is no block to catch up with
+	if exists && lastHeight+1 < tipHeight {
+		as.logger.Info(
+			"scanning the BTC blocks since the last processed height",
+			zap.Uint32("from_height", lastHeight+1),
+			zap.Uint32("to_height", tipHeight-1),
+		)
+		for height := lastHeight + 1; height < tipHeight; height++ {
+			_, block, err := as.btcClient.GetBlockByHeight(height)
+			if err != nil {
+				return fmt.Errorf("failed to get block at height %d: %w", height, err)
 			}
-			// filter out slashing tx / unbonding slashing tx, and
-			// enqueue them to the slashed BTC delegation channel
-			for _, tx := range block.Transactions {
-				txHash := tx.TxHash()
-				trackedBTCDel, slashingPath := as.btcDelIndex.FindSlashedBTCDelegation(txHash)
-				if trackedBTCDel != nil {
-					// this tx is slashing tx
-					slashingTxInfo := NewSlashingTxInfo(slashingPath, trackedBTCDel.StakingTxHash, tx)
-					as.slashingTxChan <- slashingTxInfo
-				}
+			if err := as.processBlock(height, block); err != nil {
+				return err
 			}
+		}
+	}
+
+	// get full BTC block
+	// TODO: ensure the tx witness is retrieved as well
+	_, block, err := as.btcClient.GetBlockByHash(tipHash)
+	if err != nil {
+		return fmt.Errorf("failed to get block by hash %s: %w", tipHash, err)
+	}
+
+	return as.processBlock(tipHeight, block)
+}
+
+// processBlock filters out the slashing txs / unbonding slashing txs of the given
+// block, records them in the store along with the height, and enqueues the ones
+// not seen before to the slashed BTC delegation channel
+func (as *AtomicSlasher) processBlock(height uint32, block *wire.MsgBlock) error {
+	var reports []*store.SlashingReport
+	for _, tx := range block.Transactions {
+		txHash := tx.TxHash()
+		trackedBTCDel, slashingPath := as.btcDelIndex.FindSlashedBTCDelegation(txHash)
+		if trackedBTCDel != nil {
+			// this tx is slashing tx
+			slashingTxInfo := NewSlashingTxInfo(slashingPath, trackedBTCDel.StakingTxHash, tx)
+			reports = append(reports, slashingTxInfo.toSlashingReport(height))
+		}
+	}
+
+	newReports, err := as.store.PutProcessedBlock(height, reports)
+	if err != nil {
+		return fmt.Errorf("failed to store the slashing txs at height %d: %w", height, err)
+	}
+
+	for _, report := range newReports {
+		select {
+		case as.slashingTxChan <- newSlashingTxInfoFromReport(report):
 		case <-as.quit:
-			return
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// enqueuePendingReports enqueues the slashing txs in the store that were not
+// handled yet. It returns false if the atomic slasher is stopped meanwhile.
+func (as *AtomicSlasher) enqueuePendingReports() bool {
+	reports, err := as.store.PendingReports()
+	if err != nil {
+		as.logger.Error("failed to get the pending slashing txs", zap.Error(err))
+
+		return true
+	}
+
+	if len(reports) > 0 {
+		as.logger.Info("resuming the pending slashing txs", zap.Int("num_slashing_txs", len(reports)))
+	}
+	for _, report := range reports {
+		select {
+		case as.slashingTxChan <- newSlashingTxInfoFromReport(report):
+		case <-as.quit:
+			return false
 		}
 	}
+
+	return true
 }
 
 // selectiveSlashingReporter is a routine that reports finality providers who
-// launch selective slashing to Babylon and slashing enforcer routine
+// launch selective slashing to Babylon and slashing enforcer routine. A slashing
+// tx stays pending in the store until it is handled, so that it is retried upon
+// restart if querying Babylon fails.
 func (as *AtomicSlasher) selectiveSlashingReporter() {
 	defer as.wg.Done()
 
@@ -148,6 +272,7 @@ func (as *AtomicSlasher) selectiveSlashingReporter() {
 					zap.String("staking_tx_hash", stakingTxHashStr),
 					zap.Error(err),
 				)
+				as.resolveSlashingTx(slashingTxInfo)
 
 				continue
 			}
@@ -173,6 +298,7 @@ func (as *AtomicSlasher) selectiveSlashingReporter() {
 					zap.String("fp_pk", fpPK.MarshalHex()),
 					zap.Error(err),
 				)
+				as.resolveSlashingTx(slashingTxInfo)
 
 				continue
 			}
@@ -194,22 +320,32 @@ func (as *AtomicSlasher) selectiveSlashingReporter() {
 					zap.String("staking_tx_hash", stakingTxHashStr),
 					zap.Error(err),
 				)
+				as.resolveSlashingTx(slashingTxInfo)
 
 				continue
 			}
 
-			// report selective slashing to Babylon
-			ctx, cancel = as.quitContext()
-			if err := as.bbnAdapter.ReportSelectiveSlashing(ctx, stakingTxHashStr, fpSK); err != nil {
-				// TODO: this implies that all signed covenant members collude with
-				// the finality provider. Decide what to do in this case
-				as.logger.Error(
-					"failed to report a selective slashing finality provider",
+			// report selective slashing to Babylon, unless in dry-run mode where
+			// only the slashing txs of the BTC slasher are verified
+			if as.cfg.DryRun {
+				as.logger.Info(
+					"dry run: skip reporting a selective slashing finality provider",
 					zap.String("staking_tx_hash", stakingTxHashStr),
-					zap.Error(err),
+					zap.String("fp_pk", fpPK.MarshalHex()),
 				)
+			} else {
+				ctx, cancel = as.quitContext()
+				if err := as.bbnAdapter.ReportSelectiveSlashing(ctx, stakingTxHashStr, fpSK); err != nil {
+					// TODO: this implies that all signed covenant members collude with
+					// the finality provider. Decide what to do in this case
+					as.logger.Error(
+						"failed to report a selective slashing finality provider",
+						zap.String("staking_tx_hash", stakingTxHashStr),
+						zap.Error(err),
+					)
+				}
+				cancel()
 			}
-			cancel()
 
 			// report to slashing enforcer routine who will slash all
 			// the BTC delegations under this finality provider
@@ -218,9 +354,29 @@ func (as *AtomicSlasher) selectiveSlashingReporter() {
 			// stop tracking the delegations under this finality provider
 			as.btcDelIndex.Remove(stakingTxHash)
 			as.metrics.TrackedBTCDelegationsGauge.Dec()
+			if err := as.store.DeleteTrackedDelegation(stakingTxHash); err != nil {
+				as.logger.Error(
+					"failed to delete the tracked BTC delegation",
+					zap.String("staking_tx_hash", stakingTxHashStr),
+					zap.Error(err),
+				)
+			}
+			as.resolveSlashingTx(slashingTxInfo)
 
 		case <-as.quit:
 			return
 		}
 	}
 }
+
+// resolveSlashingTx removes the handled slashing tx from the pending ones
+func (as *AtomicSlasher) resolveSlashingTx(slashingTxInfo *SlashingTxInfo) {
+	slashingTxHash := slashingTxInfo.SlashingMsgTx.TxHash()
+	if err := as.store.DeletePendingReport(slashingTxHash); err != nil {
+		as.logger.Error(
+			"failed to delete the pending slashing tx",
+			zap.String("slashing_tx_hash", slashingTxHash.String()),
+			zap.Error(err),
+		)
+	}
+}
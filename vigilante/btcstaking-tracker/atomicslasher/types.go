@@ -10,6 +10,8 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
 )
 
 type SlashingPath int
@@ -37,6 +39,21 @@ func (s *SlashingTxInfo) IsSlashStakingTx() bool {
 	return s.path == slashStakingTx
 }
 
+// toSlashingReport converts the slashing tx found in the BTC block at the given
+// height to its stored form
+func (s *SlashingTxInfo) toSlashingReport(btcHeight uint32) *store.SlashingReport {
+	return &store.SlashingReport{
+		Path:          store.SlashingPath(s.path), // #nosec G115 -- the path is either 0 or 1
+		StakingTxHash: s.StakingTxHash,
+		SlashingTx:    s.SlashingMsgTx,
+		BTCHeight:     btcHeight,
+	}
+}
+
+func newSlashingTxInfoFromReport(report *store.SlashingReport) *SlashingTxInfo {
+	return NewSlashingTxInfo(SlashingPath(report.Path), report.StakingTxHash, report.SlashingTx)
+}
+
 type TrackedDelegation struct {
 	StakingTxHash           chainhash.Hash
 	SlashingTxHash          chainhash.Hash
@@ -96,6 +113,14 @@ func NewBTCDelegationIndex() *BTCDelegationIndex {
 	}
 }
 
+// Size returns the number of tracked BTC delegations
+func (bdi *BTCDelegationIndex) Size() int {
+	bdi.Lock()
+	defer bdi.Unlock()
+
+	return len(bdi.delMap)
+}
+
 // Get returns the tracked delegation for the given staking tx hash or nil if not found.
 func (bdi *BTCDelegationIndex) Get(stakingTxHash chainhash.Hash) *TrackedDelegation {
 	bdi.Lock()
@@ -109,13 +134,14 @@ func (bdi *BTCDelegationIndex) Get(stakingTxHash chainhash.Hash) *TrackedDelegat
 	return del
 }
 
-func (bdi *BTCDelegationIndex) Add(trackedDel *TrackedDelegation) {
+// Add tracks the given BTC delegation and returns whether it was not known yet
+func (bdi *BTCDelegationIndex) Add(trackedDel *TrackedDelegation) bool {
 	bdi.Lock()
 	defer bdi.Unlock()
 
 	// ensure the BTC delegation is not known yet
 	if _, ok := bdi.delMap[trackedDel.StakingTxHash]; ok {
-		return
+		return false
 	}
 
 	// at this point, the BTC delegation is not known, add it
@@ -123,6 +149,8 @@ func (bdi *BTCDelegationIndex) Add(trackedDel *TrackedDelegation) {
 	// track slashing tx and unbonding slashing tx
 	bdi.slashingTxMap[trackedDel.SlashingTxHash] = trackedDel.StakingTxHash
 	bdi.unbondingSlashingTxMap[trackedDel.UnbondingSlashingTxHash] = trackedDel.StakingTxHash
+
+	return true
 }
 
 func (bdi *BTCDelegationIndex) Remove(stakingTxHash chainhash.Hash) {
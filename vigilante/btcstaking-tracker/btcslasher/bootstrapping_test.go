@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -14,8 +15,10 @@ import (
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
 	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/btcslasher"
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
+	"github.com/babylonlabs-io/vigilante/testutil"
 	"github.com/babylonlabs-io/vigilante/testutil/mocks"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -63,6 +66,8 @@ func FuzzSlasher_Bootstrapping(f *testing.F) {
 		logger, err := config.NewRootLogger("auto", "debug")
 		require.NoError(t, err)
 		slashedFPSKChan := make(chan *btcec.PrivateKey, 100)
+		ledger, err := store.NewBTCStakingTrackerStore(testutil.MakeTestBackend(t))
+		require.NoError(t, err)
 		btcSlasher, err := btcslasher.New(
 			logger,
 			mockBTCClient,
@@ -73,6 +78,9 @@ func FuzzSlasher_Bootstrapping(f *testing.F) {
 			commonCfg.MaxRetryTimes,
 			config.MaxSlashingConcurrency,
 			slashedFPSKChan,
+			nil,
+			ledger,
+			time.Minute,
 			metrics.NewBTCStakingTrackerMetrics().SlasherMetrics,
 		)
 		require.NoError(t, err)
@@ -196,6 +204,8 @@ func FuzzSlasher_Bootstrapping(f *testing.F) {
 			Return(&chainhash.Hash{}, nil).
 			Times((len(slashableBTCDelsList) + len(unslashableBTCDelsList)) * 2)
 
+		mockBTCClient.EXPECT().GetBestBlock().Return(uint32(1000), nil).AnyTimes()
+
 		err = btcSlasher.Bootstrap(0)
 		require.NoError(t, err)
 
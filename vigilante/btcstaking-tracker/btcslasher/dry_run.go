@@ -0,0 +1,194 @@
+package btcslasher
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// DryRunRecord is a slashing tx that was built and verified by the BTC slasher
+// in dry-run mode, instead of being broadcast
+type DryRunRecord struct {
+	Time             time.Time `json:"time"`
+	FpBtcPk          string    `json:"fp_btc_pk"`
+	DelBtcPk         string    `json:"del_btc_pk"`
+	StakingTxHash    string    `json:"staking_tx_hash"`
+	Path             string    `json:"path"` // staking or unbonding
+	SlashingTxHash   string    `json:"slashing_tx_hash"`
+	SlashingTxHex    string    `json:"slashing_tx_hex,omitempty"` // empty if the witness cannot be built
+	Fee              int64     `json:"fee"`                       // in satoshis
+	VSize            int64     `json:"vsize"`
+	FeeRate          float64   `json:"fee_rate"` // in sat/vB
+	AlreadySubmitted bool      `json:"already_submitted"`
+	Spendable        bool      `json:"spendable"` // whether the staking/unbonding output is unspent
+	WitnessValid     bool      `json:"witness_valid"`
+	Error            string    `json:"error,omitempty"`
+}
+
+// DryRunReport appends the slashing txs of the dry-run mode to a file as JSON lines
+type DryRunReport struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewDryRunReport creates the report at the given path, appending to the records
+// of the previous runs if it exists
+func NewDryRunReport(path string) (*DryRunReport, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the dry-run report: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	return &DryRunReport{path: path}, nil
+}
+
+func (r *DryRunReport) Write(record *DryRunRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open the dry-run report: %w", err)
+	}
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("failed to write the dry-run report: %w", err)
+	}
+
+	return f.Close()
+}
+
+func (bs *BTCSlasher) isDryRun() bool {
+	return bs.dryRunReport != nil
+}
+
+// reportDryRun verifies the slashing tx built with the given error, and writes
+// it to the dry-run report instead of broadcasting it
+func (bs *BTCSlasher) reportDryRun(
+	record *DryRunRecord,
+	fundingOutput *wire.TxOut,
+	slashTx *bstypes.BTCSlashingTx,
+	slashingMsgTxWithWitness *wire.MsgTx,
+	buildErr error,
+) (*chainhash.Hash, error) {
+	unsignedTx, err := slashTx.ToMsgTx()
+	if err != nil {
+		return nil, err
+	}
+	fillDryRunRecord(record, fundingOutput, unsignedTx, slashingMsgTxWithWitness, buildErr)
+
+	if err := bs.dryRunReport.Write(record); err != nil {
+		return nil, err
+	}
+	if buildErr != nil {
+		return nil, buildErr
+	}
+	if !record.WitnessValid {
+		return nil, fmt.Errorf("slashing tx %s of BTC delegation %s under finality provider %s: %s",
+			record.SlashingTxHash, record.DelBtcPk, record.FpBtcPk, record.Error)
+	}
+
+	bs.logger.Infof(
+		"dry run: built and verified slashing tx (txHash: %s) for BTC delegation %s under finality provider %s",
+		record.SlashingTxHash,
+		record.DelBtcPk,
+		record.FpBtcPk,
+	)
+	txHash := unsignedTx.TxHash()
+
+	return &txHash, nil
+}
+
+// VerifySlashingTxWitness executes the script of the staking/unbonding output
+// spent by the slashing tx against the witness of the slashing tx
+func VerifySlashingTxWitness(fundingOutput *wire.TxOut, slashingTx *wire.MsgTx) error {
+	prevOutputFetcher := txscript.NewCannedPrevOutputFetcher(fundingOutput.PkScript, fundingOutput.Value)
+	engine, err := txscript.NewEngine(
+		fundingOutput.PkScript,
+		slashingTx,
+		0,
+		txscript.StandardVerifyFlags,
+		nil,
+		txscript.NewTxSigHashes(slashingTx, prevOutputFetcher),
+		fundingOutput.Value,
+		prevOutputFetcher,
+	)
+	if err != nil {
+		return err
+	}
+
+	return engine.Execute()
+}
+
+func stakingTxHashFromHex(stakingTxHex string) string {
+	stakingTx, _, err := bbn.NewBTCTxFromHex(stakingTxHex)
+	if err != nil {
+		return ""
+	}
+
+	return stakingTx.TxHash().String()
+}
+
+// slashingTxFee returns the fee paid by the slashing tx spending the given output
+func slashingTxFee(fundingOutput *wire.TxOut, slashingTx *wire.MsgTx) int64 {
+	fee := fundingOutput.Value
+	for _, out := range slashingTx.TxOut {
+		fee -= out.Value
+	}
+
+	return fee
+}
+
+// fillDryRunRecord fills the fee and the witness validity of the record from the
+// slashing tx with witness, which is nil if the witness cannot be built
+func fillDryRunRecord(
+	record *DryRunRecord,
+	fundingOutput *wire.TxOut,
+	unsignedTx *wire.MsgTx,
+	slashingTx *wire.MsgTx,
+	buildErr error,
+) {
+	record.Time = time.Now()
+	record.Fee = slashingTxFee(fundingOutput, unsignedTx)
+
+	if buildErr != nil {
+		record.Error = buildErr.Error()
+
+		return
+	}
+
+	record.VSize = mempool.GetTxVirtualSize(btcutil.NewTx(slashingTx))
+	if record.VSize > 0 {
+		record.FeeRate = float64(record.Fee) / float64(record.VSize)
+	}
+	if txBytes, err := bbn.SerializeBTCTx(slashingTx); err == nil {
+		record.SlashingTxHex = hex.EncodeToString(txBytes)
+	}
+
+	if err := VerifySlashingTxWitness(fundingOutput, slashingTx); err != nil {
+		record.Error = fmt.Sprintf("invalid witness: %v", err)
+	} else {
+		record.WitnessValid = true
+	}
+}
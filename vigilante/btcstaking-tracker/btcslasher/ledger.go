@@ -0,0 +1,248 @@
+package btcslasher
+
+import (
+	"fmt"
+	"time"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// recordSlashingAttempt merges the outcome of an attempt to slash a BTC
+// delegation via one of its slashing paths into the slashing ledger
+func (bs *BTCSlasher) recordSlashingAttempt(attempt *store.SlashingRecord, attemptErr error) {
+	record, found, err := bs.ledger.SlashingRecord(attempt.SlashingTxHash)
+	if err != nil {
+		bs.logger.Errorf("failed to get the slashing record of tx %s: %v", attempt.SlashingTxHash, err)
+
+		return
+	}
+	if !found {
+		record = &store.SlashingRecord{
+			FpBtcPk:        attempt.FpBtcPk,
+			StakingTxHash:  attempt.StakingTxHash,
+			Path:           attempt.Path,
+			SlashingTxHash: attempt.SlashingTxHash,
+		}
+	}
+
+	record.Attempts++
+	record.UpdatedTime = time.Now()
+	record.Error = ""
+	if attemptErr != nil {
+		record.Error = attemptErr.Error()
+	}
+	if attempt.SlashingTx != nil {
+		record.SlashingTx = attempt.SlashingTx
+	}
+	if record.BroadcastHeight == 0 {
+		record.BroadcastHeight = attempt.BroadcastHeight
+	}
+	// a confirmed slashing tx stays confirmed, e.g., when a later attempt finds
+	// the staking output spent by it
+	if record.Status != store.SlashingStatusConfirmed {
+		record.Status = attempt.Status
+	}
+
+	if err := bs.ledger.PutSlashingRecord(record); err != nil {
+		bs.logger.Errorf("failed to record the slashing attempt of tx %s: %v", record.SlashingTxHash, err)
+	}
+}
+
+// putSlashedFPSK keeps the SK of the slashed finality provider in the slashing
+// ledger, so that its BTC delegations can be slashed again after a restart
+func (bs *BTCSlasher) putSlashedFPSK(fpBTCPK *bbn.BIP340PubKey, fpBTCSK *btcec.PrivateKey) {
+	if err := bs.ledger.PutSlashedFPSK(fpBTCPK.MarshalHex(), fpBTCSK.Serialize()); err != nil {
+		bs.logger.Errorf("failed to record the SK of slashed finality provider %s: %v", fpBTCPK.MarshalHex(), err)
+	}
+}
+
+// SlashingRecords returns up to limit records of the slashing ledger of the given
+// finality provider, or of all of them if fpBtcPk is empty
+func (bs *BTCSlasher) SlashingRecords(fpBtcPk string, limit uint32) ([]*store.SlashingRecord, error) {
+	return bs.ledger.ListSlashingRecords(fpBtcPk, limit)
+}
+
+// slashingLedgerTracker is a routine that resumes the slashing of the finality
+// providers left unfinished by the previous run, and then periodically follows
+// the broadcast slashing txs until they are included in Bitcoin
+func (bs *BTCSlasher) slashingLedgerTracker() {
+	defer bs.wg.Done()
+
+	bs.logger.Info("slashing ledger tracker has started")
+
+	if err := bs.resumeSlashing(); err != nil {
+		bs.logger.Errorf("failed to resume the unfinished slashing: %v", err)
+	}
+
+	ticker := time.NewTicker(bs.checkSlashingTxsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-bs.quit:
+			bs.logger.Debug("slashing ledger tracker quit")
+
+			return
+		case <-ticker.C:
+			if err := bs.checkUnfinishedSlashingTxs(); err != nil {
+				bs.logger.Errorf("failed to check the unfinished slashing txs: %v", err)
+			}
+		}
+	}
+}
+
+// resumeSlashing slashes again the finality providers with a slashing tx that
+// could not be built, and checks the status of the others
+func (bs *BTCSlasher) resumeSlashing() error {
+	records, err := bs.ledger.UnfinishedSlashingRecords()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	bs.logger.Infof("resuming %d unfinished slashing txs", len(records))
+
+	// a slashing tx with witness can only be built again from the SK of the
+	// finality provider, so such finality providers are slashed from scratch
+	fpsToSlash := make(map[string]struct{})
+	for _, record := range records {
+		if record.SlashingTx == nil {
+			fpsToSlash[record.FpBtcPk] = struct{}{}
+		}
+	}
+	for fpBtcPk := range fpsToSlash {
+		skBytes, found, err := bs.ledger.SlashedFPSK(fpBtcPk)
+		if err != nil {
+			return err
+		}
+		if !found {
+			bs.logger.Warnf("the SK of slashed finality provider %s is unknown, bootstrap the slasher to slash it again", fpBtcPk)
+
+			continue
+		}
+		fpBTCSK, _ := btcec.PrivKeyFromBytes(skBytes)
+		if err := bs.SlashFinalityProvider(fpBTCSK); err != nil {
+			bs.logger.Errorf("failed to slash finality provider %s again: %v", fpBtcPk, err)
+		}
+	}
+
+	return bs.checkUnfinishedSlashingTxs()
+}
+
+// checkUnfinishedSlashingTxs updates the status of the slashing txs in the ledger
+// that are not included in Bitcoin yet, and broadcasts again the ones that are
+// neither in the mempool nor in a block, e.g., evicted for lack of fees
+func (bs *BTCSlasher) checkUnfinishedSlashingTxs() error {
+	records, err := bs.ledger.UnfinishedSlashingRecords()
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record.SlashingTx == nil {
+			// being slashed again by SlashFinalityProvider
+			continue
+		}
+
+		select {
+		case <-bs.quit:
+			return nil
+		default:
+		}
+
+		if err := bs.checkSlashingTx(record); err != nil {
+			bs.logger.Errorf("failed to check slashing tx %s: %v", record.SlashingTxHash, err)
+		}
+	}
+
+	return nil
+}
+
+func (bs *BTCSlasher) checkSlashingTx(record *store.SlashingRecord) error {
+	if len(record.SlashingTx.TxIn) == 0 || len(record.SlashingTx.TxOut) == 0 {
+		return fmt.Errorf("slashing tx %s has no input or output", record.SlashingTxHash)
+	}
+
+	conf, status, err := bs.BTCClient.TxDetails(&record.SlashingTxHash, record.SlashingTx.TxOut[0].PkScript)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case btcclient.TxInChain:
+		bs.logger.Infof("slashing tx %s of BTC delegation with staking tx hash %s is included at height %d",
+			record.SlashingTxHash, record.StakingTxHash, conf.BlockHeight)
+		record.Status = store.SlashingStatusConfirmed
+		record.ConfirmHeight = conf.BlockHeight
+		record.Error = ""
+		record.UpdatedTime = time.Now()
+
+		return bs.ledger.PutSlashingRecord(record)
+	case btcclient.TxInMemPool:
+		if record.Status == store.SlashingStatusBroadcast {
+			return nil
+		}
+		record.Status = store.SlashingStatusBroadcast
+		record.Error = ""
+		record.UpdatedTime = time.Now()
+
+		return bs.ledger.PutSlashingRecord(record)
+	default:
+		return bs.rebroadcastSlashingTx(record)
+	}
+}
+
+// rebroadcastSlashingTx submits again the signed slashing tx of the record, unless
+// its staking/unbonding output is spent by another tx, and records the outcome
+func (bs *BTCSlasher) rebroadcastSlashingTx(record *store.SlashingRecord) error {
+	attempt := &store.SlashingRecord{
+		FpBtcPk:        record.FpBtcPk,
+		StakingTxHash:  record.StakingTxHash,
+		Path:           record.Path,
+		SlashingTxHash: record.SlashingTxHash,
+		SlashingTx:     record.SlashingTx,
+		Status:         store.SlashingStatusFailed,
+	}
+
+	fundingOutPoint := record.SlashingTx.TxIn[0].PreviousOutPoint
+	txOut, err := bs.BTCClient.GetTxOut(&fundingOutPoint.Hash, fundingOutPoint.Index, true)
+	if err != nil {
+		return fmt.Errorf("failed to get the output spent by slashing tx %s: %w", record.SlashingTxHash, err)
+	}
+	if txOut == nil {
+		bs.logger.Warnf("the output spent by slashing tx %s is already spent by another tx", record.SlashingTxHash)
+		attempt.Status = store.SlashingStatusUnslashable
+		bs.recordSlashingAttempt(attempt, fmt.Errorf("output %s is spent by another tx", fundingOutPoint))
+
+		return nil
+	}
+
+	_, err = bs.BTCClient.SendRawTransaction(record.SlashingTx, true)
+	if err != nil {
+		err = fmt.Errorf("failed to rebroadcast slashing tx %s: %w", record.SlashingTxHash, err)
+	} else {
+		bs.logger.Infof("rebroadcast slashing tx %s of BTC delegation with staking tx hash %s",
+			record.SlashingTxHash, record.StakingTxHash)
+		attempt.Status = store.SlashingStatusBroadcast
+		attempt.BroadcastHeight = bs.bestBlockHeight()
+	}
+	bs.recordSlashingAttempt(attempt, err)
+
+	return err
+}
+
+// bestBlockHeight returns the height of the BTC tip, 0 if unknown
+func (bs *BTCSlasher) bestBlockHeight() uint32 {
+	height, err := bs.BTCClient.GetBestBlock()
+	if err != nil {
+		bs.logger.Warnf("failed to get the BTC tip height: %v", err)
+
+		return 0
+	}
+
+	return height
+}
@@ -0,0 +1,131 @@
+package btcslasher
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/golang/mock/gomock"
+	notifier "github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
+	"github.com/babylonlabs-io/vigilante/testutil"
+	"github.com/babylonlabs-io/vigilante/testutil/mocks"
+)
+
+func FuzzCheckUnfinishedSlashingTxs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 3)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockBTCClient := mocks.NewMockBTCClient(ctrl)
+		ledger, err := store.NewBTCStakingTrackerStore(testutil.MakeTestBackend(t))
+		require.NoError(t, err)
+		bs := &BTCSlasher{
+			logger:    zap.NewNop().Sugar(),
+			BTCClient: mockBTCClient,
+			ledger:    ledger,
+			quit:      make(chan struct{}),
+		}
+
+		newRecord := func(status store.SlashingStatus) *store.SlashingRecord {
+			slashingTx := datagen.GenRandomTx(r)
+			record := &store.SlashingRecord{
+				FpBtcPk:        datagen.GenRandomHexStr(r, 32),
+				StakingTxHash:  datagen.GenRandomBtcdHash(r),
+				Path:           store.SlashingPath(r.Intn(2)),
+				SlashingTxHash: slashingTx.TxHash(),
+				SlashingTx:     slashingTx,
+				Status:         status,
+				Attempts:       1,
+				UpdatedTime:    time.Now(),
+			}
+			require.NoError(t, ledger.PutSlashingRecord(record))
+
+			return record
+		}
+
+		// included in a BTC block
+		confirmed := newRecord(store.SlashingStatusBroadcast)
+		confirmHeight := uint32(r.Int31())
+		mockBTCClient.EXPECT().TxDetails(&confirmed.SlashingTxHash, gomock.Any()).
+			Return(&notifier.TxConfirmation{BlockHeight: confirmHeight}, btcclient.TxInChain, nil).Times(1)
+		// accepted to the mempool after a failed attempt
+		inMempool := newRecord(store.SlashingStatusFailed)
+		mockBTCClient.EXPECT().TxDetails(&inMempool.SlashingTxHash, gomock.Any()).
+			Return(nil, btcclient.TxInMemPool, nil).Times(1)
+		// evicted from the mempool, thus rebroadcast
+		evicted := newRecord(store.SlashingStatusBroadcast)
+		mockBTCClient.EXPECT().TxDetails(&evicted.SlashingTxHash, gomock.Any()).
+			Return(nil, btcclient.TxNotFound, nil).Times(1)
+		evictedOutPoint := evicted.SlashingTx.TxIn[0].PreviousOutPoint
+		mockBTCClient.EXPECT().GetTxOut(&evictedOutPoint.Hash, evictedOutPoint.Index, true).
+			Return(&btcjson.GetTxOutResult{}, nil).Times(1)
+		mockBTCClient.EXPECT().SendRawTransaction(evicted.SlashingTx, true).
+			Return(&evicted.SlashingTxHash, nil).Times(1)
+		mockBTCClient.EXPECT().GetBestBlock().Return(uint32(1000), nil).Times(1)
+		// its staking output is spent by another tx
+		unslashable := newRecord(store.SlashingStatusFailed)
+		mockBTCClient.EXPECT().TxDetails(&unslashable.SlashingTxHash, gomock.Any()).
+			Return(nil, btcclient.TxNotFound, nil).Times(1)
+		unslashableOutPoint := unslashable.SlashingTx.TxIn[0].PreviousOutPoint
+		mockBTCClient.EXPECT().GetTxOut(&unslashableOutPoint.Hash, unslashableOutPoint.Index, true).
+			Return(nil, nil).Times(1)
+		// failed again for lack of fees
+		underpaid := newRecord(store.SlashingStatusFailed)
+		mockBTCClient.EXPECT().TxDetails(&underpaid.SlashingTxHash, gomock.Any()).
+			Return(nil, btcclient.TxNotFound, nil).Times(1)
+		underpaidOutPoint := underpaid.SlashingTx.TxIn[0].PreviousOutPoint
+		mockBTCClient.EXPECT().GetTxOut(&underpaidOutPoint.Hash, underpaidOutPoint.Index, true).
+			Return(&btcjson.GetTxOutResult{}, nil).Times(1)
+		mockBTCClient.EXPECT().SendRawTransaction(underpaid.SlashingTx, true).
+			Return(nil, errors.New("min relay fee not met")).Times(1)
+
+		require.NoError(t, bs.checkUnfinishedSlashingTxs())
+
+		getRecord := func(record *store.SlashingRecord) *store.SlashingRecord {
+			stored, found, err := ledger.SlashingRecord(record.SlashingTxHash)
+			require.NoError(t, err)
+			require.True(t, found)
+
+			return stored
+		}
+
+		stored := getRecord(confirmed)
+		require.Equal(t, store.SlashingStatusConfirmed, stored.Status)
+		require.Equal(t, confirmHeight, stored.ConfirmHeight)
+
+		stored = getRecord(inMempool)
+		require.Equal(t, store.SlashingStatusBroadcast, stored.Status)
+
+		stored = getRecord(evicted)
+		require.Equal(t, store.SlashingStatusBroadcast, stored.Status)
+		require.Equal(t, uint32(1000), stored.BroadcastHeight)
+		require.Equal(t, uint32(2), stored.Attempts)
+		require.Empty(t, stored.Error)
+
+		stored = getRecord(unslashable)
+		require.Equal(t, store.SlashingStatusUnslashable, stored.Status)
+		require.NotEmpty(t, stored.Error)
+
+		stored = getRecord(underpaid)
+		require.Equal(t, store.SlashingStatusFailed, stored.Status)
+		require.Equal(t, uint32(2), stored.Attempts)
+		require.Contains(t, stored.Error, "min relay fee not met")
+
+		// only the failed slashing txs are left to be broadcast or confirmed
+		unfinished, err := ledger.UnfinishedSlashingRecords()
+		require.NoError(t, err)
+		require.Len(t, unfinished, 3)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/babylonlabs-io/vigilante/types"
@@ -13,6 +14,7 @@ import (
 	bbn "github.com/babylonlabs-io/babylon/types"
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
 	"github.com/babylonlabs-io/vigilante/metrics"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -52,6 +54,15 @@ type BTCSlasher struct {
 	slashResultChan chan *SlashResult
 
 	maxSlashingConcurrency int64
+	// number of BTC delegations whose slashing txs are being submitted
+	slashingInProgress atomic.Int64
+	// dryRunReport receives the verified slashing txs instead of Bitcoin, nil
+	// unless in dry-run mode
+	dryRunReport *DryRunReport
+	// ledger records each attempt to slash a BTC delegation, so that the
+	// unfinished slashing is resumed after a restart
+	ledger                   *store.BTCStakingTrackerStore
+	checkSlashingTxsInterval time.Duration
 
 	metrics *metrics.SlasherMetrics
 
@@ -71,23 +82,29 @@ func New(
 	maxRetryTimes uint,
 	maxSlashingConcurrency uint8,
 	slashedFPSKChan chan *btcec.PrivateKey,
+	dryRunReport *DryRunReport,
+	ledger *store.BTCStakingTrackerStore,
+	checkSlashingTxsInterval time.Duration,
 	metrics *metrics.SlasherMetrics,
 ) (*BTCSlasher, error) {
 	logger := parentLogger.With(zap.String("module", "slasher")).Sugar()
 
 	return &BTCSlasher{
-		logger:                 logger,
-		BTCClient:              btcClient,
-		BBNQuerier:             bbnQuerier,
-		netParams:              netParams,
-		retrySleepTime:         retrySleepTime,
-		maxRetrySleepTime:      maxRetrySleepTime,
-		maxRetryTimes:          maxRetryTimes,
-		maxSlashingConcurrency: int64(maxSlashingConcurrency),
-		slashedFPSKChan:        slashedFPSKChan,
-		slashResultChan:        make(chan *SlashResult, 1000),
-		quit:                   make(chan struct{}),
-		metrics:                metrics,
+		logger:                   logger,
+		BTCClient:                btcClient,
+		BBNQuerier:               bbnQuerier,
+		netParams:                netParams,
+		retrySleepTime:           retrySleepTime,
+		maxRetrySleepTime:        maxRetrySleepTime,
+		maxRetryTimes:            maxRetryTimes,
+		maxSlashingConcurrency:   int64(maxSlashingConcurrency),
+		slashedFPSKChan:          slashedFPSKChan,
+		slashResultChan:          make(chan *SlashResult, 1000),
+		dryRunReport:             dryRunReport,
+		ledger:                   ledger,
+		checkSlashingTxsInterval: checkSlashingTxsInterval,
+		quit:                     make(chan struct{}),
+		metrics:                  metrics,
 	}, nil
 }
 
@@ -156,6 +173,12 @@ func (bs *BTCSlasher) Start() error {
 		go bs.equivocationTracker()
 		go bs.slashingEnforcer()
 
+		// the dry-run mode neither broadcasts nor records slashing txs
+		if !bs.isDryRun() {
+			bs.wg.Add(1)
+			go bs.slashingLedgerTracker()
+		}
+
 		bs.logger.Info("the BTC slasher has started")
 	})
 
@@ -200,6 +223,12 @@ func (bs *BTCSlasher) slashingEnforcer() {
 					slashRes.Del.FpBtcPkList[0].MarshalHex(), // TODO: work with restaking
 					slashRes.Err,
 				)
+			} else if bs.isDryRun() {
+				bs.logger.Infof(
+					"dry run: verified the slashing of BTC delegation with staking tx hash %s under finality provider %s",
+					slashRes.Del.StakingTxHex,
+					slashRes.Del.FpBtcPkList[0].MarshalHex(), // TODO: work with restaking
+				)
 			} else {
 				bs.logger.Infof(
 					"successfully slash BTC delegation with staking tx hash %s under finality provider %s",
@@ -279,6 +308,10 @@ func (bs *BTCSlasher) SlashFinalityProvider(extractedFpBTCSK *btcec.PrivateKey)
 		return fmt.Errorf("failed to get BTC delegations under finality provider %s: %w", fpBTCPK.MarshalHex(), err)
 	}
 
+	if !bs.isDryRun() {
+		bs.putSlashedFPSK(fpBTCPK, extractedFpBTCSK)
+	}
+
 	// Initialize a mutex protected *btcec.PrivateKey
 	safeExtractedFpBTCSK := types.NewPrivateKeyWithMutex(extractedFpBTCSK)
 
@@ -291,8 +324,10 @@ func (bs *BTCSlasher) SlashFinalityProvider(extractedFpBTCSK *btcec.PrivateKey)
 	// sign and submit slashing tx for each active and unbonded delegation
 	for _, del := range delegations {
 		bs.wg.Add(1)
+		bs.slashingInProgress.Add(1)
 		go func(d *bstypes.BTCDelegationResponse) {
 			defer bs.wg.Done()
+			defer bs.slashingInProgress.Add(-1)
 			ctx, cancel := bs.quitContext()
 			defer cancel()
 
@@ -310,11 +345,19 @@ func (bs *BTCSlasher) SlashFinalityProvider(extractedFpBTCSK *btcec.PrivateKey)
 		}(del)
 	}
 
-	bs.metrics.SlashedFinalityProvidersCounter.Inc()
+	if !bs.isDryRun() {
+		bs.metrics.SlashedFinalityProvidersCounter.Inc()
+	}
 
 	return nil
 }
 
+// SlashingInProgress returns the number of BTC delegations whose slashing txs are
+// being submitted to Bitcoin
+func (bs *BTCSlasher) SlashingInProgress() int64 {
+	return bs.slashingInProgress.Load()
+}
+
 func (bs *BTCSlasher) WaitForShutdown() {
 	bs.wg.Wait()
 }
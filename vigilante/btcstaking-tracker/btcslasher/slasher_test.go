@@ -2,9 +2,14 @@ package btcslasher_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/babylonlabs-io/babylon/btcstaking"
@@ -24,8 +29,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/btcslasher"
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
+	"github.com/babylonlabs-io/vigilante/testutil"
 	"github.com/babylonlabs-io/vigilante/testutil/mocks"
 )
 
@@ -74,6 +81,16 @@ func FuzzSlasher(f *testing.F) {
 		logger, err := config.NewRootLogger("auto", "debug")
 		require.NoError(t, err)
 		slashedFPSKChan := make(chan *btcec.PrivateKey, 100)
+		ledger, err := store.NewBTCStakingTrackerStore(testutil.MakeTestBackend(t))
+		require.NoError(t, err)
+		// the slashing txs are verified and reported instead of broadcast in dry-run mode
+		dryRun := r.Intn(2) == 0
+		var dryRunReport *btcslasher.DryRunReport
+		reportPath := filepath.Join(t.TempDir(), "report.jsonl")
+		if dryRun {
+			dryRunReport, err = btcslasher.NewDryRunReport(reportPath)
+			require.NoError(t, err)
+		}
 		btcSlasher, err := btcslasher.New(
 			logger,
 			mockBTCClient,
@@ -84,6 +101,9 @@ func FuzzSlasher(f *testing.F) {
 			commonCfg.MaxRetryTimes,
 			config.MaxSlashingConcurrency,
 			slashedFPSKChan,
+			dryRunReport,
+			ledger,
+			time.Minute,
 			metrics.NewBTCStakingTrackerMetrics().SlasherMetrics,
 		)
 		require.NoError(t, err)
@@ -296,15 +316,57 @@ func FuzzSlasher(f *testing.F) {
 			Return(&btcjson.GetTxOutResult{}, nil).
 			Times((len(activeBTCDelsList) + len(unbondedBTCDelsList)) * 2)
 
-		mockBTCClient.EXPECT().
-			SendRawTransaction(gomock.Any(), gomock.Eq(true)).
-			Return(&chainhash.Hash{}, nil).
-			Times((len(activeBTCDelsList) + len(unbondedBTCDelsList)) * 2)
+		numSlashingTxs := (len(activeBTCDelsList) + len(unbondedBTCDelsList)) * 2
+		if dryRun {
+			mockBTCClient.EXPECT().SendRawTransaction(gomock.Any(), gomock.Any()).Times(0)
+		} else {
+			mockBTCClient.EXPECT().
+				SendRawTransaction(gomock.Any(), gomock.Eq(true)).
+				Return(&chainhash.Hash{}, nil).
+				Times(numSlashingTxs)
+			mockBTCClient.EXPECT().GetBestBlock().Return(uint32(1000), nil).AnyTimes()
+		}
 
 		err = btcSlasher.SlashFinalityProvider(valSK)
 		require.NoError(t, err)
 
 		btcSlasher.WaitForShutdown()
+
+		if dryRun {
+			reportBytes, err := os.ReadFile(reportPath)
+			require.NoError(t, err)
+			lines := strings.Split(strings.TrimSpace(string(reportBytes)), "\n")
+			require.Len(t, lines, numSlashingTxs)
+			for _, line := range lines {
+				var record btcslasher.DryRunRecord
+				require.NoError(t, json.Unmarshal([]byte(line), &record))
+				require.True(t, record.WitnessValid, record.Error)
+				require.Positive(t, record.Fee)
+				require.Positive(t, record.VSize)
+				require.Contains(t, []string{store.SlashingPathStaking.String(), store.SlashingPathUnbonding.String()}, record.Path)
+			}
+		}
+
+		// each broadcast slashing tx is recorded in the slashing ledger, unless in dry-run mode
+		records, err := ledger.ListSlashingRecords(fpBTCPK.MarshalHex(), 0)
+		require.NoError(t, err)
+		_, skFound, err := ledger.SlashedFPSK(fpBTCPK.MarshalHex())
+		require.NoError(t, err)
+		if dryRun {
+			require.Empty(t, records)
+			require.False(t, skFound)
+		} else {
+			require.Len(t, records, numSlashingTxs)
+			require.True(t, skFound)
+			for _, record := range records {
+				require.Equal(t, store.SlashingStatusBroadcast, record.Status)
+				require.Equal(t, uint32(1000), record.BroadcastHeight)
+				require.Equal(t, uint32(1), record.Attempts)
+				require.Empty(t, record.Error)
+				require.NotNil(t, record.SlashingTx)
+				require.Equal(t, record.SlashingTxHash, record.SlashingTx.TxHash())
+			}
+		}
 	})
 }
 
@@ -11,6 +11,7 @@ import (
 	bbn "github.com/babylonlabs-io/babylon/types"
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
 	"github.com/babylonlabs-io/vigilante/utils"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -44,6 +45,12 @@ func (bs *BTCSlasher) slashBTCDelegation(
 	ctx, cancel := bs.quitContext()
 	defer cancel()
 
+	// a dry run reports each slashing tx once, as nothing changes upon retrying
+	attempts := bs.maxRetryTimes
+	if bs.isDryRun() {
+		attempts = 1
+	}
+
 	err := retry.Do(
 		func() error {
 			var accumulatedErrs error
@@ -68,7 +75,7 @@ func (bs *BTCSlasher) slashBTCDelegation(
 		retry.Context(ctx),
 		retry.Delay(bs.retrySleepTime),
 		retry.MaxDelay(bs.maxRetrySleepTime),
-		retry.Attempts(bs.maxRetryTimes),
+		retry.Attempts(attempts),
 	)
 
 	slashRes := &SlashResult{
@@ -84,11 +91,8 @@ func (bs *BTCSlasher) sendSlashingTx(
 	extractedfpBTCSK *btcec.PrivateKey,
 	del *bstypes.BTCDelegationResponse,
 	isUnbondingSlashingTx bool,
-) (*chainhash.Hash, error) {
-	var (
-		err     error
-		slashTx *bstypes.BTCSlashingTx
-	)
+) (_ *chainhash.Hash, err error) {
+	var slashTx *bstypes.BTCSlashingTx
 	// check if the slashing tx is known on Bitcoin
 	if isUnbondingSlashingTx {
 		slashTx, err = bstypes.NewBTCSlashingTxFromHex(del.UndelegationResponse.SlashingTxHex)
@@ -102,26 +106,55 @@ func (bs *BTCSlasher) sendSlashingTx(
 		}
 	}
 
+	// record the outcome of this attempt in the slashing ledger
+	attempt, err := newSlashingAttempt(fpBTCPK, del, slashTx, isUnbondingSlashingTx)
+	if err != nil {
+		return nil, err
+	}
+	if !bs.isDryRun() {
+		defer func() {
+			bs.recordSlashingAttempt(attempt, err)
+		}()
+	}
+
 	txHash := slashTx.MustGetTxHash()
-	if bs.isTxSubmittedToBitcoin(txHash) {
+	submitted := bs.isTxSubmittedToBitcoin(txHash)
+	// in dry-run mode, the slashing tx is verified even if it is already
+	// submitted, e.g., by the production slasher
+	if submitted && !bs.isDryRun() {
 		// already submitted to Bitcoin, skip
+		attempt.Status = store.SlashingStatusBroadcast
+
 		return txHash, nil
 	}
 
 	// check if the staking/unbonding tx's output is indeed spendable
 	// TODO: use bbn.GetOutputIdxInBTCTx
-	var spendable bool
+	var (
+		spendable   bool
+		fundingTx   *wire.MsgTx
+		fundingOIdx uint32
+	)
 	if isUnbondingSlashingTx {
 		ubondingTx, errDecode := hex.DecodeString(del.UndelegationResponse.UnbondingTxHex)
 		if errDecode != nil {
 			return nil, errDecode
 		}
+		fundingTx, err = bbn.NewBTCTxFromBytes(ubondingTx)
+		if err != nil {
+			return nil, err
+		}
 		spendable, err = bs.isTaprootOutputSpendable(ubondingTx, 0)
 	} else {
 		stakingTx, errDecode := hex.DecodeString(del.StakingTxHex)
 		if errDecode != nil {
 			return nil, errDecode
 		}
+		fundingTx, err = bbn.NewBTCTxFromBytes(stakingTx)
+		if err != nil {
+			return nil, err
+		}
+		fundingOIdx = del.StakingOutputIdx
 		spendable, err = bs.isTaprootOutputSpendable(stakingTx, del.StakingOutputIdx)
 	}
 	if err != nil {
@@ -134,7 +167,9 @@ func (bs *BTCSlasher) sendSlashingTx(
 		)
 	}
 	// this staking/unbonding tx is no longer slashable on Bitcoin
-	if !spendable {
+	if !spendable && !bs.isDryRun() {
+		attempt.Status = store.SlashingStatusUnslashable
+
 		return nil, fmt.Errorf(
 			"the staking/unbonding tx of BTC delegation %s under finality provider %s is not slashable",
 			del.BtcPk.MarshalHex(),
@@ -158,13 +193,39 @@ func (bs *BTCSlasher) sendSlashingTx(
 	}
 	if err != nil {
 		// Warning: this can only be a programming error in Babylon side
-		return nil, fmt.Errorf(
+		err = fmt.Errorf(
 			"failed to build witness for BTC delegation %s under finality provider %s: %w",
 			del.BtcPk.MarshalHex(),
 			fpBTCPK.MarshalHex(),
 			err,
 		)
 	}
+
+	if bs.isDryRun() {
+		if int(fundingOIdx) >= len(fundingTx.TxOut) {
+			return nil, fmt.Errorf("the staking/unbonding tx of BTC delegation %s has no output %d",
+				del.BtcPk.MarshalHex(), fundingOIdx)
+		}
+		record := &DryRunRecord{
+			FpBtcPk:          fpBTCPK.MarshalHex(),
+			DelBtcPk:         del.BtcPk.MarshalHex(),
+			StakingTxHash:    stakingTxHashFromHex(del.StakingTxHex),
+			Path:             store.SlashingPathStaking.String(),
+			SlashingTxHash:   txHash.String(),
+			AlreadySubmitted: submitted,
+			Spendable:        spendable,
+		}
+		if isUnbondingSlashingTx {
+			record.Path = store.SlashingPathUnbonding.String()
+		}
+
+		return bs.reportDryRun(record, fundingTx.TxOut[fundingOIdx], slashTx, slashingMsgTxWithWitness, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	attempt.SlashingTx = slashingMsgTxWithWitness
+
 	bs.logger.Debugf(
 		"signed and assembled witness for slashing tx of unbonded BTC delegation %s under finality provider %s",
 		del.BtcPk.MarshalHex(),
@@ -187,12 +248,45 @@ func (bs *BTCSlasher) sendSlashingTx(
 		del.BtcPk.MarshalHex(),
 		fpBTCPK.MarshalHex(),
 	)
+	attempt.Status = store.SlashingStatusBroadcast
+	attempt.BroadcastHeight = bs.bestBlockHeight()
 
-	// TODO: wait for k-deep to ensure slashing tx is included
+	// the slashing ledger tracker follows the slashing tx until it is included
 
 	return txHash, nil
 }
 
+// newSlashingAttempt returns the slashing record of an attempt to submit the given
+// slashing tx, failed unless updated by the attempt
+func newSlashingAttempt(
+	fpBTCPK *bbn.BIP340PubKey,
+	del *bstypes.BTCDelegationResponse,
+	slashTx *bstypes.BTCSlashingTx,
+	isUnbondingSlashingTx bool,
+) (*store.SlashingRecord, error) {
+	stakingTx, _, err := bbn.NewBTCTxFromHex(del.StakingTxHex)
+	if err != nil {
+		return nil, err
+	}
+	slashingMsgTx, err := slashTx.ToMsgTx()
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := &store.SlashingRecord{
+		FpBtcPk:        fpBTCPK.MarshalHex(),
+		StakingTxHash:  stakingTx.TxHash(),
+		Path:           store.SlashingPathStaking,
+		SlashingTxHash: slashingMsgTx.TxHash(),
+		Status:         store.SlashingStatusFailed,
+	}
+	if isUnbondingSlashingTx {
+		attempt.Path = store.SlashingPathUnbonding
+	}
+
+	return attempt, nil
+}
+
 // BuildUnbondingSlashingTxWithWitness returns the unbonding slashing tx.
 func BuildUnbondingSlashingTxWithWitness(
 	d *bstypes.BTCDelegationResponse,
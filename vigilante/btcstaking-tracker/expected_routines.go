@@ -1,13 +1,18 @@
 package btcstakingtracker
 
+import "github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
+
 type IBTCSlasher interface {
 	// common functions
 	Bootstrap(startHeight uint64) error
 	Start() error
 	Stop() error
+	SlashingInProgress() int64
+	SlashingRecords(fpBtcPk string, limit uint32) ([]*store.SlashingRecord, error)
 }
 
 type IAtomicSlasher interface {
 	Start() error
 	Stop() error
+	NumTrackedDelegations() int
 }
@@ -0,0 +1,206 @@
+package stakingeventwatcher
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	btcstakingtypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	notifier "github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+const (
+	delegationEventsSubscriber = "staking-event-watcher"
+	delegationEventsCapacity   = 1000
+
+	eventBTCDelegationCreated                = "babylon.btcstaking.v1.EventBTCDelegationCreated"
+	eventCovenantQuorumReached               = "babylon.btcstaking.v1.EventCovenantQuorumReached"
+	eventBTCDelegationInclusionProofReceived = "babylon.btcstaking.v1.EventBTCDelegationInclusionProofReceived"
+	eventBTCDelegationUnbondedEarly          = "babylon.btcstaking.v1.EventBTCDelgationUnbondedEarly"
+	eventBTCDelegationExpired                = "babylon.btcstaking.v1.EventBTCDelegationExpired"
+)
+
+var (
+	// the events changing the status of a btc delegation, identified by its staking tx hash
+	delegationStatusEvents = []string{
+		eventCovenantQuorumReached,
+		eventBTCDelegationInclusionProofReceived,
+		eventBTCDelegationUnbondedEarly,
+		eventBTCDelegationExpired,
+	}
+
+	// NOTE: the expired delegations are unbonded at the end of a block, not by a tx
+	delegationEventQueries = []string{
+		fmt.Sprintf("tm.event='Tx' AND %s.staking_tx_hex EXISTS", eventBTCDelegationCreated),
+		fmt.Sprintf("tm.event='Tx' AND %s.staking_tx_hash EXISTS", eventCovenantQuorumReached),
+		fmt.Sprintf("tm.event='Tx' AND %s.staking_tx_hash EXISTS", eventBTCDelegationInclusionProofReceived),
+		fmt.Sprintf("tm.event='Tx' AND %s.staking_tx_hash EXISTS", eventBTCDelegationUnbondedEarly),
+		fmt.Sprintf("tm.event='NewBlock' AND %s.staking_tx_hash EXISTS", eventBTCDelegationExpired),
+	}
+)
+
+// delegationEvent is a btc delegation created or whose status changed on babylon
+type delegationEvent struct {
+	stakingTxHash chainhash.Hash
+	created       bool
+}
+
+// parseDelegationEvents returns the btc delegations of the given babylon event
+func parseDelegationEvents(resultEvent *coretypes.ResultEvent) ([]*delegationEvent, error) {
+	var events []*delegationEvent
+
+	for _, stakingTxHex := range resultEvent.Events[eventBTCDelegationCreated+".staking_tx_hex"] {
+		stakingTx, _, err := bbn.NewBTCTxFromHex(unquoteEventAttribute(stakingTxHex))
+		if err != nil {
+			return nil, fmt.Errorf("invalid staking tx in %s: %w", eventBTCDelegationCreated, err)
+		}
+		events = append(events, &delegationEvent{stakingTxHash: stakingTx.TxHash(), created: true})
+	}
+
+	for _, eventName := range delegationStatusEvents {
+		for _, stakingTxHashHex := range resultEvent.Events[eventName+".staking_tx_hash"] {
+			stakingTxHash, err := chainhash.NewHashFromStr(unquoteEventAttribute(stakingTxHashHex))
+			if err != nil {
+				return nil, fmt.Errorf("invalid staking tx hash in %s: %w", eventName, err)
+			}
+			events = append(events, &delegationEvent{stakingTxHash: *stakingTxHash})
+		}
+	}
+
+	return events, nil
+}
+
+// unquoteEventAttribute returns the value of an attribute of a typed event,
+// which is JSON encoded
+func unquoteEventAttribute(value string) string {
+	var unquoted string
+	if err := json.Unmarshal([]byte(value), &unquoted); err != nil {
+		return strings.Trim(value, `"`)
+	}
+
+	return unquoted
+}
+
+// watchDelegationEvents is a routine that receives the babylon events of one of
+// the subscriptions and updates the tracked delegations accordingly
+func (sew *StakingEventWatcher) watchDelegationEvents(eventChan <-chan coretypes.ResultEvent) {
+	defer sew.wg.Done()
+
+	for {
+		select {
+		case resultEvent, ok := <-eventChan:
+			if !ok {
+				sew.logger.Warn("delegation events subscription is closed, relying on the consistency checks")
+
+				return
+			}
+			sew.handleDelegationEvent(&resultEvent)
+		case <-sew.quit:
+			sew.logger.Debug("delegation events loop quit")
+
+			return
+		}
+	}
+}
+
+func (sew *StakingEventWatcher) handleDelegationEvent(resultEvent *coretypes.ResultEvent) {
+	events, err := parseDelegationEvents(resultEvent)
+	if err != nil {
+		sew.logger.Errorf("error parsing delegation event: %v", err)
+
+		return
+	}
+
+	for _, ev := range events {
+		sew.metrics.DelegationEventsCounter.Inc()
+
+		if ev.created {
+			// pending delegations are tracked once the covenant quorum is reached
+			sew.logger.Debugf("delegation with staking tx %s created", ev.stakingTxHash)
+
+			continue
+		}
+
+		del, err := sew.babylonNodeAdapter.BTCDelegation(ev.stakingTxHash)
+		if err != nil {
+			sew.logger.Errorf("error fetching delegation with staking tx %s: %v", ev.stakingTxHash, err)
+
+			continue
+		}
+		sew.logger.Debugf("delegation with staking tx %s changed status to %s", ev.stakingTxHash, del.Status)
+
+		switch del.Status {
+		case btcstakingtypes.BTCDelegationStatus_VERIFIED:
+			sew.trackUnbonding(*del)
+			// only the new blocks are checked for staking txs, so the staking tx
+			// might already be included
+			if pendingDel := sew.addPending(*del); pendingDel != nil {
+				sew.checkNewPendingDelegation(pendingDel)
+			}
+		case btcstakingtypes.BTCDelegationStatus_ACTIVE:
+			sew.trackUnbonding(*del)
+			sew.untrackPending(ev.stakingTxHash)
+		case btcstakingtypes.BTCDelegationStatus_UNBONDED, btcstakingtypes.BTCDelegationStatus_EXPIRED:
+			// the unbonding tracker stops tracking the delegation upon the spend of its staking output
+			sew.untrackPending(ev.stakingTxHash)
+		default:
+		}
+	}
+}
+
+func (sew *StakingEventWatcher) checkNewPendingDelegation(del *TrackedDelegation) {
+	params, err := sew.babylonNodeAdapter.Params()
+	if err != nil {
+		sew.logger.Errorf("error getting tx params %v", err)
+
+		return
+	}
+
+	sew.checkPendingDelegation(del, params)
+}
+
+// checkBlockForStakingTxs activates the pending delegations whose staking tx is
+// included in the given btc block
+func (sew *StakingEventWatcher) checkBlockForStakingTxs(block *notifier.BlockEpoch) {
+	if sew.pendingTracker.Count() == 0 {
+		return
+	}
+
+	defer sew.latency("checkBlockForStakingTxs")()
+
+	ib, _, err := sew.btcClient.GetBlockByHash(block.Hash)
+	if err != nil {
+		sew.logger.Errorf("error getting btc block %s: %v", block.Hash, err)
+
+		return
+	}
+
+	var params *BabylonParams
+	for i, tx := range ib.Txs {
+		del, exists := sew.pendingTracker.GetDelegation(*tx.Hash())
+		if !exists || del.ActivationInProgress {
+			continue
+		}
+		sew.metrics.StakingTxsFoundInBlocksCounter.Inc()
+
+		if params == nil {
+			if params, err = sew.babylonNodeAdapter.Params(); err != nil {
+				sew.logger.Errorf("error getting tx params %v", err)
+
+				return
+			}
+		}
+
+		proof, err := ib.GenSPVProof(i)
+		if err != nil {
+			sew.logger.Debugf("error making spv proof %s", err)
+
+			continue
+		}
+
+		sew.startActivation(del, proof, inclusionBlock{hash: *block.Hash, height: uint32(block.Height)}, params.ConfirmationTimeBlocks)
+	}
+}
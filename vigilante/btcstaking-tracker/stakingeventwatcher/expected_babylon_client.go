@@ -18,6 +18,7 @@ import (
 	btcstakingtypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -33,6 +34,7 @@ type Delegation struct {
 	DelegationStartHeight uint32
 	UnbondingOutput       *wire.TxOut
 	HasProof              bool
+	Status                btcstakingtypes.BTCDelegationStatus
 }
 
 type BabylonParams struct {
@@ -49,6 +51,9 @@ type BabylonNodeAdapter interface {
 	ActivateDelegation(ctx context.Context, stakingTxHash chainhash.Hash, proof *btcctypes.BTCSpvProof) error
 	QueryHeaderDepth(headerHash *chainhash.Hash) (uint32, error)
 	Params() (*BabylonParams, error)
+	BTCDelegation(stakingTxHash chainhash.Hash) (*Delegation, error)
+	SubscribeDelegationEvents() ([]<-chan coretypes.ResultEvent, error)
+	UnsubscribeDelegationEvents() error
 }
 
 type BabylonClientAdapter struct {
@@ -83,26 +88,72 @@ func (bca *BabylonClientAdapter) DelegationsByStatus(
 	delegations := make([]Delegation, len(resp.BtcDelegations))
 
 	for i, delegation := range resp.BtcDelegations {
-		stakingTx, _, err := bbn.NewBTCTxFromHex(delegation.StakingTxHex)
+		del, err := delegationFromResponse(delegation)
 		if err != nil {
 			return nil, err
 		}
+		delegations[i] = *del
+	}
+
+	return delegations, nil
+}
+
+// BTCDelegation returns the btc delegation of the given staking tx with its current status
+func (bca *BabylonClientAdapter) BTCDelegation(stakingTxHash chainhash.Hash) (*Delegation, error) {
+	resp, err := bca.babylonClient.BTCDelegation(stakingTxHash.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve delegation from babylon: %w", err)
+	}
+
+	return delegationFromResponse(resp.BtcDelegation)
+}
 
-		unbondingTx, _, err := bbn.NewBTCTxFromHex(delegation.UndelegationResponse.UnbondingTxHex)
+// SubscribeDelegationEvents subscribes to the babylon events creating a btc delegation
+// or changing its status, one channel per event type
+func (bca *BabylonClientAdapter) SubscribeDelegationEvents() ([]<-chan coretypes.ResultEvent, error) {
+	eventChans := make([]<-chan coretypes.ResultEvent, 0, len(delegationEventQueries))
+	for _, query := range delegationEventQueries {
+		eventChan, err := bca.babylonClient.Subscribe(delegationEventsSubscriber, query, delegationEventsCapacity)
 		if err != nil {
-			return nil, err
-		}
+			_ = bca.UnsubscribeDelegationEvents()
 
-		delegations[i] = Delegation{
-			StakingTx:             stakingTx,
-			StakingOutputIdx:      delegation.StakingOutputIdx,
-			DelegationStartHeight: delegation.StartHeight,
-			UnbondingOutput:       unbondingTx.TxOut[0],
-			HasProof:              delegation.StartHeight > 0,
+			return nil, fmt.Errorf("failed to subscribe to %s: %w", query, err)
 		}
+		eventChans = append(eventChans, eventChan)
 	}
 
-	return delegations, nil
+	return eventChans, nil
+}
+
+// UnsubscribeDelegationEvents cancels the subscriptions of SubscribeDelegationEvents
+func (bca *BabylonClientAdapter) UnsubscribeDelegationEvents() error {
+	return bca.babylonClient.UnsubscribeAll(delegationEventsSubscriber)
+}
+
+func delegationFromResponse(delegation *btcstakingtypes.BTCDelegationResponse) (*Delegation, error) {
+	stakingTx, _, err := bbn.NewBTCTxFromHex(delegation.StakingTxHex)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingTx, _, err := bbn.NewBTCTxFromHex(delegation.UndelegationResponse.UnbondingTxHex)
+	if err != nil {
+		return nil, err
+	}
+
+	status, ok := btcstakingtypes.BTCDelegationStatus_value[delegation.StatusDesc]
+	if !ok {
+		return nil, fmt.Errorf("unknown status %s of delegation %s", delegation.StatusDesc, stakingTx.TxHash())
+	}
+
+	return &Delegation{
+		StakingTx:             stakingTx,
+		StakingOutputIdx:      delegation.StakingOutputIdx,
+		DelegationStartHeight: delegation.StartHeight,
+		UnbondingOutput:       unbondingTx.TxOut[0],
+		HasProof:              delegation.StartHeight > 0,
+		Status:                btcstakingtypes.BTCDelegationStatus(status),
+	}, nil
 }
 
 // IsDelegationActive method for BabylonClientAdapter
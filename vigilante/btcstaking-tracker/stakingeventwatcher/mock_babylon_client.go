@@ -12,6 +12,7 @@ import (
 	types0 "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	chainhash "github.com/btcsuite/btcd/chaincfg/chainhash"
 	wire "github.com/btcsuite/btcd/wire"
+	types1 "github.com/cometbft/cometbft/rpc/core/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -52,6 +53,21 @@ func (mr *MockBabylonNodeAdapterMockRecorder) ActivateDelegation(ctx, stakingTxH
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateDelegation", reflect.TypeOf((*MockBabylonNodeAdapter)(nil).ActivateDelegation), ctx, stakingTxHash, proof)
 }
 
+// BTCDelegation mocks base method.
+func (m *MockBabylonNodeAdapter) BTCDelegation(stakingTxHash chainhash.Hash) (*Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BTCDelegation", stakingTxHash)
+	ret0, _ := ret[0].(*Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BTCDelegation indicates an expected call of BTCDelegation.
+func (mr *MockBabylonNodeAdapterMockRecorder) BTCDelegation(stakingTxHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BTCDelegation", reflect.TypeOf((*MockBabylonNodeAdapter)(nil).BTCDelegation), stakingTxHash)
+}
+
 // BtcClientTipHeight mocks base method.
 func (m *MockBabylonNodeAdapter) BtcClientTipHeight() (uint32, error) {
 	m.ctrl.T.Helper()
@@ -155,3 +171,32 @@ func (mr *MockBabylonNodeAdapterMockRecorder) ReportUnbonding(ctx, stakingTxHash
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportUnbonding", reflect.TypeOf((*MockBabylonNodeAdapter)(nil).ReportUnbonding), ctx, stakingTxHash, stakeSpendingTx, inclusionProof)
 }
+
+// SubscribeDelegationEvents mocks base method.
+func (m *MockBabylonNodeAdapter) SubscribeDelegationEvents() ([]<-chan types1.ResultEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeDelegationEvents")
+	ret0, _ := ret[0].([]<-chan types1.ResultEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeDelegationEvents indicates an expected call of SubscribeDelegationEvents.
+func (mr *MockBabylonNodeAdapterMockRecorder) SubscribeDelegationEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeDelegationEvents", reflect.TypeOf((*MockBabylonNodeAdapter)(nil).SubscribeDelegationEvents))
+}
+
+// UnsubscribeDelegationEvents mocks base method.
+func (m *MockBabylonNodeAdapter) UnsubscribeDelegationEvents() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeDelegationEvents")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeDelegationEvents indicates an expected call of UnsubscribeDelegationEvents.
+func (mr *MockBabylonNodeAdapterMockRecorder) UnsubscribeDelegationEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeDelegationEvents", reflect.TypeOf((*MockBabylonNodeAdapter)(nil).UnsubscribeDelegationEvents))
+}
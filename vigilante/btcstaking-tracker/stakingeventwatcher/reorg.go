@@ -0,0 +1,146 @@
+package stakingeventwatcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	notifier "github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+const (
+	proofTypeActivation = "activation"
+	proofTypeUnbonding  = "unbonding"
+)
+
+// errInclusionBlockReorged is the cause of the cancellation of the submission of an
+// inclusion proof whose btc block is no longer in the best chain
+var errInclusionBlockReorged = errors.New("inclusion block is reorged out of the btc best chain")
+
+// inclusionBlock is the btc block including a tx
+type inclusionBlock struct {
+	hash   chainhash.Hash
+	height uint32
+}
+
+// inFlightProof is an inclusion proof of a tx being submitted to babylon
+type inFlightProof struct {
+	proofType string
+	pkScript  []byte
+	block     inclusionBlock
+	cancel    context.CancelCauseFunc
+}
+
+// inFlightProofs keeps track of the inclusion proofs being submitted to babylon,
+// so that their submission is cancelled if their inclusion block is reorged out
+type inFlightProofs struct {
+	mu sync.Mutex
+	// key: hash of the included tx
+	proofs map[chainhash.Hash]*inFlightProof
+}
+
+func newInFlightProofs() *inFlightProofs {
+	return &inFlightProofs{
+		proofs: make(map[chainhash.Hash]*inFlightProof),
+	}
+}
+
+func (p *inFlightProofs) add(txHash chainhash.Hash, proof *inFlightProof) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.proofs[txHash] = proof
+}
+
+// remove removes the proof of the given tx, unless it was replaced by another one
+func (p *inFlightProofs) remove(txHash chainhash.Hash, proof *inFlightProof) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.proofs[txHash] == proof {
+		delete(p.proofs, txHash)
+	}
+}
+
+func (p *inFlightProofs) snapshot() map[chainhash.Hash]*inFlightProof {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	proofs := make(map[chainhash.Hash]*inFlightProof, len(p.proofs))
+	for txHash, proof := range p.proofs {
+		proofs[txHash] = proof
+	}
+
+	return proofs
+}
+
+// watchInclusion tracks the inclusion block of the proof of the given tx while it
+// is submitted to babylon. The returned context is cancelled with
+// errInclusionBlockReorged once the block is reorged out, and the returned func
+// stops tracking it.
+func (sew *StakingEventWatcher) watchInclusion(
+	ctx context.Context,
+	proofType string,
+	txHash chainhash.Hash,
+	pkScript []byte,
+	block inclusionBlock,
+) (context.Context, func()) {
+	proofCtx, cancel := context.WithCancelCause(ctx)
+	proof := &inFlightProof{
+		proofType: proofType,
+		pkScript:  pkScript,
+		block:     block,
+		cancel:    cancel,
+	}
+	sew.inFlightProofs.add(txHash, proof)
+
+	return proofCtx, func() {
+		sew.inFlightProofs.remove(txHash, proof)
+		cancel(nil)
+	}
+}
+
+// isReorged returns whether the submission of a proof was cancelled due to a reorg
+func isReorged(proofCtx context.Context) bool {
+	return errors.Is(context.Cause(proofCtx), errInclusionBlockReorged)
+}
+
+// detectReorg returns whether the given new best block does not extend the
+// previous best block, and records it as the best block
+func (sew *StakingEventWatcher) detectReorg(block *notifier.BlockEpoch) bool {
+	prevBestBlockHash := sew.bestBlockHash
+	sew.bestBlockHash = block.Hash
+
+	if prevBestBlockHash == nil || block.BlockHeader == nil {
+		return false
+	}
+
+	return block.BlockHeader.PrevBlock != *prevBestBlockHash
+}
+
+// checkInFlightProofs cancels the submission of the proofs whose inclusion block
+// is no longer in the btc best chain, so that they are built again
+func (sew *StakingEventWatcher) checkInFlightProofs() {
+	defer sew.latency("checkInFlightProofs")()
+
+	for txHash, proof := range sew.inFlightProofs.snapshot() {
+		details, status, err := sew.btcClient.TxDetails(&txHash, proof.pkScript)
+		if err != nil {
+			sew.logger.Errorf("error getting details of tx %s upon reorg: %v", txHash, err)
+
+			continue
+		}
+
+		if status == btcclient.TxInChain && details.BlockHash != nil && *details.BlockHash == proof.block.hash {
+			continue
+		}
+
+		sew.logger.Infof("inclusion block %s at height %d of %s tx %s is reorged out",
+			proof.block.hash, proof.block.height, proof.proofType, txHash)
+		sew.metrics.ReorgedInclusionProofsCounter.WithLabelValues(proof.proofType).Inc()
+		sew.inFlightProofs.remove(txHash, proof)
+		proof.cancel(errInclusionBlockReorged)
+	}
+}
@@ -89,7 +89,20 @@ type StakingEventWatcher struct {
 	unbondingDelegationChan chan *newDelegation
 	unbondingRemovalChan    chan *delegationInactive
 	currentBestBlockHeight  atomic.Uint32
-	activationLimiter       *semaphore.Weighted
+	// only accessed by the routine handling new blocks, once started
+	bestBlockHash     *chainhash.Hash
+	activationLimiter *semaphore.Weighted
+	// inclusion proofs of the activations and unbonding reports being submitted
+	inFlightProofs *inFlightProofs
+}
+
+// Status is a snapshot of the number of BTC delegations in each tracker of the watcher
+type Status struct {
+	UnbondingTracked         int
+	PendingActivations       int
+	ActivationsInProgress    int
+	VerifiedNotInChain       int
+	VerifiedInsufficientConf int
 }
 
 func NewStakingEventWatcher(
@@ -116,6 +129,7 @@ func NewStakingEventWatcher(
 		unbondingDelegationChan:         make(chan *newDelegation),
 		unbondingRemovalChan:            make(chan *delegationInactive),
 		activationLimiter:               semaphore.NewWeighted(maxConcurrentActivations), // todo(lazar): this should be in config
+		inFlightProofs:                  newInFlightProofs(),
 	}
 }
 
@@ -138,6 +152,7 @@ func (sew *StakingEventWatcher) Start() error {
 				panic(fmt.Errorf("received negative block height: %d", block.Height))
 			}
 			sew.currentBestBlockHeight.Store(uint32(block.Height))
+			sew.bestBlockHash = block.Hash
 		case <-sew.quit:
 			startErr = errors.New("watcher quit before finishing start")
 
@@ -146,11 +161,28 @@ func (sew *StakingEventWatcher) Start() error {
 
 		sew.logger.Infof("Initial btc best block height is: %d", sew.currentBestBlockHeight.Load())
 
-		sew.wg.Add(4)
+		if sew.cfg.EnableDelegationEvents {
+			eventChans, err := sew.babylonNodeAdapter.SubscribeDelegationEvents()
+			if err != nil {
+				startErr = err
+
+				return
+			}
+			for _, eventChan := range eventChans {
+				sew.wg.Add(1)
+				go sew.watchDelegationEvents(eventChan)
+			}
+		}
+
+		sew.wg.Add(3)
 		go sew.handleNewBlocks(blockEventNotifier)
 		go sew.handleUnbondedDelegations()
 		go sew.fetchDelegations()
-		go sew.handlerVerifiedDelegations()
+		// upon events, the pending delegations are checked by the scans instead
+		if !sew.cfg.EnableDelegationEvents {
+			sew.wg.Add(1)
+			go sew.handlerVerifiedDelegations()
+		}
 
 		sew.logger.Info("staking event watcher started")
 	})
@@ -162,6 +194,11 @@ func (sew *StakingEventWatcher) Stop() error {
 	var stopErr error
 	sew.stopOnce.Do(func() {
 		sew.logger.Info("stopping staking event watcher")
+		if sew.cfg.EnableDelegationEvents {
+			if err := sew.babylonNodeAdapter.UnsubscribeDelegationEvents(); err != nil {
+				sew.logger.Errorf("failed to unsubscribe from delegation events: %v", err)
+			}
+		}
 		close(sew.quit)
 		sew.wg.Wait()
 		sew.logger.Info("stopped staking event watcher")
@@ -170,6 +207,17 @@ func (sew *StakingEventWatcher) Stop() error {
 	return stopErr
 }
 
+// Status returns the number of BTC delegations currently tracked by the watcher
+func (sew *StakingEventWatcher) Status() *Status {
+	return &Status{
+		UnbondingTracked:         sew.unbondingTracker.Count(),
+		PendingActivations:       sew.pendingTracker.Count(),
+		ActivationsInProgress:    sew.verifiedSufficientConfTracker.Count(),
+		VerifiedNotInChain:       sew.verifiedNotInChainTracker.Count(),
+		VerifiedInsufficientConf: sew.verifiedInsufficientConfTracker.Count(),
+	}
+}
+
 func (sew *StakingEventWatcher) handleNewBlocks(blockNotifier *notifier.BlockEpochEvent) {
 	defer sew.wg.Done()
 	defer blockNotifier.Cancel()
@@ -184,6 +232,14 @@ func (sew *StakingEventWatcher) handleNewBlocks(blockNotifier *notifier.BlockEpo
 			}
 			sew.currentBestBlockHeight.Store(uint32(block.Height))
 			sew.logger.Debugf("Received new best btc block: %d", block.Height)
+			if sew.detectReorg(block) {
+				sew.logger.Infof("btc reorg detected, new best block %s at height %d", block.Hash, block.Height)
+				sew.metrics.DetectedReorgsCounter.Inc()
+				sew.checkInFlightProofs()
+			}
+			if sew.cfg.EnableDelegationEvents {
+				sew.checkBlockForStakingTxs(block)
+			}
 		case <-sew.quit:
 			return
 		}
@@ -218,95 +274,150 @@ func (sew *StakingEventWatcher) checkBabylonDelegations(status btcstakingtypes.B
 	}
 }
 
+// fullScanInterval returns the interval of the scans of all the delegations, which
+// only check the consistency of the trackers if they are updated upon events
+func (sew *StakingEventWatcher) fullScanInterval() time.Duration {
+	if sew.cfg.EnableDelegationEvents {
+		return sew.cfg.ConsistencyCheckInterval
+	}
+
+	return sew.cfg.CheckDelegationsInterval
+}
+
 func (sew *StakingEventWatcher) fetchDelegations() {
 	defer sew.wg.Done()
-	ticker := time.NewTicker(sew.cfg.CheckDelegationsInterval)
+	ticker := time.NewTicker(sew.fullScanInterval())
 	defer ticker.Stop()
 
+	// upon events, the delegations that exist before the start are only found
+	// by a scan, so it is not delayed
+	if sew.cfg.EnableDelegationEvents {
+		sew.scanDelegations()
+	}
+
 	for {
 		select {
 		case <-ticker.C:
-			sew.logger.Debug("Querying babylon for new delegations")
+			sew.scanDelegations()
+		case <-sew.quit:
+			sew.logger.Debug("fetch delegations loop quit")
 
-			nodeSynced, err := sew.syncedWithBabylon()
-			if err != nil || !nodeSynced {
-				// Log message and continue if there's an error or node isn't synced
-				continue
-			}
+			return
+		}
+	}
+}
 
-			addToUnbondingFunc := func(delegation Delegation) {
-				del := &newDelegation{
-					stakingTxHash:         delegation.StakingTx.TxHash(),
-					stakingTx:             delegation.StakingTx,
-					stakingOutputIdx:      delegation.StakingOutputIdx,
-					delegationStartHeight: delegation.DelegationStartHeight,
-					unbondingOutput:       delegation.UnbondingOutput,
-				}
-
-				// if we already have this delegation, we still want to check if it has changed,
-				// we should track both verified and active status for unbonding
-				changed, exists := sew.unbondingTracker.HasDelegationChanged(delegation.StakingTx.TxHash(), del)
-				if exists && changed {
-					// The Delegation exists and has changed, push the update.
-					utils.PushOrQuit(sew.unbondingDelegationChan, del, sew.quit)
-				} else if !exists {
-					// The Delegation doesn't exist, push the new delegation.
-					utils.PushOrQuit(sew.unbondingDelegationChan, del, sew.quit)
-				}
-			}
+// scanDelegations pages through all the verified and active delegations on babylon
+// and tracks the ones that are not tracked yet
+func (sew *StakingEventWatcher) scanDelegations() {
+	sew.logger.Debug("Querying babylon for new delegations")
 
-			addToPendingFunc := func(delegation Delegation) {
-				del := &newDelegation{
-					stakingTxHash:         delegation.StakingTx.TxHash(),
-					stakingTx:             delegation.StakingTx,
-					stakingOutputIdx:      delegation.StakingOutputIdx,
-					delegationStartHeight: delegation.DelegationStartHeight,
-					unbondingOutput:       delegation.UnbondingOutput,
-				}
-				_, exists := sew.pendingTracker.GetDelegation(delegation.StakingTx.TxHash())
-				if !exists && !delegation.HasProof {
-					_, _ = sew.pendingTracker.AddDelegation(
-						del.stakingTx,
-						del.stakingOutputIdx,
-						del.unbondingOutput,
-						del.delegationStartHeight,
-						false,
-					)
-					sew.metrics.NumberOfVerifiedDelegations.Inc()
-				}
-			}
+	nodeSynced, err := sew.syncedWithBabylon()
+	if err != nil || !nodeSynced {
+		// Log message and continue if there's an error or node isn't synced
+		return
+	}
 
-			var wg sync.WaitGroup
-			wg.Add(3)
-
-			go func() {
-				defer wg.Done()
-				if err = sew.checkBabylonDelegations(btcstakingtypes.BTCDelegationStatus_ACTIVE, addToUnbondingFunc); err != nil {
-					sew.logger.Errorf("error checking babylon delegations: %v", err)
-				}
-			}()
-
-			go func() {
-				defer wg.Done()
-				if err = sew.checkBabylonDelegations(btcstakingtypes.BTCDelegationStatus_VERIFIED, addToUnbondingFunc); err != nil {
-					sew.logger.Errorf("error checking babylon delegations: %v", err)
-				}
-			}()
-
-			go func() {
-				defer wg.Done()
-				if err = sew.checkBabylonDelegations(btcstakingtypes.BTCDelegationStatus_VERIFIED, addToPendingFunc); err != nil {
-					sew.logger.Errorf("error checking babylon delegations: %v", err)
-				}
-			}()
-
-			wg.Wait()
-		case <-sew.quit:
-			sew.logger.Debug("fetch delegations loop quit")
+	var wg sync.WaitGroup
+	wg.Add(3)
 
-			return
+	go func() {
+		defer wg.Done()
+		if err := sew.checkBabylonDelegations(btcstakingtypes.BTCDelegationStatus_ACTIVE, sew.trackUnbonding); err != nil {
+			sew.logger.Errorf("error checking babylon delegations: %v", err)
 		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		if err := sew.checkBabylonDelegations(btcstakingtypes.BTCDelegationStatus_VERIFIED, sew.trackUnbonding); err != nil {
+			sew.logger.Errorf("error checking babylon delegations: %v", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		if err := sew.checkBabylonDelegations(btcstakingtypes.BTCDelegationStatus_VERIFIED, sew.trackPending); err != nil {
+			sew.logger.Errorf("error checking babylon delegations: %v", err)
+		}
+	}()
+
+	wg.Wait()
+
+	// upon events, the pending delegations are otherwise only checked upon new
+	// blocks, so the ones found by the scan are checked right away
+	if sew.cfg.EnableDelegationEvents {
+		sew.checkBtcForStakingTx()
+	}
+}
+
+// trackUnbonding sends the delegation to the unbonding tracker, unless it is
+// already tracked and has not changed
+func (sew *StakingEventWatcher) trackUnbonding(delegation Delegation) {
+	del := &newDelegation{
+		stakingTxHash:         delegation.StakingTx.TxHash(),
+		stakingTx:             delegation.StakingTx,
+		stakingOutputIdx:      delegation.StakingOutputIdx,
+		delegationStartHeight: delegation.DelegationStartHeight,
+		unbondingOutput:       delegation.UnbondingOutput,
+	}
+
+	// if we already have this delegation, we still want to check if it has changed,
+	// we should track both verified and active status for unbonding
+	changed, exists := sew.unbondingTracker.HasDelegationChanged(delegation.StakingTx.TxHash(), del)
+	if exists && changed {
+		// The Delegation exists and has changed, push the update.
+		utils.PushOrQuit(sew.unbondingDelegationChan, del, sew.quit)
+	} else if !exists {
+		// The Delegation doesn't exist, push the new delegation.
+		utils.PushOrQuit(sew.unbondingDelegationChan, del, sew.quit)
+	}
+}
+
+// trackPending adds the verified delegation without inclusion proof to the
+// pending tracker, to be activated once its staking tx is k-deep
+func (sew *StakingEventWatcher) trackPending(delegation Delegation) {
+	_ = sew.addPending(delegation)
+}
+
+// addPending returns the delegation added to the pending tracker, nil if it is
+// already tracked or has an inclusion proof
+func (sew *StakingEventWatcher) addPending(delegation Delegation) *TrackedDelegation {
+	stakingTxHash := delegation.StakingTx.TxHash()
+	if _, exists := sew.pendingTracker.GetDelegation(stakingTxHash); exists || delegation.HasProof {
+		return nil
+	}
+
+	del, err := sew.pendingTracker.AddDelegation(
+		delegation.StakingTx,
+		delegation.StakingOutputIdx,
+		delegation.UnbondingOutput,
+		delegation.DelegationStartHeight,
+		false,
+	)
+	if err != nil {
+		return nil
 	}
+	sew.metrics.NumberOfVerifiedDelegations.Inc()
+
+	return del.Clone()
+}
+
+// untrackPending removes the delegation that is no longer verified from the
+// pending tracker, unless it is being activated
+func (sew *StakingEventWatcher) untrackPending(stakingTxHash chainhash.Hash) {
+	del, exists := sew.pendingTracker.GetDelegation(stakingTxHash)
+	if !exists || del.ActivationInProgress {
+		return
+	}
+
+	sew.pendingTracker.RemoveDelegation(stakingTxHash)
+	sew.metrics.NumberOfVerifiedDelegations.Dec()
+	if _, exists := sew.verifiedNotInChainTracker.GetDelegation(stakingTxHash); exists {
+		sew.verifiedNotInChainTracker.RemoveDelegation(stakingTxHash)
+		sew.metrics.NumberOfVerifiedNotInChainDelegations.Set(float64(sew.verifiedNotInChainTracker.Count()))
+	}
+	sew.logger.Debugf("delegation with staking tx %s is no longer pending activation", stakingTxHash)
 }
 
 func (sew *StakingEventWatcher) syncedWithBabylon() (bool, error) {
@@ -397,6 +508,13 @@ func (sew *StakingEventWatcher) reportUnbondingToBabylon(
 			return nil
 		}
 
+		// in dry-run mode, the tracker shadows a production deployment which reports the unbonding
+		if sew.cfg.DryRun {
+			sew.logger.Infof("dry run: skip reporting the unbonding of staking tx %s", stakingTxHash)
+
+			return nil
+		}
+
 		if err = sew.babylonNodeAdapter.ReportUnbonding(ctx, stakingTxHash, stakeSpendingTx, proof); err != nil {
 			sew.metrics.FailedReportedUnbondingTransactions.Inc()
 
@@ -442,25 +560,21 @@ func (sew *StakingEventWatcher) watchForSpend(spendEvent *notifier.SpendEvent, t
 		// As we only care about unbonding transactions, we do not need to take additional actions.
 		// We start polling babylon for delegation to stop being active, and then delete it from unbondingTracker.
 		sew.logger.Debugf("Spending tx %s for staking tx %s is not unbonding tx. Info: %v", spendingTxHash, delegationID, err)
-		proof := sew.waitForStakeSpendInclusionProof(quitCtx, spendingTx)
-		if proof == nil {
+		if !sew.reportStakeSpendingTx(quitCtx, delegationID, spendingTx) {
 			sew.logger.Errorf("unbonding tx %s for staking tx %s proof not built", spendingTxHash, delegationID)
 
 			return
 		}
-		sew.reportUnbondingToBabylon(quitCtx, delegationID, spendingTx, proof)
 	} else {
 		sew.metrics.DetectedUnbondingTransactionsCounter.Inc()
 		// We found valid unbonding tx. We need to try to report it to babylon.
 		// We stop reporting if delegation is no longer active or we succeed.
-		proof := sew.waitForStakeSpendInclusionProof(quitCtx, spendingTx)
-		if proof == nil {
+		sew.logger.Debugf("found unbonding tx %s for staking tx %s", spendingTxHash, delegationID)
+		if !sew.reportStakeSpendingTx(quitCtx, delegationID, spendingTx) {
 			sew.logger.Errorf("unbonding tx %s for staking tx %s proof not built", spendingTxHash, delegationID)
 
 			return
 		}
-		sew.logger.Debugf("found unbonding tx %s for staking tx %s", spendingTxHash, delegationID)
-		sew.reportUnbondingToBabylon(quitCtx, delegationID, spendingTx, proof)
 		sew.logger.Debugf("unbonding tx %s for staking tx %s reported to babylon", spendingTxHash, delegationID)
 	}
 
@@ -471,18 +585,48 @@ func (sew *StakingEventWatcher) watchForSpend(spendEvent *notifier.SpendEvent, t
 	)
 }
 
-func (sew *StakingEventWatcher) buildSpendingTxProof(spendingTx *wire.MsgTx) (*btcstakingtypes.InclusionProof, error) {
+// reportStakeSpendingTx reports the tx spending the staking output to babylon once it
+// is included in btc. Its inclusion proof is built again, and reported again, whenever
+// its inclusion block is reorged out before babylon accepts it. It returns false if
+// the proof could not be built.
+func (sew *StakingEventWatcher) reportStakeSpendingTx(
+	ctx context.Context,
+	stakingTxHash chainhash.Hash,
+	spendingTx *wire.MsgTx,
+) bool {
+	spendingTxHash := spendingTx.TxHash()
+
+	for {
+		proof, block := sew.waitForStakeSpendInclusionProof(ctx, spendingTx)
+		if proof == nil {
+			return false
+		}
+
+		proofCtx, stopWatching := sew.watchInclusion(ctx, proofTypeUnbonding, spendingTxHash, spendingTx.TxOut[0].PkScript, *block)
+		sew.reportUnbondingToBabylon(proofCtx, stakingTxHash, spendingTx, proof)
+		stopWatching()
+
+		if !isReorged(proofCtx) {
+			return true
+		}
+		sew.logger.Infof("rebuilding the proof of stake spending tx %s for staking tx %s upon reorg", spendingTxHash, stakingTxHash)
+	}
+}
+
+// buildSpendingTxProof returns the inclusion proof of the stake spending tx with its
+// inclusion block, nil if it is not in the btc best chain yet
+func (sew *StakingEventWatcher) buildSpendingTxProof(spendingTx *wire.MsgTx) (*btcstakingtypes.InclusionProof, *inclusionBlock, error) {
 	txHash := spendingTx.TxHash()
 	if len(spendingTx.TxOut) == 0 {
 		panic(fmt.Errorf("stake spending tx has no outputs %s", spendingTx.TxHash().String())) // this is a software error
 	}
 	details, status, err := sew.btcClient.TxDetails(&txHash, spendingTx.TxOut[0].PkScript)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if status != btcclient.TxInChain {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	btcTxs := types.GetWrappedTxs(details.Block)
@@ -490,23 +634,26 @@ func (sew *StakingEventWatcher) buildSpendingTxProof(spendingTx *wire.MsgTx) (*b
 
 	proof, err := ib.GenSPVProof(int(details.TxIndex))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return btcstakingtypes.NewInclusionProofFromSpvProof(proof), nil
+	block := &inclusionBlock{hash: details.Block.BlockHash(), height: details.BlockHeight}
+
+	return btcstakingtypes.NewInclusionProofFromSpvProof(proof), block, nil
 }
 
 // waitForStakeSpendInclusionProof polls btc until stake spend tx has inclusion proof built
 func (sew *StakingEventWatcher) waitForStakeSpendInclusionProof(
 	ctx context.Context,
 	spendingTx *wire.MsgTx,
-) *btcstakingtypes.InclusionProof {
+) (*btcstakingtypes.InclusionProof, *inclusionBlock) {
 	var (
 		proof *btcstakingtypes.InclusionProof
+		block *inclusionBlock
 		err   error
 	)
 	_ = retry.Do(func() error {
-		proof, err = sew.buildSpendingTxProof(spendingTx)
+		proof, block, err = sew.buildSpendingTxProof(spendingTx)
 		if err != nil {
 			return err
 		}
@@ -527,7 +674,7 @@ func (sew *StakingEventWatcher) waitForStakeSpendInclusionProof(
 		}),
 	)
 
-	return proof
+	return proof, block
 }
 
 func (sew *StakingEventWatcher) handleUnbondedDelegations() {
@@ -589,7 +736,7 @@ func (sew *StakingEventWatcher) handleUnbondedDelegations() {
 
 func (sew *StakingEventWatcher) handlerVerifiedDelegations() {
 	defer sew.wg.Done()
-	ticker := time.NewTicker(sew.cfg.CheckDelegationsInterval) // todo(lazar): use different interval in config
+	ticker := time.NewTicker(sew.fullScanInterval()) // todo(lazar): use different interval in config
 	defer ticker.Stop()
 
 	for {
@@ -616,66 +763,104 @@ func (sew *StakingEventWatcher) checkBtcForStakingTx() {
 	}
 
 	for del := range sew.pendingTracker.DelegationsIter(1000) {
-		if del.ActivationInProgress {
-			continue
-		}
-		txHash := del.StakingTx.TxHash()
-
-		details, status, err := sew.btcClient.TxDetails(&txHash, del.StakingTx.TxOut[del.StakingOutputIdx].PkScript)
-		if err != nil {
-			sew.logger.Debugf("error getting tx %v", txHash)
+		sew.checkPendingDelegation(del, params)
+	}
+}
 
-			continue
-		}
+// checkPendingDelegation checks if the staking tx of the delegation is in BTC, and
+// if so starts its activation
+func (sew *StakingEventWatcher) checkPendingDelegation(del *TrackedDelegation, params *BabylonParams) {
+	if del.ActivationInProgress {
+		return
+	}
+	txHash := del.StakingTx.TxHash()
 
-		if status != btcclient.TxInChain {
-			if err := sew.verifiedNotInChainTracker.AddEmptyDelegation(txHash); err == nil {
-				sew.metrics.NumberOfVerifiedNotInChainDelegations.Set(float64(sew.verifiedNotInChainTracker.Count()))
-			}
+	proof, block, err := sew.buildStakingTxProof(del)
+	if err != nil {
+		sew.logger.Debugf("error building proof of staking tx %v: %v", txHash, err)
 
-			continue
-		}
+		return
+	}
 
-		if _, exists := sew.verifiedNotInChainTracker.GetDelegation(txHash); exists {
-			sew.verifiedNotInChainTracker.RemoveDelegation(txHash)
+	if proof == nil {
+		if err := sew.verifiedNotInChainTracker.AddEmptyDelegation(txHash); err == nil {
 			sew.metrics.NumberOfVerifiedNotInChainDelegations.Set(float64(sew.verifiedNotInChainTracker.Count()))
 		}
 
-		btcTxs := types.GetWrappedTxs(details.Block)
-		ib := types.NewIndexedBlock(details.BlockHeight, &details.Block.Header, btcTxs)
+		return
+	}
 
-		proof, err := ib.GenSPVProof(int(details.TxIndex))
-		if err != nil {
-			sew.logger.Debugf("error making spv proof %s", err)
+	sew.startActivation(del, proof, *block, params.ConfirmationTimeBlocks)
+}
 
-			continue
-		}
+// buildStakingTxProof returns the inclusion proof of the staking tx of the delegation
+// with its inclusion block, nil if it is not in the btc best chain
+func (sew *StakingEventWatcher) buildStakingTxProof(del *TrackedDelegation) (*btcctypes.BTCSpvProof, *inclusionBlock, error) {
+	txHash := del.StakingTx.TxHash()
 
-		if err := sew.activationLimiter.Acquire(context.Background(), 1); err != nil {
-			sew.logger.Warnf("error acquiring a activation semaphore %s", err)
+	details, status, err := sew.btcClient.TxDetails(&txHash, del.StakingTx.TxOut[del.StakingOutputIdx].PkScript)
+	if err != nil {
+		return nil, nil, err
+	}
 
-			continue
-		}
+	if status != btcclient.TxInChain {
+		return nil, nil, nil
+	}
+
+	btcTxs := types.GetWrappedTxs(details.Block)
+	ib := types.NewIndexedBlock(details.BlockHeight, &details.Block.Header, btcTxs)
 
-		if err := sew.pendingTracker.UpdateActivation(txHash, true); err != nil {
-			sew.logger.Debugf("error updating activation in pending tracker tx: %v", txHash)
-			sew.activationLimiter.Release(1) // in probable edge case, insure we release the sem
+	proof, err := ib.GenSPVProof(int(details.TxIndex))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error making spv proof: %w", err)
+	}
 
-			continue
-		}
+	return proof, &inclusionBlock{hash: details.Block.BlockHash(), height: details.BlockHeight}, nil
+}
+
+// startActivation activates the delegation whose staking tx is included in the
+// given block in the background, unless too many activations are in progress
+func (sew *StakingEventWatcher) startActivation(
+	del *TrackedDelegation,
+	proof *btcctypes.BTCSpvProof,
+	block inclusionBlock,
+	requiredDepth uint32,
+) {
+	txHash := del.StakingTx.TxHash()
+	pkScript := del.StakingTx.TxOut[del.StakingOutputIdx].PkScript
 
-		go func() {
-			defer sew.activationLimiter.Release(1)
-			sew.activateBtcDelegation(txHash, proof, details.Block.BlockHash(), params.ConfirmationTimeBlocks)
-		}()
+	if _, exists := sew.verifiedNotInChainTracker.GetDelegation(txHash); exists {
+		sew.verifiedNotInChainTracker.RemoveDelegation(txHash)
+		sew.metrics.NumberOfVerifiedNotInChainDelegations.Set(float64(sew.verifiedNotInChainTracker.Count()))
 	}
+
+	if err := sew.activationLimiter.Acquire(context.Background(), 1); err != nil {
+		sew.logger.Warnf("error acquiring a activation semaphore %s", err)
+
+		return
+	}
+
+	if err := sew.pendingTracker.UpdateActivation(txHash, true); err != nil {
+		sew.logger.Debugf("error updating activation in pending tracker tx: %v", txHash)
+		sew.activationLimiter.Release(1) // in probable edge case, insure we release the sem
+
+		return
+	}
+
+	go func() {
+		defer sew.activationLimiter.Release(1)
+		sew.activateBtcDelegation(txHash, pkScript, proof, block, requiredDepth)
+	}()
 }
 
-// activateBtcDelegation invokes bbn client and send MsgAddBTCDelegationInclusionProof
+// activateBtcDelegation invokes bbn client and send MsgAddBTCDelegationInclusionProof.
+// The proof is built again if its inclusion block is reorged out before babylon
+// accepts it, provided that the staking tx is included in the new best chain.
 func (sew *StakingEventWatcher) activateBtcDelegation(
 	stakingTxHash chainhash.Hash,
+	pkScript []byte,
 	proof *btcctypes.BTCSpvProof,
-	inclusionBlockHash chainhash.Hash,
+	block inclusionBlock,
 	requiredDepth uint32,
 ) {
 	sew.metrics.NumberOfActivationInProgress.Inc()
@@ -691,7 +876,45 @@ func (sew *StakingEventWatcher) activateBtcDelegation(
 		}
 	}()
 
+	for {
+		proofCtx, stopWatching := sew.watchInclusion(ctx, proofTypeActivation, stakingTxHash, pkScript, block)
+		sew.submitActivation(proofCtx, stakingTxHash, proof, block.hash, requiredDepth)
+		stopWatching()
+
+		if !isReorged(proofCtx) {
+			return
+		}
+
+		del, exists := sew.pendingTracker.GetDelegation(stakingTxHash)
+		if !exists {
+			return
+		}
+		newProof, newBlock, err := sew.buildStakingTxProof(del)
+		if err != nil || newProof == nil {
+			// the delegation stays pending until its staking tx is included again
+			sew.logger.Infof("staking tx %s is not in the btc best chain after reorg, will try later", stakingTxHash)
+
+			return
+		}
+		sew.logger.Infof("rebuilding the proof of staking tx %s in block %s upon reorg", stakingTxHash, newBlock.hash)
+		proof, block = newProof, *newBlock
+	}
+}
+
+// submitActivation waits for the inclusion block of the staking tx to be k-deep on
+// babylon, and then submits the inclusion proof of the staking tx
+func (sew *StakingEventWatcher) submitActivation(
+	ctx context.Context,
+	stakingTxHash chainhash.Hash,
+	proof *btcctypes.BTCSpvProof,
+	inclusionBlockHash chainhash.Hash,
+	requiredDepth uint32,
+) {
 	if err := sew.waitForRequiredDepth(ctx, stakingTxHash, &inclusionBlockHash, requiredDepth); err != nil {
+		if isReorged(ctx) {
+			return
+		}
+
 		sew.logger.Warnf("exceeded waiting for required depth for tx: %s, will try later: err %v", stakingTxHash.String(), err)
 
 		if err := sew.verifiedInsufficientConfTracker.AddEmptyDelegation(stakingTxHash); err == nil {
@@ -724,13 +947,19 @@ func (sew *StakingEventWatcher) activateBtcDelegation(
 			return nil
 		}
 
-		if err := sew.babylonNodeAdapter.ActivateDelegation(ctx, stakingTxHash, proof); err != nil {
-			sew.metrics.FailedReportedActivateDelegations.Inc()
+		// in dry-run mode, the delegation is only dropped as if the production deployment
+		// activated it, so that its activation is not attempted again
+		if sew.cfg.DryRun {
+			sew.logger.Infof("dry run: skip activating the delegation of staking tx %s", stakingTxHash)
+		} else {
+			if err := sew.babylonNodeAdapter.ActivateDelegation(ctx, stakingTxHash, proof); err != nil {
+				sew.metrics.FailedReportedActivateDelegations.Inc()
 
-			return fmt.Errorf("error reporting activate delegation tx %s to babylon: %w", stakingTxHash, err)
-		}
+				return fmt.Errorf("error reporting activate delegation tx %s to babylon: %w", stakingTxHash, err)
+			}
 
-		sew.metrics.ReportedActivateDelegationsCounter.Inc()
+			sew.metrics.ReportedActivateDelegationsCounter.Inc()
+		}
 		sew.pendingTracker.RemoveDelegation(stakingTxHash)
 		sew.metrics.NumberOfVerifiedDelegations.Dec()
 
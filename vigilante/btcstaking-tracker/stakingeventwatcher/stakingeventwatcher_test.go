@@ -1,11 +1,22 @@
 package stakingeventwatcher
 
 import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	btcstakingtypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/babylonlabs-io/vigilante/btcclient"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
 	"github.com/babylonlabs-io/vigilante/testutil/mocks"
+	"github.com/babylonlabs-io/vigilante/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/golang/mock/gomock"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
@@ -25,6 +36,7 @@ func TestHandlingDelegations(t *testing.T) {
 
 	cfg := config.DefaultBTCStakingTrackerConfig()
 	cfg.CheckDelegationsInterval = 1 * time.Second
+	cfg.EnableDelegationEvents = false
 
 	mockBTCClient := mocks.NewMockBTCClient(ctrl)
 	mockBabylonNodeAdapter := NewMockBabylonNodeAdapter(ctrl)
@@ -46,6 +58,7 @@ func TestHandlingDelegations(t *testing.T) {
 		unbondingDelegationChan:         make(chan *newDelegation),
 		unbondingRemovalChan:            make(chan *delegationInactive),
 		activationLimiter:               semaphore.NewWeighted(30),
+		inFlightProofs:                  newInFlightProofs(),
 		metrics:                         bsMetrics.UnbondingWatcherMetrics,
 	}
 
@@ -99,3 +112,278 @@ func TestHandlingDelegations(t *testing.T) {
 		return promtestutil.ToFloat64(sew.metrics.ReportedActivateDelegationsCounter) >= float64(expectedActivated)
 	}, 60*time.Second, 100*time.Millisecond)
 }
+
+func TestHandlingDelegationEvents(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := config.DefaultBTCStakingTrackerConfig()
+	cfg.EnableDelegationEvents = true
+
+	mockBTCClient := mocks.NewMockBTCClient(ctrl)
+	mockBabylonNodeAdapter := NewMockBabylonNodeAdapter(ctrl)
+	bsMetrics := metrics.NewBTCStakingTrackerMetrics()
+
+	numDels := 20
+	sew := StakingEventWatcher{
+		logger:                          zap.NewNop().Sugar(),
+		quit:                            make(chan struct{}),
+		cfg:                             &cfg,
+		babylonNodeAdapter:              mockBabylonNodeAdapter,
+		btcClient:                       mockBTCClient,
+		unbondingTracker:                NewTrackedDelegations(),
+		pendingTracker:                  NewTrackedDelegations(),
+		verifiedInsufficientConfTracker: NewTrackedDelegations(),
+		verifiedNotInChainTracker:       NewTrackedDelegations(),
+		verifiedSufficientConfTracker:   NewTrackedDelegations(),
+		unbondingDelegationChan:         make(chan *newDelegation, numDels+1),
+		unbondingRemovalChan:            make(chan *delegationInactive),
+		activationLimiter:               semaphore.NewWeighted(30),
+		inFlightProofs:                  newInFlightProofs(),
+		metrics:                         bsMetrics.UnbondingWatcherMetrics,
+	}
+	defer close(sew.quit)
+
+	params := BabylonParams{ConfirmationTimeBlocks: 1}
+	mockBabylonNodeAdapter.EXPECT().Params().Return(&params, nil).AnyTimes()
+
+	// the covenant quorum is reached for new delegations, whose staking txs are not in BTC yet
+	stakingTxs := make([]*wire.MsgTx, 0, numDels)
+	quorumEvent := coretypes.ResultEvent{Events: map[string][]string{}}
+	quorumKey := eventCovenantQuorumReached + ".staking_tx_hash"
+	for i := 0; i < numDels; i++ {
+		stk := datagen.GenRandomTx(r)
+		stakingTxs = append(stakingTxs, stk)
+		stkHash := stk.TxHash()
+		quorumEvent.Events[quorumKey] = append(quorumEvent.Events[quorumKey], fmt.Sprintf("%q", stkHash.String()))
+		mockBabylonNodeAdapter.EXPECT().BTCDelegation(stkHash).Return(&Delegation{
+			StakingTx:        stk,
+			StakingOutputIdx: 0,
+			UnbondingOutput:  stk.TxOut[0],
+			Status:           btcstakingtypes.BTCDelegationStatus_VERIFIED,
+		}, nil).Times(1)
+	}
+	mockBTCClient.EXPECT().TxDetails(gomock.Any(), gomock.Any()).Return(nil, btcclient.TxNotFound, nil).Times(numDels)
+
+	sew.handleDelegationEvent(&quorumEvent)
+	require.Equal(t, numDels, sew.pendingTracker.Count())
+	require.Equal(t, numDels, sew.verifiedNotInChainTracker.Count())
+	require.Len(t, sew.unbondingDelegationChan, numDels)
+
+	// a delegation activated by another party is no longer pending
+	activatedHash := stakingTxs[0].TxHash()
+	mockBabylonNodeAdapter.EXPECT().BTCDelegation(activatedHash).Return(&Delegation{
+		StakingTx:             stakingTxs[0],
+		StakingOutputIdx:      0,
+		UnbondingOutput:       stakingTxs[0].TxOut[0],
+		DelegationStartHeight: 100,
+		HasProof:              true,
+		Status:                btcstakingtypes.BTCDelegationStatus_ACTIVE,
+	}, nil).Times(1)
+	sew.handleDelegationEvent(&coretypes.ResultEvent{Events: map[string][]string{
+		eventBTCDelegationInclusionProofReceived + ".staking_tx_hash": {fmt.Sprintf("%q", activatedHash.String())},
+	}})
+	_, exists := sew.pendingTracker.GetDelegation(activatedHash)
+	require.False(t, exists)
+	// its start height changed, so the unbonding tracker is updated
+	require.Len(t, sew.unbondingDelegationChan, numDels+1)
+
+	// the other staking txs are included in a new BTC block
+	block, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+	block.Transactions = append(block.Transactions, stakingTxs[1:]...)
+	blockHash := block.BlockHash()
+	ib := types.NewIndexedBlock(100, &block.Header, types.GetWrappedTxs(block))
+	mockBTCClient.EXPECT().GetBlockByHash(&blockHash).Return(ib, block, nil).Times(1)
+	mockBabylonNodeAdapter.EXPECT().QueryHeaderDepth(gomock.Any()).Return(uint32(2), nil).AnyTimes()
+	mockBabylonNodeAdapter.EXPECT().IsDelegationVerified(gomock.Any()).Return(true, nil).AnyTimes()
+	mockBabylonNodeAdapter.EXPECT().ActivateDelegation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(numDels - 1)
+
+	sew.checkBlockForStakingTxs(&chainntnfs.BlockEpoch{Hash: &blockHash, Height: 100, BlockHeader: &block.Header})
+
+	require.Eventually(t, func() bool {
+		return promtestutil.ToFloat64(sew.metrics.ReportedActivateDelegationsCounter) >= float64(numDels-1)
+	}, 30*time.Second, 100*time.Millisecond)
+	require.Equal(t, 0, sew.verifiedNotInChainTracker.Count())
+	require.Eventually(t, func() bool {
+		return sew.pendingTracker.Count() == 0
+	}, 30*time.Second, 100*time.Millisecond)
+}
+
+func TestParseDelegationEvents(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	createdTx := datagen.GenRandomTx(r)
+	var txBuf bytes.Buffer
+	require.NoError(t, createdTx.Serialize(&txBuf))
+	expiredHash := datagen.GenRandomBtcdHash(r)
+	unbondedHash := datagen.GenRandomBtcdHash(r)
+
+	events, err := parseDelegationEvents(&coretypes.ResultEvent{Events: map[string][]string{
+		eventBTCDelegationCreated + ".staking_tx_hex":        {fmt.Sprintf("%q", hex.EncodeToString(txBuf.Bytes()))},
+		eventBTCDelegationExpired + ".staking_tx_hash":       {fmt.Sprintf("%q", expiredHash.String())},
+		eventBTCDelegationUnbondedEarly + ".staking_tx_hash": {unbondedHash.String()},
+		"tm.event": {"Tx"},
+	}})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*delegationEvent{
+		{stakingTxHash: createdTx.TxHash(), created: true},
+		{stakingTxHash: expiredHash},
+		{stakingTxHash: unbondedHash},
+	}, events)
+
+	_, err = parseDelegationEvents(&coretypes.ResultEvent{Events: map[string][]string{
+		eventCovenantQuorumReached + ".staking_tx_hash": {`"not a hash"`},
+	}})
+	require.Error(t, err)
+}
+
+func TestReorgedInclusionProofs(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := config.DefaultBTCStakingTrackerConfig()
+	cfg.RetrySubmitUnbondingTxInterval = 100 * time.Millisecond
+	cfg.RetryJitter = 10 * time.Millisecond
+
+	mockBTCClient := mocks.NewMockBTCClient(ctrl)
+	mockBabylonNodeAdapter := NewMockBabylonNodeAdapter(ctrl)
+	bsMetrics := metrics.NewBTCStakingTrackerMetrics()
+
+	bestBlockHash := datagen.GenRandomBtcdHash(r)
+	sew := StakingEventWatcher{
+		logger:                          zap.NewNop().Sugar(),
+		quit:                            make(chan struct{}),
+		cfg:                             &cfg,
+		babylonNodeAdapter:              mockBabylonNodeAdapter,
+		btcClient:                       mockBTCClient,
+		unbondingTracker:                NewTrackedDelegations(),
+		pendingTracker:                  NewTrackedDelegations(),
+		verifiedInsufficientConfTracker: NewTrackedDelegations(),
+		verifiedNotInChainTracker:       NewTrackedDelegations(),
+		verifiedSufficientConfTracker:   NewTrackedDelegations(),
+		unbondingDelegationChan:         make(chan *newDelegation),
+		unbondingRemovalChan:            make(chan *delegationInactive),
+		activationLimiter:               semaphore.NewWeighted(30),
+		inFlightProofs:                  newInFlightProofs(),
+		bestBlockHash:                   &bestBlockHash,
+		metrics:                         bsMetrics.UnbondingWatcherMetrics,
+	}
+	defer close(sew.quit)
+
+	// a block extending the best chain is not a reorg
+	nextBlock, _ := datagen.GenRandomBtcdBlock(r, 0, &bestBlockHash)
+	nextBlockHash := nextBlock.BlockHash()
+	require.False(t, sew.detectReorg(&chainntnfs.BlockEpoch{Hash: &nextBlockHash, Height: 101, BlockHeader: &nextBlock.Header}))
+	// a block forking from an ancestor is
+	forkBlock, _ := datagen.GenRandomBtcdBlock(r, 0, &bestBlockHash)
+	forkBlockHash := forkBlock.BlockHash()
+	require.True(t, sew.detectReorg(&chainntnfs.BlockEpoch{Hash: &forkBlockHash, Height: 101, BlockHeader: &forkBlock.Header}))
+
+	genIncludingBlock := func(tx *wire.MsgTx, height uint32) (*wire.MsgBlock, *chainntnfs.TxConfirmation) {
+		block, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+		block.Transactions = append(block.Transactions, tx)
+		blockHash := block.BlockHash()
+
+		return block, &chainntnfs.TxConfirmation{
+			BlockHash:   &blockHash,
+			BlockHeight: height,
+			TxIndex:     uint32(len(block.Transactions) - 1),
+			Block:       block,
+		}
+	}
+
+	// the stake spending tx of an unbonding report stays in its inclusion block
+	spendingTx := datagen.GenRandomTx(r)
+	spendingTxHash := spendingTx.TxHash()
+	_, spendingTxConf := genIncludingBlock(spendingTx, 90)
+	spendingProofCtx, stopWatchingSpendingTx := sew.watchInclusion(context.Background(), proofTypeUnbonding,
+		spendingTxHash, spendingTx.TxOut[0].PkScript, inclusionBlock{hash: *spendingTxConf.BlockHash, height: 90})
+	defer stopWatchingSpendingTx()
+	mockBTCClient.EXPECT().TxDetails(&spendingTxHash, gomock.Any()).Return(spendingTxConf, btcclient.TxInChain, nil).AnyTimes()
+
+	// the staking tx of an activation is included in another block of the new best chain
+	stakingTx := datagen.GenRandomTx(r)
+	stakingTxHash := stakingTx.TxHash()
+	del, err := sew.pendingTracker.AddDelegation(stakingTx, 0, stakingTx.TxOut[0], 0, false)
+	require.NoError(t, err)
+	oldBlock, oldConf := genIncludingBlock(stakingTx, 100)
+	oldIB := types.NewIndexedBlock(100, &oldBlock.Header, types.GetWrappedTxs(oldBlock))
+	oldProof, err := oldIB.GenSPVProof(int(oldConf.TxIndex))
+	require.NoError(t, err)
+	_, newConf := genIncludingBlock(stakingTx, 101)
+
+	mockBabylonNodeAdapter.EXPECT().QueryHeaderDepth(oldConf.BlockHash).Return(uint32(0), nil).AnyTimes()
+	mockBabylonNodeAdapter.EXPECT().QueryHeaderDepth(newConf.BlockHash).Return(uint32(2), nil).AnyTimes()
+	mockBabylonNodeAdapter.EXPECT().IsDelegationVerified(stakingTxHash).Return(true, nil).AnyTimes()
+	mockBabylonNodeAdapter.EXPECT().ActivateDelegation(gomock.Any(), stakingTxHash, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ chainhash.Hash, proof *btcctypes.BTCSpvProof) error {
+			require.Equal(t, newConf.BlockHash[:], proof.ConfirmingBtcHeader.Hash().MustMarshal())
+
+			return nil
+		}).Times(1)
+	mockBTCClient.EXPECT().TxDetails(&stakingTxHash, gomock.Any()).Return(newConf, btcclient.TxInChain, nil).AnyTimes()
+
+	sew.startActivation(del, oldProof, inclusionBlock{hash: *oldConf.BlockHash, height: 100}, 1)
+	require.Eventually(t, func() bool {
+		_, exists := sew.inFlightProofs.snapshot()[stakingTxHash]
+
+		return exists
+	}, 10*time.Second, 10*time.Millisecond)
+
+	sew.checkInFlightProofs()
+
+	require.Eventually(t, func() bool {
+		return promtestutil.ToFloat64(sew.metrics.ReportedActivateDelegationsCounter) == 1
+	}, 30*time.Second, 100*time.Millisecond)
+	require.Equal(t, float64(1), promtestutil.ToFloat64(sew.metrics.ReorgedInclusionProofsCounter.WithLabelValues(proofTypeActivation)))
+	require.Equal(t, float64(0), promtestutil.ToFloat64(sew.metrics.ReorgedInclusionProofsCounter.WithLabelValues(proofTypeUnbonding)))
+	require.NoError(t, spendingProofCtx.Err())
+	require.Equal(t, 0, sew.pendingTracker.Count())
+}
+
+func TestDryRunSkipsBabylonTxs(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := config.DefaultBTCStakingTrackerConfig()
+	cfg.DryRun = true
+
+	// neither ActivateDelegation nor ReportUnbonding is expected
+	mockBabylonNodeAdapter := NewMockBabylonNodeAdapter(ctrl)
+	mockBabylonNodeAdapter.EXPECT().QueryHeaderDepth(gomock.Any()).Return(uint32(2), nil).AnyTimes()
+	mockBabylonNodeAdapter.EXPECT().IsDelegationVerified(gomock.Any()).Return(true, nil).AnyTimes()
+	mockBabylonNodeAdapter.EXPECT().IsDelegationActive(gomock.Any()).Return(true, nil).AnyTimes()
+	bsMetrics := metrics.NewBTCStakingTrackerMetrics()
+
+	sew := StakingEventWatcher{
+		logger:                          zap.NewNop().Sugar(),
+		quit:                            make(chan struct{}),
+		cfg:                             &cfg,
+		babylonNodeAdapter:              mockBabylonNodeAdapter,
+		pendingTracker:                  NewTrackedDelegations(),
+		verifiedInsufficientConfTracker: NewTrackedDelegations(),
+		verifiedSufficientConfTracker:   NewTrackedDelegations(),
+		metrics:                         bsMetrics.UnbondingWatcherMetrics,
+	}
+
+	stakingTx := datagen.GenRandomTx(r)
+	stakingTxHash := stakingTx.TxHash()
+	_, err := sew.pendingTracker.AddDelegation(stakingTx, 0, nil, 0, false)
+	require.NoError(t, err)
+
+	sew.submitActivation(context.Background(), stakingTxHash, &btcctypes.BTCSpvProof{}, chainhash.Hash{}, 1)
+	// the delegation is dropped as if activated, so that it is not activated again
+	_, exists := sew.pendingTracker.GetDelegation(stakingTxHash)
+	require.False(t, exists)
+	require.Zero(t, promtestutil.ToFloat64(sew.metrics.ReportedActivateDelegationsCounter))
+
+	sew.reportUnbondingToBabylon(context.Background(), stakingTxHash, datagen.GenRandomTx(r), &btcstakingtypes.InclusionProof{})
+	require.Zero(t, promtestutil.ToFloat64(sew.metrics.ReportedUnbondingTransactionsCounter))
+}
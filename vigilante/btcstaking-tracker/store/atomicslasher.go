@@ -0,0 +1,250 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/vigilante/proto"
+)
+
+// TrackedDelegation is a BTC delegation whose slashing tx and unbonding slashing
+// tx are watched by the atomic slasher
+type TrackedDelegation struct {
+	StakingTxHash           chainhash.Hash
+	SlashingTxHash          chainhash.Hash
+	UnbondingSlashingTxHash chainhash.Hash
+}
+
+// SlashingReport is a slashing tx of a tracked delegation found in a BTC block,
+// kept until the selective slashing is handled
+type SlashingReport struct {
+	Path          SlashingPath
+	StakingTxHash chainhash.Hash
+	SlashingTx    *wire.MsgTx
+	BTCHeight     uint32
+}
+
+// PutTrackedDelegations creates or overwrites the tracked delegations of their
+// staking txs in a single transaction
+func (s *BTCStakingTrackerStore) PutTrackedDelegations(dels []*TrackedDelegation) error {
+	delsBytes := make([][]byte, len(dels))
+	for i, del := range dels {
+		delBytes, err := pm.Marshal(&proto.TrackedDelegation{
+			StakingTxHash:           del.StakingTxHash[:],
+			SlashingTxHash:          del.SlashingTxHash[:],
+			UnbondingSlashingTxHash: del.UnbondingSlashingTxHash[:],
+		})
+		if err != nil {
+			return err
+		}
+		delsBytes[i] = delBytes
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(trackedDelegationsBucketName)
+		if bucket == nil {
+			return ErrCorruptedDB
+		}
+
+		for i, del := range dels {
+			if err := bucket.Put(del.StakingTxHash[:], delsBytes[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// DeleteTrackedDelegation stops tracking the delegation of the given staking tx
+func (s *BTCStakingTrackerStore) DeleteTrackedDelegation(stakingTxHash chainhash.Hash) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(trackedDelegationsBucketName)
+		if bucket == nil {
+			return ErrCorruptedDB
+		}
+
+		return bucket.Delete(stakingTxHash[:])
+	})
+}
+
+// TrackedDelegations returns all the tracked delegations
+func (s *BTCStakingTrackerStore) TrackedDelegations() ([]*TrackedDelegation, error) {
+	var dels []*TrackedDelegation
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(trackedDelegationsBucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		return b.ForEach(func(_, v []byte) error {
+			protoDel := &proto.TrackedDelegation{}
+			if err := pm.Unmarshal(v, protoDel); err != nil {
+				return fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+			}
+
+			del := &TrackedDelegation{}
+			if err := del.StakingTxHash.SetBytes(protoDel.StakingTxHash); err != nil {
+				return fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+			}
+			if err := del.SlashingTxHash.SetBytes(protoDel.SlashingTxHash); err != nil {
+				return fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+			}
+			if err := del.UnbondingSlashingTxHash.SetBytes(protoDel.UnbondingSlashingTxHash); err != nil {
+				return fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+			}
+			dels = append(dels, del)
+
+			return nil
+		})
+	}, func() {
+		dels = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dels, nil
+}
+
+// PutProcessedBlock atomically records the slashing reports found in the BTC block
+// at the given height and moves the last processed height to it. The reports whose
+// slashing tx was already seen are skipped, and the new ones are returned.
+func (s *BTCStakingTrackerStore) PutProcessedBlock(height uint32, reports []*SlashingReport) ([]*SlashingReport, error) {
+	reportsBytes := make([][]byte, len(reports))
+	for i, report := range reports {
+		reportBytes, err := report.toBytes()
+		if err != nil {
+			return nil, err
+		}
+		reportsBytes[i] = reportBytes
+	}
+
+	var newReports []*SlashingReport
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		newReports = nil
+
+		seenBucket := tx.ReadWriteBucket(seenSlashingTxsBucketName)
+		pendingBucket := tx.ReadWriteBucket(pendingReportsBucketName)
+		heightBucket := tx.ReadWriteBucket(atomicSlasherHeightBucketName)
+		if seenBucket == nil || pendingBucket == nil || heightBucket == nil {
+			return ErrCorruptedDB
+		}
+
+		for i, report := range reports {
+			slashingTxHash := report.SlashingTx.TxHash()
+			if seenBucket.Get(slashingTxHash[:]) != nil {
+				continue
+			}
+			if err := seenBucket.Put(slashingTxHash[:], uint32ToBytes(height)); err != nil {
+				return err
+			}
+			if err := pendingBucket.Put(slashingTxHash[:], reportsBytes[i]); err != nil {
+				return err
+			}
+			newReports = append(newReports, report)
+		}
+
+		return heightBucket.Put(lastProcessedHeightKey, uint32ToBytes(height))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newReports, nil
+}
+
+// LastProcessedHeight returns the height of the last BTC block scanned for
+// slashing txs, false if none was scanned yet
+func (s *BTCStakingTrackerStore) LastProcessedHeight() (uint32, bool, error) {
+	return s.getUint32(lastProcessedHeightKey, atomicSlasherHeightBucketName)
+}
+
+// IsSlashingTxSeen returns whether the given slashing tx was found in a BTC block
+func (s *BTCStakingTrackerStore) IsSlashingTxSeen(slashingTxHash chainhash.Hash) (bool, error) {
+	_, seen, err := s.getUint32(slashingTxHash[:], seenSlashingTxsBucketName)
+
+	return seen, err
+}
+
+// DeletePendingReport removes the report of the given slashing tx once handled.
+// The slashing tx is kept as seen so that it is not reported again.
+func (s *BTCStakingTrackerStore) DeletePendingReport(slashingTxHash chainhash.Hash) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(pendingReportsBucketName)
+		if bucket == nil {
+			return ErrCorruptedDB
+		}
+
+		return bucket.Delete(slashingTxHash[:])
+	})
+}
+
+// PendingReports returns the slashing reports that are not handled yet
+func (s *BTCStakingTrackerStore) PendingReports() ([]*SlashingReport, error) {
+	var reports []*SlashingReport
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(pendingReportsBucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		return b.ForEach(func(_, v []byte) error {
+			report, err := slashingReportFromBytes(v)
+			if err != nil {
+				return err
+			}
+			reports = append(reports, report)
+
+			return nil
+		})
+	}, func() {
+		reports = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+func (r *SlashingReport) toBytes() ([]byte, error) {
+	var txBuf bytes.Buffer
+	if err := r.SlashingTx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
+
+	return pm.Marshal(&proto.SlashingReport{
+		Path:          uint32(r.Path),
+		StakingTxHash: r.StakingTxHash[:],
+		SlashingTx:    txBuf.Bytes(),
+		BtcHeight:     r.BTCHeight,
+	})
+}
+
+func slashingReportFromBytes(reportBytes []byte) (*SlashingReport, error) {
+	protoReport := &proto.SlashingReport{}
+	if err := pm.Unmarshal(reportBytes, protoReport); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+	}
+
+	report := &SlashingReport{
+		Path:       SlashingPath(protoReport.Path),
+		SlashingTx: &wire.MsgTx{},
+		BTCHeight:  protoReport.BtcHeight,
+	}
+	if err := report.StakingTxHash.SetBytes(protoReport.StakingTxHash); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+	}
+	if err := report.SlashingTx.Deserialize(bytes.NewReader(protoReport.SlashingTx)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+	}
+
+	return report, nil
+}
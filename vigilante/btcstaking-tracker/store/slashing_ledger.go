@@ -0,0 +1,292 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/vigilante/proto"
+)
+
+var (
+	// storing the slashing attempts of the BTC slasher, keyed by slashing tx hash
+	slashingLedgerBucketName = []byte("slashingledger")
+	// storing the extracted SKs of the slashed finality providers, keyed by BTC PK hex
+	slashedFPSKsBucketName = []byte("slashedfpsks")
+)
+
+type SlashingPath uint32
+
+const (
+	// SlashingPathStaking spends the staking output of an active BTC delegation
+	SlashingPathStaking SlashingPath = iota
+	// SlashingPathUnbonding spends the unbonding output of an unbonded BTC delegation
+	SlashingPathUnbonding
+)
+
+func (p SlashingPath) String() string {
+	switch p {
+	case SlashingPathStaking:
+		return "staking"
+	case SlashingPathUnbonding:
+		return "unbonding"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(p))
+	}
+}
+
+type SlashingStatus uint32
+
+const (
+	// SlashingStatusFailed is a slashing tx whose last attempt failed, to be retried
+	SlashingStatusFailed SlashingStatus = iota
+	// SlashingStatusBroadcast is a slashing tx accepted by Bitcoin but not included yet
+	SlashingStatusBroadcast
+	// SlashingStatusConfirmed is a slashing tx included in a BTC block
+	SlashingStatusConfirmed
+	// SlashingStatusUnslashable is a slashing tx whose staking/unbonding output is
+	// spent by another tx
+	SlashingStatusUnslashable
+)
+
+func (s SlashingStatus) String() string {
+	switch s {
+	case SlashingStatusFailed:
+		return "failed"
+	case SlashingStatusBroadcast:
+		return "broadcast"
+	case SlashingStatusConfirmed:
+		return "confirmed"
+	case SlashingStatusUnslashable:
+		return "unslashable"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(s))
+	}
+}
+
+// SlashingRecord is the latest attempt of the BTC slasher to slash a BTC delegation
+// via one of its slashing paths
+type SlashingRecord struct {
+	FpBtcPk         string // hex of the BIP-340 PK of the slashed finality provider
+	StakingTxHash   chainhash.Hash
+	Path            SlashingPath
+	SlashingTxHash  chainhash.Hash
+	SlashingTx      *wire.MsgTx // with witness, nil if it was not built
+	Status          SlashingStatus
+	BroadcastHeight uint32 // 0 if not broadcast
+	ConfirmHeight   uint32 // 0 if not confirmed
+	Error           string // empty if the last attempt succeeded
+	Attempts        uint32
+	UpdatedTime     time.Time
+}
+
+// IsUnfinished returns whether the slashing tx still has to be broadcast or confirmed
+func (r *SlashingRecord) IsUnfinished() bool {
+	return r.Status == SlashingStatusFailed || r.Status == SlashingStatusBroadcast
+}
+
+// PutSlashingRecord creates or overwrites the record of its slashing tx
+func (s *BTCStakingTrackerStore) PutSlashingRecord(record *SlashingRecord) error {
+	recordBytes, err := record.toBytes()
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(slashingLedgerBucketName)
+		if bucket == nil {
+			return ErrCorruptedDB
+		}
+
+		return bucket.Put(record.SlashingTxHash[:], recordBytes)
+	})
+}
+
+// SlashingRecord returns the record of the given slashing tx
+func (s *BTCStakingTrackerStore) SlashingRecord(slashingTxHash chainhash.Hash) (*SlashingRecord, bool, error) {
+	var record *SlashingRecord
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(slashingLedgerBucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		recordBytes := b.Get(slashingTxHash[:])
+		if recordBytes == nil {
+			return ErrNotFound
+		}
+
+		var err error
+		record, err = slashingRecordFromBytes(recordBytes)
+
+		return err
+	}, func() {})
+
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return record, true, nil
+}
+
+// ListSlashingRecords returns up to limit records of the given finality provider,
+// or of all of them if fpBtcPk is empty. A zero limit returns all the records.
+func (s *BTCStakingTrackerStore) ListSlashingRecords(fpBtcPk string, limit uint32) ([]*SlashingRecord, error) {
+	return s.filterSlashingRecords(func(record *SlashingRecord) bool {
+		return fpBtcPk == "" || record.FpBtcPk == fpBtcPk
+	}, limit)
+}
+
+// UnfinishedSlashingRecords returns the records whose slashing tx still has to be
+// broadcast or confirmed
+func (s *BTCStakingTrackerStore) UnfinishedSlashingRecords() ([]*SlashingRecord, error) {
+	return s.filterSlashingRecords(func(record *SlashingRecord) bool {
+		return record.IsUnfinished()
+	}, 0)
+}
+
+func (s *BTCStakingTrackerStore) filterSlashingRecords(
+	filter func(record *SlashingRecord) bool,
+	limit uint32,
+) ([]*SlashingRecord, error) {
+	var records []*SlashingRecord
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(slashingLedgerBucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		c := b.ReadCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if limit > 0 && uint32(len(records)) >= limit {
+				break
+			}
+			record, err := slashingRecordFromBytes(v)
+			if err != nil {
+				return err
+			}
+			if filter(record) {
+				records = append(records, record)
+			}
+		}
+
+		return nil
+	}, func() {
+		records = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// PutSlashedFPSK stores the SK extracted from a slashed finality provider, so that
+// its BTC delegations can be slashed again after a restart. Note that the SK is
+// already revealed by the slashing evidence.
+func (s *BTCStakingTrackerStore) PutSlashedFPSK(fpBtcPk string, sk []byte) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(slashedFPSKsBucketName)
+		if bucket == nil {
+			return ErrCorruptedDB
+		}
+
+		return bucket.Put([]byte(fpBtcPk), sk)
+	})
+}
+
+// SlashedFPSK returns the SK extracted from the given slashed finality provider
+func (s *BTCStakingTrackerStore) SlashedFPSK(fpBtcPk string) ([]byte, bool, error) {
+	var sk []byte
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(slashedFPSKsBucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		skBytes := b.Get([]byte(fpBtcPk))
+		if skBytes == nil {
+			return ErrNotFound
+		}
+		sk = append([]byte{}, skBytes...)
+
+		return nil
+	}, func() {})
+
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return sk, true, nil
+}
+
+func (r *SlashingRecord) toBytes() ([]byte, error) {
+	protoRecord := &proto.SlashingRecord{
+		FpBtcPk:         r.FpBtcPk,
+		StakingTxHash:   r.StakingTxHash[:],
+		Path:            uint32(r.Path),
+		SlashingTxHash:  r.SlashingTxHash[:],
+		Status:          uint32(r.Status),
+		BroadcastHeight: r.BroadcastHeight,
+		ConfirmHeight:   r.ConfirmHeight,
+		Error:           r.Error,
+		Attempts:        r.Attempts,
+		UpdatedTime:     r.UpdatedTime.Unix(),
+	}
+	if r.SlashingTx != nil {
+		var txBuf bytes.Buffer
+		if err := r.SlashingTx.Serialize(&txBuf); err != nil {
+			return nil, err
+		}
+		protoRecord.SlashingTx = txBuf.Bytes()
+	}
+
+	return pm.Marshal(protoRecord)
+}
+
+func slashingRecordFromBytes(recordBytes []byte) (*SlashingRecord, error) {
+	protoRecord := &proto.SlashingRecord{}
+	if err := pm.Unmarshal(recordBytes, protoRecord); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+	}
+
+	record := &SlashingRecord{
+		FpBtcPk:         protoRecord.FpBtcPk,
+		Path:            SlashingPath(protoRecord.Path),
+		Status:          SlashingStatus(protoRecord.Status),
+		BroadcastHeight: protoRecord.BroadcastHeight,
+		ConfirmHeight:   protoRecord.ConfirmHeight,
+		Error:           protoRecord.Error,
+		Attempts:        protoRecord.Attempts,
+		UpdatedTime:     time.Unix(protoRecord.UpdatedTime, 0),
+	}
+	if err := record.StakingTxHash.SetBytes(protoRecord.StakingTxHash); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+	}
+	if err := record.SlashingTxHash.SetBytes(protoRecord.SlashingTxHash); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+	}
+	if len(protoRecord.SlashingTx) > 0 {
+		record.SlashingTx = &wire.MsgTx{}
+		if err := record.SlashingTx.Deserialize(bytes.NewReader(protoRecord.SlashingTx)); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+		}
+	}
+
+	return record, nil
+}
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// BTCStakingTrackerStore persists the state of the BTC staking tracker routines
+// so that they can resume after a restart
+type BTCStakingTrackerStore struct {
+	db kvdb.Backend
+}
+
+var (
+	// storing the BTC delegations tracked by the atomic slasher, keyed by staking tx hash
+	trackedDelegationsBucketName = []byte("trackeddels")
+	// storing the slashing txs found by the atomic slasher, keyed by slashing tx hash
+	seenSlashingTxsBucketName = []byte("seenslashingtxs")
+	// storing the slashing txs not reported to Babylon yet, keyed by slashing tx hash
+	pendingReportsBucketName = []byte("pendingreports")
+	// storing the last BTC height processed by the atomic slasher
+	atomicSlasherHeightBucketName = []byte("atomicslasherheight")
+	lastProcessedHeightKey        = []byte("lastprocessedheight")
+)
+
+var (
+	// ErrCorruptedDB For some reason, db on disk representation have changed
+	ErrCorruptedDB = errors.New("db is corrupted")
+	// ErrNotFound Value not found
+	ErrNotFound = errors.New("not found")
+)
+
+func NewBTCStakingTrackerStore(backend kvdb.Backend) (*BTCStakingTrackerStore, error) {
+	store := &BTCStakingTrackerStore{db: backend}
+	if err := store.createBuckets(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *BTCStakingTrackerStore) createBuckets() error {
+	buckets := [][]byte{
+		trackedDelegationsBucketName,
+		seenSlashingTxsBucketName,
+		pendingReportsBucketName,
+		atomicSlasherHeightBucketName,
+		slashingLedgerBucketName,
+		slashedFPSKsBucketName,
+	}
+	for _, bucket := range buckets {
+		if err := s.db.Update(func(tx kvdb.RwTx) error {
+			_, err := tx.CreateTopLevelBucket(bucket)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}, func() {}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *BTCStakingTrackerStore) getUint32(key, bucketName []byte) (uint32, bool, error) {
+	var returnVal uint32
+
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(bucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		byteVal := b.Get(key)
+		if byteVal == nil {
+			return ErrNotFound
+		}
+		if len(byteVal) != 4 {
+			return ErrCorruptedDB
+		}
+
+		returnVal = binary.BigEndian.Uint32(byteVal)
+
+		return nil
+	}, func() {})
+
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	return returnVal, true, nil
+}
+
+func uint32ToBytes(v uint32) []byte {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], v)
+
+	return buf[:]
+}
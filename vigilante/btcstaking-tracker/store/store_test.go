@@ -0,0 +1,205 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	bbndatagen "github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
+	"github.com/babylonlabs-io/vigilante/testutil"
+)
+
+func TestEmptyStore(t *testing.T) {
+	t.Parallel()
+	s, err := store.NewBTCStakingTrackerStore(testutil.MakeTestBackend(t))
+	require.NoError(t, err)
+
+	_, exists, err := s.LastProcessedHeight()
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	dels, err := s.TrackedDelegations()
+	require.NoError(t, err)
+	require.Empty(t, dels)
+
+	reports, err := s.PendingReports()
+	require.NoError(t, err)
+	require.Empty(t, reports)
+
+	records, err := s.ListSlashingRecords("", 0)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
+
+func FuzzStoringTrackedDelegations(f *testing.F) {
+	bbndatagen.AddRandomSeedsToFuzzer(f, 3)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+		s, err := store.NewBTCStakingTrackerStore(testutil.MakeTestBackend(t))
+		require.NoError(t, err)
+
+		expected := make([]*store.TrackedDelegation, r.Intn(10)+1)
+		for i := range expected {
+			expected[i] = &store.TrackedDelegation{
+				StakingTxHash:           bbndatagen.GenRandomBtcdHash(r),
+				SlashingTxHash:          bbndatagen.GenRandomBtcdHash(r),
+				UnbondingSlashingTxHash: bbndatagen.GenRandomBtcdHash(r),
+			}
+		}
+		require.NoError(t, s.PutTrackedDelegations(expected))
+
+		// stop tracking one of them
+		require.NoError(t, s.DeleteTrackedDelegation(expected[0].StakingTxHash))
+
+		dels, err := s.TrackedDelegations()
+		require.NoError(t, err)
+		require.ElementsMatch(t, expected[1:], dels)
+	})
+}
+
+func FuzzStoringProcessedBlocks(f *testing.F) {
+	bbndatagen.AddRandomSeedsToFuzzer(f, 3)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+		s, err := store.NewBTCStakingTrackerStore(testutil.MakeTestBackend(t))
+		require.NoError(t, err)
+
+		height := uint32(r.Int31n(1000) + 1)
+		numReports := r.Intn(5) + 1
+		reports := make([]*store.SlashingReport, numReports)
+		for i := range reports {
+			slashingTx := bbndatagen.GenRandomTx(r)
+			slashingTx.TxIn[0].Witness = wire.TxWitness{
+				bbndatagen.GenRandomByteArray(r, 64),
+				bbndatagen.GenRandomByteArray(r, 32),
+			}
+			reports[i] = &store.SlashingReport{
+				Path:          store.SlashingPath(r.Intn(2)),
+				StakingTxHash: bbndatagen.GenRandomBtcdHash(r),
+				SlashingTx:    slashingTx,
+				BTCHeight:     height,
+			}
+		}
+
+		newReports, err := s.PutProcessedBlock(height, reports)
+		require.NoError(t, err)
+		require.Equal(t, reports, newReports)
+
+		lastHeight, exists, err := s.LastProcessedHeight()
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, height, lastHeight)
+
+		pending, err := s.PendingReports()
+		require.NoError(t, err)
+		require.ElementsMatch(t, reports, pending)
+
+		// the slashing txs found again, e.g., upon a reorg, are skipped
+		newReports, err = s.PutProcessedBlock(height+1, reports)
+		require.NoError(t, err)
+		require.Empty(t, newReports)
+		lastHeight, _, err = s.LastProcessedHeight()
+		require.NoError(t, err)
+		require.Equal(t, height+1, lastHeight)
+
+		// a handled slashing tx is no longer pending but still seen
+		handledTxHash := reports[0].SlashingTx.TxHash()
+		require.NoError(t, s.DeletePendingReport(handledTxHash))
+		seen, err := s.IsSlashingTxSeen(handledTxHash)
+		require.NoError(t, err)
+		require.True(t, seen)
+		pending, err = s.PendingReports()
+		require.NoError(t, err)
+		require.ElementsMatch(t, reports[1:], pending)
+	})
+}
+
+func FuzzStoringSlashingRecords(f *testing.F) {
+	bbndatagen.AddRandomSeedsToFuzzer(f, 3)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+		s, err := store.NewBTCStakingTrackerStore(testutil.MakeTestBackend(t))
+		require.NoError(t, err)
+
+		fpBtcPks := []string{bbndatagen.GenRandomHexStr(r, 32), bbndatagen.GenRandomHexStr(r, 32)}
+		records := make([]*store.SlashingRecord, r.Intn(10)+2)
+		for i := range records {
+			record := &store.SlashingRecord{
+				FpBtcPk:        fpBtcPks[i%2],
+				StakingTxHash:  bbndatagen.GenRandomBtcdHash(r),
+				Path:           store.SlashingPath(r.Intn(2)),
+				SlashingTxHash: bbndatagen.GenRandomBtcdHash(r),
+				Status:         store.SlashingStatus(r.Intn(4)),
+				Error:          bbndatagen.GenRandomHexStr(r, 10),
+				Attempts:       uint32(r.Intn(10) + 1),
+				UpdatedTime:    time.Unix(r.Int63n(1<<32), 0),
+			}
+			// the witness could not be built for some of the slashing txs
+			if r.Intn(2) == 0 {
+				record.SlashingTx = bbndatagen.GenRandomTx(r)
+				record.SlashingTx.TxIn[0].Witness = wire.TxWitness{bbndatagen.GenRandomByteArray(r, 64)}
+				record.SlashingTxHash = record.SlashingTx.TxHash()
+				record.BroadcastHeight = uint32(r.Int31n(1000) + 1)
+			}
+			records[i] = record
+			require.NoError(t, s.PutSlashingRecord(record))
+		}
+
+		for _, record := range records {
+			stored, found, err := s.SlashingRecord(record.SlashingTxHash)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, record, stored)
+		}
+		_, found, err := s.SlashingRecord(bbndatagen.GenRandomBtcdHash(r))
+		require.NoError(t, err)
+		require.False(t, found)
+
+		all, err := s.ListSlashingRecords("", 0)
+		require.NoError(t, err)
+		require.ElementsMatch(t, records, all)
+		limited, err := s.ListSlashingRecords("", 1)
+		require.NoError(t, err)
+		require.Len(t, limited, 1)
+		for i, fpBtcPk := range fpBtcPks {
+			var expected []*store.SlashingRecord
+			for j := i; j < len(records); j += 2 {
+				expected = append(expected, records[j])
+			}
+			fpRecords, err := s.ListSlashingRecords(fpBtcPk, 0)
+			require.NoError(t, err)
+			require.ElementsMatch(t, expected, fpRecords)
+		}
+
+		var expectedUnfinished []*store.SlashingRecord
+		for _, record := range records {
+			if record.Status == store.SlashingStatusFailed || record.Status == store.SlashingStatusBroadcast {
+				expectedUnfinished = append(expectedUnfinished, record)
+			}
+		}
+		unfinished, err := s.UnfinishedSlashingRecords()
+		require.NoError(t, err)
+		require.ElementsMatch(t, expectedUnfinished, unfinished)
+
+		// the SK of a slashed finality provider is kept for the restarts
+		sk := bbndatagen.GenRandomByteArray(r, 32)
+		require.NoError(t, s.PutSlashedFPSK(fpBtcPks[0], sk))
+		storedSK, found, err := s.SlashedFPSK(fpBtcPks[0])
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, sk, storedSK)
+		_, found, err = s.SlashedFPSK(fpBtcPks[1])
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+}
@@ -9,11 +9,13 @@ import (
 	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/atomicslasher"
 	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/btcslasher"
 	uw "github.com/babylonlabs-io/vigilante/btcstaking-tracker/stakingeventwatcher"
+	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/store"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
 	"github.com/babylonlabs-io/vigilante/netparams"
 	"github.com/btcsuite/btcd/btcec/v2"
 	notifier "github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"go.uber.org/zap"
 )
 
@@ -64,9 +66,15 @@ func NewBTCStakingTracker(
 	commonCfg *config.CommonConfig,
 	parentLogger *zap.Logger,
 	metrics *metrics.BTCStakingTrackerMetrics,
+	db kvdb.Backend,
 ) *BTCStakingTracker {
 	logger := parentLogger.With(zap.String("module", "btcstaking-tracker"))
 
+	trackerStore, err := store.NewBTCStakingTrackerStore(db)
+	if err != nil {
+		parentLogger.Fatal("failed to create BTC staking tracker store", zap.Error(err))
+	}
+
 	// watcher routine
 	babylonAdapter := uw.NewBabylonClientAdapter(bbnClient, cfg)
 	watcher := uw.NewStakingEventWatcher(
@@ -88,6 +96,15 @@ func NewBTCStakingTracker(
 	if err != nil {
 		parentLogger.Fatal("failed to get BTC parameter", zap.Error(err))
 	}
+	var dryRunReport *btcslasher.DryRunReport
+	if cfg.DryRun {
+		dryRunReport, err = btcslasher.NewDryRunReport(cfg.DryRunReport)
+		if err != nil {
+			parentLogger.Fatal("failed to create the slashing dry-run report", zap.Error(err))
+		}
+		logger.Warn("dry-run mode: the slashing txs are written to the report instead of being broadcast",
+			zap.String("report", cfg.DryRunReport))
+	}
 	btcSlasher, err := btcslasher.New(
 		logger,
 		btcClient,
@@ -98,6 +115,9 @@ func NewBTCStakingTracker(
 		commonCfg.MaxRetryTimes,
 		cfg.MaxSlashingConcurrency,
 		slashedFPSKChan,
+		dryRunReport,
+		trackerStore,
+		cfg.CheckSlashingTxsInterval,
 		metrics.SlasherMetrics,
 	)
 	if err != nil {
@@ -115,6 +135,7 @@ func NewBTCStakingTracker(
 		btcNotifier,
 		bbnClient,
 		slashedFPSKChan,
+		trackerStore,
 		metrics.AtomicSlasherMetrics,
 	)
 
@@ -133,6 +154,28 @@ func NewBTCStakingTracker(
 	}
 }
 
+// Status is a snapshot of the BTC delegations handled by the routines of the tracker
+type Status struct {
+	uw.Status
+	AtomicSlasherTrackedDelegations int
+	SlashingInProgress              int64
+}
+
+// Status returns the number of BTC delegations tracked by each routine
+func (tracker *BTCStakingTracker) Status() *Status {
+	return &Status{
+		Status:                          *tracker.stakingEventWatcher.Status(),
+		AtomicSlasherTrackedDelegations: tracker.atomicSlasher.NumTrackedDelegations(),
+		SlashingInProgress:              tracker.btcSlasher.SlashingInProgress(),
+	}
+}
+
+// SlashingRecords returns up to limit records of the slashing ledger of the given
+// finality provider, or of all of them if fpBtcPk is empty
+func (tracker *BTCStakingTracker) SlashingRecords(fpBtcPk string, limit uint32) ([]*store.SlashingRecord, error) {
+	return tracker.btcSlasher.SlashingRecords(fpBtcPk, limit)
+}
+
 // Bootstrap initialises the BTC staking tracker. At the moment, only BTC
 // slasher needs to be bootstrapped, in which BTC slasher checks if there is
 // any previous evidence whose slashing tx is not submitted to Bitcoin yet
@@ -16,6 +16,8 @@ func GetBTCStakingTracker() *cobra.Command {
 	var babylonKeyDir string
 	var cfgFile = ""
 	var startHeight uint64
+	var dryRun bool
+	var dryRunReport string
 
 	cmd := &cobra.Command{
 		Use:   "bstracker",
@@ -37,6 +39,13 @@ func GetBTCStakingTracker() *cobra.Command {
 				cfg.Babylon.KeyDirectory = babylonKeyDir
 			}
 
+			if dryRun {
+				cfg.BTCStakingTracker.DryRun = true
+			}
+			if len(dryRunReport) != 0 {
+				cfg.BTCStakingTracker.DryRunReport = dryRunReport
+			}
+
 			rootLogger, err := cfg.CreateLogger()
 			if err != nil {
 				panic(fmt.Errorf("failed to create logger: %w", err))
@@ -73,6 +82,11 @@ func GetBTCStakingTracker() *cobra.Command {
 				panic(err)
 			}
 
+			dbBackend, err := cfg.BTCStakingTracker.DatabaseConfig.GetDBBackend()
+			if err != nil {
+				panic(err)
+			}
+
 			bsMetrics := metrics.NewBTCStakingTrackerMetrics()
 
 			bstracker := bst.NewBTCStakingTracker(
@@ -83,6 +97,7 @@ func GetBTCStakingTracker() *cobra.Command {
 				&cfg.Common,
 				rootLogger,
 				bsMetrics,
+				dbBackend,
 			)
 
 			// create RPC server
@@ -148,6 +163,8 @@ func GetBTCStakingTracker() *cobra.Command {
 	cmd.Flags().StringVar(&babylonKeyDir, "babylon-key", "", "Directory of the Babylon key")
 	cmd.Flags().StringVar(&cfgFile, "config", config.DefaultConfigFile(), "config file")
 	cmd.Flags().Uint64Var(&startHeight, "start-height", 0, "height that the BTC slasher starts scanning for evidences")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "build and verify the slashing txs without broadcasting them")
+	cmd.Flags().StringVar(&dryRunReport, "dry-run-report", "", "file the slashing txs are written to in dry-run mode")
 
 	return cmd
 }
@@ -1,17 +1,22 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	bbnqccfg "github.com/babylonlabs-io/babylon/client/config"
 	bbnqc "github.com/babylonlabs-io/babylon/client/query"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/babylonlabs-io/vigilante/btcclient"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
 	"github.com/babylonlabs-io/vigilante/rpcserver"
+	pb "github.com/babylonlabs-io/vigilante/rpcserver/api"
 	"github.com/babylonlabs-io/vigilante/submitter"
 )
 
@@ -119,6 +124,66 @@ func GetSubmitterCmd() *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVar(&cfgFile, "config", config.DefaultConfigFile(), "config file")
+	cmd.AddCommand(getSubmitterHistoryCmd())
+
+	return cmd
+}
+
+// getSubmitterHistoryCmd returns the CLI command querying the checkpoint
+// submission history from a running submitter
+func getSubmitterHistoryCmd() *cobra.Command {
+	var (
+		cfgFile    = ""
+		rpcAddr    = ""
+		epoch      uint64
+		startEpoch uint64
+		limit      uint32
+	)
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show the txs broadcast for each checkpoint by a running submitter",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.New(cfgFile)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			client, conn, err := rpcserver.NewClient(&cfg.GRPC, rpcAddr)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+			defer cancel()
+
+			var resp proto.Message
+			if cmd.Flags().Changed("epoch") {
+				resp, err = client.CheckpointHistory(ctx, &pb.CheckpointHistoryRequest{Epoch: epoch})
+			} else {
+				resp, err = client.ListCheckpointHistory(ctx, &pb.ListCheckpointHistoryRequest{
+					StartEpoch: startEpoch,
+					Limit:      limit,
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			out, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(resp)
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&cfgFile, "config", config.DefaultConfigFile(), "config file")
+	cmd.Flags().StringVar(&rpcAddr, "rpc-addr", "", "address of the vigilante gRPC server, defaults to the first configured endpoint")
+	cmd.Flags().Uint64Var(&epoch, "epoch", 0, "only show the history of this epoch")
+	cmd.Flags().Uint64Var(&startEpoch, "start-epoch", 0, "first epoch to show")
+	cmd.Flags().Uint32Var(&limit, "limit", 100, "maximum number of epochs to show, 0 for all")
 
 	return cmd
 }
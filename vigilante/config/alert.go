@@ -0,0 +1,51 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const DefaultAlertTimeout = 10 * time.Second
+
+// AlertConfig defines where the monitor sends the evidence of the detected liveness
+// violations and checkpoint mismatches, and their resolutions. Every configured
+// sink receives every alert.
+type AlertConfig struct {
+	// WebhookURL is the endpoint the alerts are POSTed to as JSON, empty to disable
+	WebhookURL string `mapstructure:"webhook-url"`
+	// File is the path to the file the alerts are appended to as JSON lines, empty to disable
+	File string `mapstructure:"file"`
+	// ExecCommand is the path to an executable receiving each alert as JSON on its stdin, empty to disable
+	ExecCommand string `mapstructure:"exec-command"`
+	// Timeout defines the timeout of the webhook requests and of the exec hook
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+func (cfg *AlertConfig) Validate() error {
+	if cfg.WebhookURL != "" {
+		u, err := url.Parse(cfg.WebhookURL)
+		if err != nil {
+			return fmt.Errorf("invalid webhook-url: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid webhook-url %q, should be http or https", cfg.WebhookURL)
+		}
+	}
+
+	if cfg.Timeout <= 0 {
+		return errors.New("timeout of the alert sinks should be positive")
+	}
+
+	return nil
+}
+
+func DefaultAlertConfig() AlertConfig {
+	return AlertConfig{
+		WebhookURL:  "",
+		File:        "",
+		ExecCommand: "",
+		Timeout:     DefaultAlertTimeout,
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/babylonlabs-io/vigilante/types"
@@ -13,16 +14,39 @@ const (
 	MaxSlashingConcurrency = 20
 )
 
+var (
+	defaultDryRunReport = filepath.Join(DataDir(defaultAppDataDir), "slashing-dry-run.jsonl")
+)
+
 type BTCStakingTrackerConfig struct {
 	CheckDelegationsInterval       time.Duration `mapstructure:"check-delegations-interval"`
 	NewDelegationsBatchSize        uint64        `mapstructure:"delegations-batch-size"`
 	CheckDelegationActiveInterval  time.Duration `mapstructure:"check-if-delegation-active-interval"`
 	RetrySubmitUnbondingTxInterval time.Duration `mapstructure:"retry-submit-unbonding-interval"`
 	RetryJitter                    time.Duration `mapstructure:"max-jitter-interval"`
+	// EnableDelegationEvents tracks the BTC delegations upon the Babylon events and
+	// the staking txs in new BTC blocks, instead of scanning all the BTC delegations
+	// every CheckDelegationsInterval
+	EnableDelegationEvents bool `mapstructure:"enable-delegation-events"`
+	// ConsistencyCheckInterval is the interval of the full scans of the BTC
+	// delegations when EnableDelegationEvents is set, in case an event is missed
+	ConsistencyCheckInterval time.Duration `mapstructure:"consistency-check-interval"`
 	// the BTC network
 	BTCNetParams string `mapstructure:"btcnetparams"` // should be mainnet|testnet|simnet|signet|regtest
 	// number of concurrent requests that when slashing
 	MaxSlashingConcurrency uint8 `mapstructure:"max-slashing-concurrency"`
+	// DatabaseConfig stores the tracked delegations and the slashing txs found by the
+	// atomic slasher, so that no selective slashing is missed across restarts
+	DatabaseConfig *DBConfig `mapstructure:"dbconfig"`
+	// CheckSlashingTxsInterval is the interval to follow the slashing txs of the
+	// slashing ledger until they are included in Bitcoin
+	CheckSlashingTxsInterval time.Duration `mapstructure:"check-slashing-txs-interval"`
+	// DryRun builds and verifies the slashing txs without broadcasting them, and
+	// skips reporting the selective slashing offences, the unbondings and the
+	// delegation activations to Babylon
+	DryRun bool `mapstructure:"dry-run"`
+	// DryRunReport is the file the slashing txs are written to in dry-run mode
+	DryRunReport string `mapstructure:"dry-run-report"`
 }
 
 func DefaultBTCStakingTrackerConfig() BTCStakingTrackerConfig {
@@ -35,8 +59,16 @@ func DefaultBTCStakingTrackerConfig() BTCStakingTrackerConfig {
 		RetrySubmitUnbondingTxInterval: 1 * time.Minute,
 		// pretty large jitter to avoid spamming babylon with requests
 		RetryJitter:            30 * time.Second,
-		BTCNetParams:           types.BtcSimnet.String(),
-		MaxSlashingConcurrency: MaxSlashingConcurrency,
+		EnableDelegationEvents: true,
+		// the full scans only catch up with the missed events
+		ConsistencyCheckInterval: 30 * time.Minute,
+		BTCNetParams:             types.BtcSimnet.String(),
+		MaxSlashingConcurrency:   MaxSlashingConcurrency,
+		DatabaseConfig:           DefaultDBConfig(),
+		// slashing txs are rebroadcast if evicted from the mempool
+		CheckSlashingTxsInterval: 1 * time.Minute,
+		DryRun:                   false,
+		DryRunReport:             defaultDryRunReport,
 	}
 }
 
@@ -56,6 +88,10 @@ func (cfg *BTCStakingTrackerConfig) Validate() error {
 		return errors.New("max-jitter-interval can't be negative")
 	}
 
+	if cfg.EnableDelegationEvents && cfg.ConsistencyCheckInterval <= 0 {
+		return errors.New("consistency-check-interval must be positive when enable-delegation-events is set")
+	}
+
 	if cfg.NewDelegationsBatchSize > maxBatchSize {
 		return errors.New("delegations-batch-size can't be greater than 10000")
 	}
@@ -68,5 +104,20 @@ func (cfg *BTCStakingTrackerConfig) Validate() error {
 		return errors.New("max-slashing-concurrency cannot be 0")
 	}
 
+	if cfg.CheckSlashingTxsInterval <= 0 {
+		return errors.New("check-slashing-txs-interval must be positive")
+	}
+
+	if cfg.DryRun && cfg.DryRunReport == "" {
+		return errors.New("dry-run-report cannot be empty in dry-run mode")
+	}
+
+	if cfg.DatabaseConfig == nil {
+		return errors.New("dbconfig cannot be empty")
+	}
+	if err := cfg.DatabaseConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid dbconfig: %w", err)
+	}
+
 	return nil
 }
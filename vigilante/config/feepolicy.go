@@ -0,0 +1,59 @@
+package config
+
+import (
+	"errors"
+)
+
+const (
+	DefaultMaxFeedbackMultiplier = 2
+	DefaultDeadlineStartRatio    = 0.5
+	DefaultDeadlineMaxMultiplier = 3
+)
+
+// FeePolicyConfig defines how the fee rate of the checkpoint txs is estimated.
+// The bitcoind estimatesmartfee estimation is always used, and the sources below
+// can only raise the fee rate, which is still kept within [tx-fee-min, tx-fee-max].
+type FeePolicyConfig struct {
+	// MempoolEstimation enables estimating the fee rate from the verbose getrawmempool of the node,
+	// which is the fee rate of the last tx fitting into target-block-num blocks
+	MempoolEstimation bool `mapstructure:"mempool-estimation"`
+	// FeedbackEpochs defines the number of past checkpoints whose inclusion delays are used to
+	// raise the fee rate when they took longer than target-block-num blocks to be included, 0 to disable
+	FeedbackEpochs uint32 `mapstructure:"feedback-epochs"`
+	// MaxFeedbackMultiplier caps the multiplier derived from the inclusion delays
+	MaxFeedbackMultiplier float64 `mapstructure:"max-feedback-multiplier"`
+	// DeadlineMode enables raising the fee rate as the blocks elapsed since the first broadcast of
+	// the checkpoint get close to the checkpoint finalization timeout of Babylon
+	DeadlineMode bool `mapstructure:"deadline-mode"`
+	// DeadlineStartRatio defines the fraction of the finalization timeout after which the fee rate is raised
+	DeadlineStartRatio float64 `mapstructure:"deadline-start-ratio"`
+	// DeadlineMaxMultiplier is the multiplier applied to the fee rate once the finalization timeout elapsed
+	DeadlineMaxMultiplier float64 `mapstructure:"deadline-max-multiplier"`
+}
+
+func (cfg *FeePolicyConfig) Validate() error {
+	if cfg.MaxFeedbackMultiplier < 1 {
+		return errors.New("invalid max-feedback-multiplier, should not be less than 1")
+	}
+
+	if cfg.DeadlineStartRatio < 0 || cfg.DeadlineStartRatio >= 1 {
+		return errors.New("invalid deadline-start-ratio, should be in [0, 1)")
+	}
+
+	if cfg.DeadlineMaxMultiplier < 1 {
+		return errors.New("invalid deadline-max-multiplier, should not be less than 1")
+	}
+
+	return nil
+}
+
+func DefaultFeePolicyConfig() FeePolicyConfig {
+	return FeePolicyConfig{
+		MempoolEstimation:     false,
+		FeedbackEpochs:        0,
+		MaxFeedbackMultiplier: DefaultMaxFeedbackMultiplier,
+		DeadlineMode:          false,
+		DeadlineStartRatio:    DefaultDeadlineStartRatio,
+		DeadlineMaxMultiplier: DefaultDeadlineMaxMultiplier,
+	}
+}
@@ -6,6 +6,9 @@ type GRPCConfig struct {
 	RPCKeyFile    string   `mapstructure:"rpc-key"`
 	RPCCertFile   string   `mapstructure:"rpc-cert"`
 	Endpoints     []string `mapstructure:"endpoints"`
+	// GatewayEndpoints are the endpoints serving the VigilanteService over REST,
+	// using the same TLS key pair as the gRPC endpoints
+	GatewayEndpoints []string `mapstructure:"gateway-endpoints"`
 }
 
 func (cfg *GRPCConfig) Validate() error {
@@ -14,9 +17,10 @@ func (cfg *GRPCConfig) Validate() error {
 
 func DefaultGRPCConfig() GRPCConfig {
 	return GRPCConfig{
-		OneTimeTLSKey: true,
-		RPCKeyFile:    defaultRPCKeyFile,
-		RPCCertFile:   defaultRPCCertFile,
-		Endpoints:     []string{"localhost:8080"},
+		OneTimeTLSKey:    true,
+		RPCKeyFile:       defaultRPCKeyFile,
+		RPCCertFile:      defaultRPCCertFile,
+		Endpoints:        []string{"localhost:8080"},
+		GatewayEndpoints: []string{},
 	}
 }
@@ -31,6 +31,8 @@ type MonitorConfig struct {
 	EnableLivenessChecker bool `mapstructure:"enable-liveness-checker"`
 	// DatabaseConfig stores lates epoch and height used for faster bootstrap
 	DatabaseConfig *DBConfig `mapstructure:"dbconfig"`
+	// Alert defines where the evidence of the detected misbehaviours is sent
+	Alert AlertConfig `mapstructure:"alert"`
 }
 
 func (cfg *MonitorConfig) Validate() error {
@@ -43,6 +45,9 @@ func (cfg *MonitorConfig) Validate() error {
 	if cfg.BtcConfirmationDepth < defaultBtcConfirmationDepth {
 		return fmt.Errorf("btc-confirmation-depth should not be less than %d", defaultBtcConfirmationDepth)
 	}
+	if err := cfg.Alert.Validate(); err != nil {
+		return fmt.Errorf("invalid alert config: %w", err)
+	}
 
 	return nil
 }
@@ -56,5 +61,6 @@ func DefaultMonitorConfig() MonitorConfig {
 		BtcConfirmationDepth:         defaultBtcConfirmationDepth,
 		MaxLiveBtcHeights:            defaultMaxLiveBtcHeights,
 		EnableLivenessChecker:        true,
+		Alert:                        DefaultAlertConfig(),
 	}
 }
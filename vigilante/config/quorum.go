@@ -0,0 +1,100 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// types of the BTC backends cross-validating the best chain of the reporter
+const (
+	// BTCBackendRPC is a bitcoind RPC endpoint
+	BTCBackendRPC = "rpc"
+	// BTCBackendEsplora is an Esplora-style REST API
+	BTCBackendEsplora = "esplora"
+	// BTCBackendElectrum is an Electrum server
+	BTCBackendElectrum = "electrum"
+
+	DefaultQuorumTimeout   = 10 * time.Second
+	DefaultQuorumMinVoters = 2
+)
+
+// BTCBackendConfig defines a BTC backend queried for the best chain
+type BTCBackendConfig struct {
+	Type     string `mapstructure:"type"`     // should be rpc|esplora|electrum
+	Endpoint string `mapstructure:"endpoint"` // host:port of the rpc and electrum backends, base URL of the esplora backends
+	Username string `mapstructure:"username"` // username of the rpc backends
+	Password string `mapstructure:"password"` // password of the rpc backends
+	TLS      bool   `mapstructure:"tls"`      // whether to connect to the rpc and electrum backends over TLS
+}
+
+func (cfg *BTCBackendConfig) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint cannot be empty")
+	}
+
+	switch cfg.Type {
+	case BTCBackendRPC, BTCBackendElectrum:
+	case BTCBackendEsplora:
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil {
+			return fmt.Errorf("invalid esplora endpoint: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid esplora endpoint %q, should be http or https", cfg.Endpoint)
+		}
+	default:
+		return fmt.Errorf("invalid backend type %q, should be one of %s|%s|%s",
+			cfg.Type, BTCBackendRPC, BTCBackendEsplora, BTCBackendElectrum)
+	}
+
+	return nil
+}
+
+// QuorumConfig defines the BTC backends that have to agree with the BTC node of the
+// reporter on the best chain before its headers are submitted to Babylon. The node
+// of the reporter counts as one vote, and the quorum is disabled without backends.
+type QuorumConfig struct {
+	Backends []BTCBackendConfig `mapstructure:"backends"`
+	// RequireMajority refuses to submit headers until a majority agrees with the node
+	// of the reporter, instead of only reporting the divergence
+	RequireMajority bool `mapstructure:"require_majority"`
+	// MinVoters is the minimum number of voters, including the node of the reporter,
+	// for a majority to count. The lagging and unreachable backends are not voters.
+	MinVoters int `mapstructure:"min_voters"`
+	// Timeout defines the timeout of the queries to each backend
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+func (cfg *QuorumConfig) Validate() error {
+	for i := range cfg.Backends {
+		if err := cfg.Backends[i].Validate(); err != nil {
+			return fmt.Errorf("invalid backend %d: %w", i, err)
+		}
+	}
+
+	if cfg.Timeout <= 0 {
+		return errors.New("timeout should be positive")
+	}
+
+	if cfg.MinVoters < 1 {
+		return errors.New("min_voters should be positive")
+	}
+
+	if len(cfg.Backends) > 0 && cfg.MinVoters > len(cfg.Backends)+1 {
+		return fmt.Errorf("min_voters %d is more than the %d backends plus the BTC node",
+			cfg.MinVoters, len(cfg.Backends))
+	}
+
+	return nil
+}
+
+func DefaultQuorumConfig() QuorumConfig {
+	return QuorumConfig{
+		Backends:        []BTCBackendConfig{},
+		RequireMajority: false,
+		MinVoters:       DefaultQuorumMinVoters,
+		Timeout:         DefaultQuorumTimeout,
+	}
+}
@@ -16,6 +16,8 @@ type ReporterConfig struct {
 	NetParams       string `mapstructure:"netparams"`          // should be mainnet|testnet|simnet|signet
 	BTCCacheSize    uint32 `mapstructure:"btc_cache_size"`     // size of the BTC cache
 	MaxHeadersInMsg uint32 `mapstructure:"max_headers_in_msg"` // maximum number of headers in a MsgInsertHeaders message
+	// Quorum defines the BTC backends cross-validating the best chain of the BTC node
+	Quorum QuorumConfig `mapstructure:"quorum"`
 }
 
 func (cfg *ReporterConfig) Validate() error {
@@ -28,6 +30,9 @@ func (cfg *ReporterConfig) Validate() error {
 	if cfg.MaxHeadersInMsg < maxHeadersInMsg {
 		return fmt.Errorf("max_headers_in_msg has to be at least %d", maxHeadersInMsg)
 	}
+	if err := cfg.Quorum.Validate(); err != nil {
+		return fmt.Errorf("invalid quorum config: %w", err)
+	}
 
 	return nil
 }
@@ -37,5 +42,6 @@ func DefaultReporterConfig() ReporterConfig {
 		NetParams:       types.BtcSimnet.String(),
 		BTCCacheSize:    minBTCCacheSize,
 		MaxHeadersInMsg: maxHeadersInMsg,
+		Quorum:          DefaultQuorumConfig(),
 	}
 }
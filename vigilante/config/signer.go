@@ -0,0 +1,72 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// signers of the PSBTs of the checkpoint txs funded by the bitcoind wallet
+const (
+	// SignerWallet signs with the keys of the bitcoind wallet
+	SignerWallet = "wallet"
+	// SignerFile signs with the keys kept in a local file
+	SignerFile = "file"
+	// SignerRemote sends the PSBTs to a remote HTTP signer
+	SignerRemote = "remote"
+
+	DefaultSignerType    = SignerWallet
+	DefaultSignerTimeout = 10 * time.Second
+)
+
+// SignerConfig defines who signs the checkpoint txs. With the file and remote signers,
+// the bitcoind wallet only needs to watch the funding addresses.
+type SignerConfig struct {
+	// Type defines the signer, which should be wallet|file|remote
+	Type string `mapstructure:"type"`
+	// KeyFile is the path to the file with the WIF-encoded private keys of the file signer, one per line
+	KeyFile string `mapstructure:"key-file"`
+	// URL is the endpoint the remote signer receives the PSBTs on
+	URL string `mapstructure:"url"`
+	// AuthToken is the optional bearer token sent to the remote signer
+	AuthToken string `mapstructure:"auth-token"`
+	// Timeout defines the timeout of the requests to the remote signer
+	Timeout time.Duration `mapstructure:"timeout"`
+	// ChangeAddress is the address the change of the txs signed by the remote signer is
+	// sent to, so that it can spend it in the chained txs. If empty, the wallet picks it.
+	ChangeAddress string `mapstructure:"change-address"`
+}
+
+func (cfg *SignerConfig) Validate() error {
+	switch cfg.Type {
+	case SignerWallet:
+	case SignerFile:
+		if cfg.KeyFile == "" {
+			return errors.New("key-file cannot be empty for the file signer")
+		}
+	case SignerRemote:
+		u, err := url.Parse(cfg.URL)
+		if err != nil {
+			return fmt.Errorf("invalid url of the remote signer: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid url of the remote signer %q, should be http or https", cfg.URL)
+		}
+		if cfg.Timeout <= 0 {
+			return errors.New("timeout of the remote signer should be positive")
+		}
+	default:
+		return fmt.Errorf("invalid signer type %q, should be one of %s|%s|%s",
+			cfg.Type, SignerWallet, SignerFile, SignerRemote)
+	}
+
+	return nil
+}
+
+func DefaultSignerConfig() SignerConfig {
+	return SignerConfig{
+		Type:    DefaultSignerType,
+		Timeout: DefaultSignerTimeout,
+	}
+}
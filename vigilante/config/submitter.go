@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/babylonlabs-io/vigilante/types"
 )
@@ -11,6 +12,17 @@ const (
 	DefaultPollingIntervalSeconds    = 60   // in seconds
 	DefaultResendIntervalSeconds     = 1800 // 30 minutes
 	DefaultResubmitFeeMultiplier     = 1
+	DefaultFeeBumpStrategy           = FeeBumpRBF
+)
+
+// strategies to bump the fee of a checkpoint that is not included on BTC in time
+const (
+	// FeeBumpRBF replaces the second tx of the checkpoint with a higher fee one
+	FeeBumpRBF = "rbf"
+	// FeeBumpCPFP spends the change of the checkpoint with a child that pays for the whole package
+	FeeBumpCPFP = "cpfp"
+	// FeeBumpAuto uses CPFP while the first tx of the checkpoint is unconfirmed, and RBF otherwise
+	FeeBumpAuto = "auto"
 )
 
 // SubmitterConfig defines configuration for the gRPC-web server.
@@ -26,6 +38,12 @@ type SubmitterConfig struct {
 	// ResendIntervalSeconds defines the time (in seconds) which the submitter awaits
 	// before resubmitting checkpoints to BTC
 	ResendIntervalSeconds uint `mapstructure:"resend-interval-seconds"`
+	// FeeBumpStrategy defines how a checkpoint not included on BTC is bumped, which should be rbf|cpfp|auto
+	FeeBumpStrategy string `mapstructure:"fee-bump-strategy"`
+	// FeePolicy defines how the fee rate of the checkpoint txs is estimated
+	FeePolicy FeePolicyConfig `mapstructure:"fee-policy"`
+	// Signer defines who signs the checkpoint txs funded by the wallet
+	Signer SignerConfig `mapstructure:"signer"`
 	// DatabaseConfig stores last submitted txn
 	DatabaseConfig *DBConfig `mapstructure:"dbconfig"`
 }
@@ -43,6 +61,21 @@ func (cfg *SubmitterConfig) Validate() error {
 		return errors.New("invalid polling-interval-seconds, should be positive")
 	}
 
+	switch cfg.FeeBumpStrategy {
+	case FeeBumpRBF, FeeBumpCPFP, FeeBumpAuto:
+	default:
+		return fmt.Errorf("invalid fee-bump-strategy %q, should be one of %s|%s|%s",
+			cfg.FeeBumpStrategy, FeeBumpRBF, FeeBumpCPFP, FeeBumpAuto)
+	}
+
+	if err := cfg.FeePolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid fee-policy config: %w", err)
+	}
+
+	if err := cfg.Signer.Validate(); err != nil {
+		return fmt.Errorf("invalid signer config: %w", err)
+	}
+
 	return nil
 }
 
@@ -53,5 +86,8 @@ func DefaultSubmitterConfig() SubmitterConfig {
 		ResubmitFeeMultiplier:  DefaultResubmitFeeMultiplier,
 		PollingIntervalSeconds: DefaultPollingIntervalSeconds,
 		ResendIntervalSeconds:  DefaultResendIntervalSeconds,
+		FeeBumpStrategy:        DefaultFeeBumpStrategy,
+		FeePolicy:              DefaultFeePolicyConfig(),
+		Signer:                 DefaultSignerConfig(),
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/babylonlabs-io/vigilante/btcstaking-tracker/btcslasher"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
+	"github.com/babylonlabs-io/vigilante/testutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/stretchr/testify/require"
@@ -57,6 +58,7 @@ func TestAtomicSlasher(t *testing.T) {
 		&commonCfg,
 		zap.NewNop(),
 		metrics.NewBTCStakingTrackerMetrics(),
+		testutil.MakeTestBackend(t),
 	)
 	go bsTracker.Start()
 	defer bsTracker.Stop()
@@ -179,6 +181,7 @@ func TestAtomicSlasher_Unbonding(t *testing.T) {
 		&commonCfg,
 		zap.NewNop(),
 		stakingTrackerMetrics,
+		testutil.MakeTestBackend(t),
 	)
 	go bsTracker.Start()
 	defer bsTracker.Stop()
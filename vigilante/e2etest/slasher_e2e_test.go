@@ -14,6 +14,7 @@ import (
 	bst "github.com/babylonlabs-io/vigilante/btcstaking-tracker"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
+	"github.com/babylonlabs-io/vigilante/testutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/stretchr/testify/require"
 )
@@ -53,6 +54,7 @@ func TestSlasher_GracefulShutdown(t *testing.T) {
 		&commonCfg,
 		zap.NewNop(),
 		stakingTrackerMetrics,
+		testutil.MakeTestBackend(t),
 	)
 
 	go bsTracker.Start()
@@ -103,6 +105,7 @@ func TestSlasher_Slasher(t *testing.T) {
 		&commonCfg,
 		zap.NewNop(),
 		stakingTrackerMetrics,
+		testutil.MakeTestBackend(t),
 	)
 	go bsTracker.Start()
 	defer bsTracker.Stop()
@@ -172,6 +175,7 @@ func TestSlasher_SlashingUnbonding(t *testing.T) {
 		&commonCfg,
 		zap.NewNop(),
 		stakingTrackerMetrics,
+		testutil.MakeTestBackend(t),
 	)
 	go bsTracker.Start()
 	defer bsTracker.Stop()
@@ -267,6 +271,7 @@ func TestSlasher_Bootstrapping(t *testing.T) {
 		&commonCfg,
 		zap.NewNop(),
 		stakingTrackerMetrics,
+		testutil.MakeTestBackend(t),
 	)
 
 	// bootstrap BTC staking tracker
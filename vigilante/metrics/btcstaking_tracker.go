@@ -36,6 +36,10 @@ type UnbondingWatcherMetrics struct {
 	NumberOfVerifiedNotInChainDelegations       prometheus.Gauge
 	NumberOfVerifiedInsufficientConfDelegations prometheus.Gauge
 	NumberOfVerifiedSufficientConfDelegations   prometheus.Gauge
+	DelegationEventsCounter                     prometheus.Counter
+	StakingTxsFoundInBlocksCounter              prometheus.Counter
+	DetectedReorgsCounter                       prometheus.Counter
+	ReorgedInclusionProofsCounter               *prometheus.CounterVec
 
 	MethodExecutionLatency *prometheus.HistogramVec
 }
@@ -111,6 +115,26 @@ func newUnbondingWatcherMetrics(registry *prometheus.Registry) *UnbondingWatcher
 			Name:      "unbonding_watcher_number_of_verified_sufficient_conf_delegations",
 			Help:      "The number of verified delegations with sufficient confirmations",
 		}),
+		DelegationEventsCounter: registerer.NewCounter(prometheus.CounterOpts{
+			Namespace: "vigilante",
+			Name:      "unbonding_watcher_delegation_events",
+			Help:      "The total number of BTC delegation events received from Babylon node",
+		}),
+		StakingTxsFoundInBlocksCounter: registerer.NewCounter(prometheus.CounterOpts{
+			Namespace: "vigilante",
+			Name:      "unbonding_watcher_staking_txs_found_in_blocks",
+			Help:      "The total number of staking txs of verified delegations found in new BTC blocks",
+		}),
+		DetectedReorgsCounter: registerer.NewCounter(prometheus.CounterOpts{
+			Namespace: "vigilante",
+			Name:      "unbonding_watcher_detected_reorgs",
+			Help:      "The total number of BTC reorgs detected by unbonding watcher",
+		}),
+		ReorgedInclusionProofsCounter: registerer.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "vigilante",
+			Name:      "unbonding_watcher_reorged_inclusion_proofs",
+			Help:      "The total number of inclusion proofs being submitted to Babylon node whose BTC block was reorged out",
+		}, []string{"type"}),
 	}
 
 	return uwMetrics
@@ -17,6 +17,9 @@ type ReporterMetrics struct {
 	SecondsSinceLastCheckpointGauge prometheus.Gauge
 	NewReportedHeaderGaugeVec       *prometheus.GaugeVec
 	NewReportedCheckpointGaugeVec   *prometheus.GaugeVec
+	QuorumVotesCounterVec           *prometheus.CounterVec
+	QuorumAgreeingVotesGauge        prometheus.Gauge
+	QuorumRejectedHeadersCounter    prometheus.Counter
 }
 
 func NewReporterMetrics() *ReporterMetrics {
@@ -75,6 +78,26 @@ func NewReporterMetrics() *ReporterMetrics {
 				"tx2id",
 			},
 		),
+		QuorumVotesCounterVec: registerer.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "vigilante_reporter_quorum_votes",
+				Help: "The total number of votes of the BTC backends on the best chain of the BTC node",
+			},
+			[]string{
+				// the name of the BTC backend
+				"backend",
+				// agree|diverge|lagging|unreachable
+				"vote",
+			},
+		),
+		QuorumAgreeingVotesGauge: registerer.NewGauge(prometheus.GaugeOpts{
+			Name: "vigilante_reporter_quorum_agreeing_votes",
+			Help: "The number of votes agreeing with the best chain of the BTC node at the latest check, including the BTC node",
+		}),
+		QuorumRejectedHeadersCounter: registerer.NewCounter(prometheus.CounterOpts{
+			Name: "vigilante_reporter_quorum_rejected_headers",
+			Help: "The total number of BTC headers not submitted to Babylon as no majority of the BTC backends agreed on them",
+		}),
 	}
 
 	return metrics
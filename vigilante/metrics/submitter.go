@@ -21,6 +21,7 @@ type RelayerMetrics struct {
 	InvalidCheckpointCounter              prometheus.Counter
 	ResentCheckpointsCounter              prometheus.Counter
 	FailedResentCheckpointsCounter        prometheus.Counter
+	CPFPCheckpointsCounter                prometheus.Counter
 	NewSubmittedCheckpointSegmentGaugeVec *prometheus.GaugeVec
 }
 
@@ -48,6 +49,10 @@ func newRelayerMetrics(registry *prometheus.Registry) *RelayerMetrics {
 			Name: "vigilante_submitter_failed_resent_checkpoints",
 			Help: "The number of failed resent checkpoints",
 		}),
+		CPFPCheckpointsCounter: registerer.NewCounter(prometheus.CounterOpts{
+			Name: "vigilante_submitter_cpfp_checkpoints",
+			Help: "The number of checkpoints bumped with a child-pays-for-parent tx",
+		}),
 		NewSubmittedCheckpointSegmentGaugeVec: registerer.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Name: "vigilante_submitter_new_checkpoint_segment",
@@ -0,0 +1,43 @@
+package alert
+
+import (
+	"github.com/babylonlabs-io/vigilante/config"
+	"github.com/babylonlabs-io/vigilante/monitor/store"
+)
+
+type Event string
+
+const (
+	// EventDetected is sent when a new piece of evidence is recorded
+	EventDetected Event = "detected"
+	// EventResolved is sent when a recorded liveness violation is resolved
+	EventResolved Event = "resolved"
+)
+
+// Alert is the message sent to the sinks, encoded as JSON
+type Alert struct {
+	Event    Event           `json:"event"`
+	Evidence *store.Evidence `json:"evidence"`
+}
+
+// Sink delivers the alerts of the monitor to the operators
+type Sink interface {
+	Name() string
+	Send(alert *Alert) error
+}
+
+// NewSinks creates a sink for each destination set in the config
+func NewSinks(cfg *config.AlertConfig) []Sink {
+	var sinks []Sink
+	if cfg.WebhookURL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.WebhookURL, cfg.Timeout))
+	}
+	if cfg.File != "" {
+		sinks = append(sinks, NewFileSink(cfg.File))
+	}
+	if cfg.ExecCommand != "" {
+		sinks = append(sinks, NewExecSink(cfg.ExecCommand, cfg.Timeout))
+	}
+
+	return sinks
+}
@@ -0,0 +1,138 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxResponseSize bounds the size of the webhook responses kept for the errors
+const maxResponseSize = 1 << 10
+
+// WebhookSink POSTs the alerts as JSON to an HTTP endpoint
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Send(alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to post the alert: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+
+		return fmt.Errorf("the webhook responded with status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	return nil
+}
+
+// FileSink appends the alerts to a file as JSON lines
+type FileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+func (s *FileSink) Send(alert *Alert) error {
+	line, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open the alert file: %w", err)
+	}
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("failed to write the alert file: %w", err)
+	}
+
+	return f.Close()
+}
+
+// ExecSink runs a command for each alert, which receives the alert as JSON on its
+// stdin, together with its event, type and epoch in the environment variables
+// VIGILANTE_ALERT_EVENT, VIGILANTE_ALERT_TYPE and VIGILANTE_ALERT_EPOCH
+type ExecSink struct {
+	command string
+	timeout time.Duration
+}
+
+func NewExecSink(command string, timeout time.Duration) *ExecSink {
+	return &ExecSink{
+		command: command,
+		timeout: timeout,
+	}
+}
+
+func (s *ExecSink) Name() string {
+	return "exec"
+}
+
+func (s *ExecSink) Send(alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	// #nosec G204 -- the command is set by the operator in the config
+	cmd := exec.CommandContext(ctx, s.command)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"VIGILANTE_ALERT_EVENT="+string(alert.Event),
+		"VIGILANTE_ALERT_TYPE="+alert.Evidence.Type.String(),
+		fmt.Sprintf("VIGILANTE_ALERT_EPOCH=%d", alert.Evidence.Epoch),
+	)
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("the alert command failed: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package alert_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/vigilante/config"
+	"github.com/babylonlabs-io/vigilante/monitor/alert"
+	"github.com/babylonlabs-io/vigilante/monitor/store"
+)
+
+func testAlert() *alert.Alert {
+	return &alert.Alert{
+		Event: alert.EventDetected,
+		Evidence: &store.Evidence{
+			Type:                store.EvidenceLivenessViolation,
+			Epoch:               42,
+			CheckpointHash:      "ab",
+			BTCHeightEpochEnded: 100,
+			BTCHeightFirstSeen:  102,
+			BTCTipHeight:        310,
+			Gap:                 210,
+			DetectedTime:        time.Unix(1700000000, 0),
+		},
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received <- body
+	}))
+	defer server.Close()
+
+	sink := alert.NewWebhookSink(server.URL, time.Second)
+	require.NoError(t, sink.Send(testAlert()))
+
+	var decoded alert.Alert
+	require.NoError(t, json.Unmarshal(<-received, &decoded))
+	require.Equal(t, alert.EventDetected, decoded.Event)
+	require.Equal(t, store.EvidenceLivenessViolation, decoded.Evidence.Type)
+	require.Equal(t, uint64(42), decoded.Evidence.Epoch)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	require.ErrorContains(t, alert.NewWebhookSink(failing.URL, time.Second).Send(testAlert()), "503")
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	sink := alert.NewFileSink(path)
+	a := testAlert()
+	require.NoError(t, sink.Send(a))
+	a.Event = alert.EventResolved
+	require.NoError(t, sink.Send(a))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], `"event":"detected"`)
+	require.Contains(t, lines[1], `"event":"resolved"`)
+	require.Contains(t, lines[1], `"type":"liveness-violation"`)
+}
+
+func TestExecSink(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out")
+	script := filepath.Join(dir, "hook.sh")
+	require.NoError(t, os.WriteFile(script, []byte(
+		"#!/bin/sh\necho \"$VIGILANTE_ALERT_EVENT $VIGILANTE_ALERT_TYPE $VIGILANTE_ALERT_EPOCH\" > "+out+"\ncat >> "+out+"\n",
+	), 0700))
+
+	sink := alert.NewExecSink(script, 5*time.Second)
+	require.NoError(t, sink.Send(testAlert()))
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(content), "detected liveness-violation 42\n"))
+	require.Contains(t, string(content), `"checkpoint_hash":"ab"`)
+
+	require.Error(t, alert.NewExecSink(filepath.Join(dir, "missing"), time.Second).Send(testAlert()))
+}
+
+func TestNewSinks(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.DefaultAlertConfig()
+	require.Empty(t, alert.NewSinks(&cfg))
+
+	cfg.WebhookURL = "http://localhost:8080"
+	cfg.File = "alerts.jsonl"
+	cfg.ExecCommand = "/bin/true"
+	sinks := alert.NewSinks(&cfg)
+	require.Len(t, sinks, 3)
+	require.Equal(t, "webhook", sinks[0].Name())
+	require.Equal(t, "file", sinks[1].Name())
+	require.Equal(t, "exec", sinks[2].Name())
+}
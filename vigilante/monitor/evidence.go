@@ -0,0 +1,99 @@
+package monitor
+
+import (
+	"time"
+
+	"github.com/babylonlabs-io/vigilante/monitor/alert"
+	"github.com/babylonlabs-io/vigilante/monitor/store"
+	"github.com/babylonlabs-io/vigilante/types"
+)
+
+// recordLivenessViolation persists the evidence of a censored checkpoint and alerts
+// when it is first detected and when the checkpoint is eventually reported
+func (m *Monitor) recordLivenessViolation(cr *types.CheckpointRecord, report *LivenessReport, checkErr error) {
+	ev, exists, err := m.store.Evidence(cr.EpochNum(), store.EvidenceLivenessViolation)
+	if err != nil {
+		m.logger.Errorf("failed to get the liveness evidence of epoch %d: %v", cr.EpochNum(), err)
+
+		return
+	}
+	if !exists {
+		ev = &store.Evidence{
+			Type:                store.EvidenceLivenessViolation,
+			Epoch:               cr.EpochNum(),
+			CheckpointHash:      cr.ID(),
+			BTCHeightEpochEnded: report.BTCHeightEpochEnded,
+			BTCHeightFirstSeen:  report.BTCHeightFirstSeen,
+			DetectedTime:        time.Now(),
+		}
+	}
+
+	// keep the latest heights of the evidence
+	wasResolved := ev.IsResolved()
+	ev.BTCHeightReported = report.BTCHeightCkptReported
+	if report.CurrentBtcTipHeight != 0 {
+		ev.BTCTipHeight = report.CurrentBtcTipHeight
+	}
+	ev.Gap = report.Gap
+	ev.Details = checkErr.Error()
+	if report.Reported() && !wasResolved {
+		ev.ResolvedTime = time.Now()
+	}
+
+	if err := m.store.PutEvidence(ev); err != nil {
+		m.logger.Errorf("failed to store the liveness evidence of epoch %d: %v", cr.EpochNum(), err)
+
+		return
+	}
+
+	if !exists {
+		m.sendAlert(alert.EventDetected, ev)
+	}
+	if ev.IsResolved() && !wasResolved {
+		m.sendAlert(alert.EventResolved, ev)
+	}
+}
+
+// recordCheckpointMismatch persists the evidence of a BTC checkpoint conflicting with
+// the Babylon checkpoint of the same epoch, and alerts the first time it is seen
+func (m *Monitor) recordCheckpointMismatch(cr *types.CheckpointRecord, verifyErr error) {
+	_, exists, err := m.store.Evidence(cr.EpochNum(), store.EvidenceCheckpointMismatch)
+	if err != nil {
+		m.logger.Errorf("failed to get the checkpoint mismatch evidence of epoch %d: %v", cr.EpochNum(), err)
+
+		return
+	} else if exists {
+		return
+	}
+
+	ev := &store.Evidence{
+		Type:               store.EvidenceCheckpointMismatch,
+		Epoch:              cr.EpochNum(),
+		CheckpointHash:     cr.ID(),
+		BTCHeightFirstSeen: cr.FirstSeenBtcHeight,
+		Details:            verifyErr.Error(),
+		DetectedTime:       time.Now(),
+	}
+	if err := m.store.PutEvidence(ev); err != nil {
+		m.logger.Errorf("failed to store the checkpoint mismatch evidence of epoch %d: %v", cr.EpochNum(), err)
+
+		return
+	}
+
+	m.sendAlert(alert.EventDetected, ev)
+}
+
+func (m *Monitor) sendAlert(event alert.Event, ev *store.Evidence) {
+	a := &alert.Alert{Event: event, Evidence: ev}
+	for _, sink := range m.alertSinks {
+		if err := sink.Send(a); err != nil {
+			m.logger.Errorf("failed to send the %s alert of the %s at epoch %d to the %s sink: %v",
+				event, ev.Type, ev.Epoch, sink.Name(), err)
+		}
+	}
+}
+
+// Evidence returns up to limit evidence records from startEpoch, 0 for no limit
+func (m *Monitor) Evidence(startEpoch uint64, limit uint32) ([]*store.Evidence, error) {
+	return m.store.ListEvidence(startEpoch, limit)
+}
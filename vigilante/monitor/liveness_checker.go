@@ -30,10 +30,13 @@ func (m *Monitor) runLivenessChecker() {
 			m.logger.Debugf("next liveness check is in %d seconds", m.Cfg.LivenessCheckIntervalSeconds)
 			checkpoints := m.checkpointChecklist.GetAll()
 			for _, c := range checkpoints {
-				err := m.CheckLiveness(c)
+				report, err := m.checkLiveness(c)
 				if err != nil {
 					m.logger.Errorf("the checkpoint at epoch %d is detected being censored: %s", c.EpochNum(), err.Error())
 					m.metrics.LivenessAttacksCounter.Inc()
+					if errors.Is(err, types.ErrLivenessAttack) {
+						m.recordLivenessViolation(c, report, err)
+					}
 
 					continue
 				}
@@ -54,50 +57,70 @@ func (m *Monitor) runLivenessChecker() {
 //  5. if H3 - min(H1, H2) > max_live_btc_heights (if the checkpoint is reported), or
 //     H4 - min(H1, H2) > max_live_btc_heights (if the checkpoint is not reported), return error
 func (m *Monitor) CheckLiveness(cr *types.CheckpointRecord) error {
+	_, err := m.checkLiveness(cr)
+
+	return err
+}
+
+// LivenessReport holds the BTC heights a liveness check is based on
+type LivenessReport struct {
+	BTCHeightEpochEnded   uint32 // the BTC light client height when the epoch ends (obtained from Babylon)
+	BTCHeightFirstSeen    uint32 // the BTC height at which the unique checkpoint first appears (obtained from BTC)
+	BTCHeightCkptReported uint32 // the tip height of BTC light client when the checkpoint is reported (obtained from Babylon)
+	CurrentBtcTipHeight   uint32 // the current tip height of BTC light client (obtained from Babylon)
+	Gap                   uint64 // the gap between two BTC heights
+}
+
+// Reported returns whether the checkpoint has been reported to Babylon
+func (r *LivenessReport) Reported() bool {
+	return r.BTCHeightCkptReported != 0
+}
+
+// checkLiveness runs CheckLiveness and returns the BTC heights it is based on. The
+// report is complete whenever the error is types.ErrLivenessAttack.
+func (m *Monitor) checkLiveness(cr *types.CheckpointRecord) (*LivenessReport, error) {
 	var (
-		btcHeightEpochEnded   uint32 // the BTC light client height when the epoch ends (obtained from Babylon)
-		btcHeightFirstSeen    uint32 // the BTC height at which the unique checkpoint first appears (obtained from BTC)
-		btcHeightCkptReported uint32 // the tip height of BTC light client when the checkpoint is reported (obtained from Babylon)
-		currentBtcTipHeight   uint32 // the current tip height of BTC light client (obtained from Babylon)
-		gap                   int    // the gap between two BTC heights
-		err                   error
+		report = &LivenessReport{}
+		gap    int
+		err    error
 	)
 	epoch := cr.EpochNum()
 	endedEpochRes, err := m.queryEndedEpochBTCHeightWithRetry(cr.EpochNum())
 	if err != nil {
-		return fmt.Errorf("the checkpoint at epoch %d is submitted on BTC the epoch is not ended on Babylon: %w", epoch, err)
+		return nil, fmt.Errorf("the checkpoint at epoch %d is submitted on BTC the epoch is not ended on Babylon: %w", epoch, err)
 	}
-	btcHeightEpochEnded = endedEpochRes.BtcLightClientHeight
-	m.logger.Debugf("the epoch %d is ended at BTC height %d", cr.EpochNum(), btcHeightEpochEnded)
+	report.BTCHeightEpochEnded = endedEpochRes.BtcLightClientHeight
+	m.logger.Debugf("the epoch %d is ended at BTC height %d", cr.EpochNum(), report.BTCHeightEpochEnded)
 
-	btcHeightFirstSeen = cr.FirstSeenBtcHeight
-	minHeight := minBTCHeight(btcHeightEpochEnded, btcHeightFirstSeen)
+	report.BTCHeightFirstSeen = cr.FirstSeenBtcHeight
+	minHeight := minBTCHeight(report.BTCHeightEpochEnded, report.BTCHeightFirstSeen)
 
 	reportedRes, err := m.queryReportedCheckpointBTCHeightWithRetry(cr.ID())
 	if err != nil {
 		if !errors.Is(err, monitortypes.ErrCheckpointNotReported) {
-			return fmt.Errorf("failed to query checkpoint of epoch %d reported BTC height: %w", epoch, err)
+			return nil, fmt.Errorf("failed to query checkpoint of epoch %d reported BTC height: %w", epoch, err)
 		}
 		m.logger.Debugf("the checkpoint of epoch %d has not been reported: %s", epoch, err.Error())
 		chainTipRes, err := m.queryBTCHeaderChainTipWithRetry()
 		if err != nil {
-			return fmt.Errorf("failed to query the current tip height of BTC light client: %w", err)
+			return nil, fmt.Errorf("failed to query the current tip height of BTC light client: %w", err)
 		}
-		currentBtcTipHeight = chainTipRes.Header.Height
-		m.logger.Debugf("the current tip height of BTC light client is %d", currentBtcTipHeight)
-		gap = int(currentBtcTipHeight) - int(minHeight)
+		report.CurrentBtcTipHeight = chainTipRes.Header.Height
+		m.logger.Debugf("the current tip height of BTC light client is %d", report.CurrentBtcTipHeight)
+		gap = int(report.CurrentBtcTipHeight) - int(minHeight)
 	} else {
-		btcHeightCkptReported = reportedRes.BtcLightClientHeight
-		gap = int(btcHeightCkptReported) - int(minHeight)
+		report.BTCHeightCkptReported = reportedRes.BtcLightClientHeight
+		gap = int(report.BTCHeightCkptReported) - int(minHeight)
 	}
 
 	if gap < 0 {
-		return fmt.Errorf("the gap %d between two BTC heights should not be negative", gap)
+		return nil, fmt.Errorf("the gap %d between two BTC heights should not be negative", gap)
 	}
+	report.Gap = uint64(gap)
 
-	if uint64(gap) > m.Cfg.MaxLiveBtcHeights {
-		return fmt.Errorf("%w: the gap BTC height is %d, larger than the threshold %d", types.ErrLivenessAttack, gap, m.Cfg.MaxLiveBtcHeights)
+	if report.Gap > m.Cfg.MaxLiveBtcHeights {
+		return report, fmt.Errorf("%w: the gap BTC height is %d, larger than the threshold %d", types.ErrLivenessAttack, gap, m.Cfg.MaxLiveBtcHeights)
 	}
 
-	return nil
+	return report, nil
 }
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/babylonlabs-io/vigilante/monitor/alert"
 	"github.com/babylonlabs-io/vigilante/monitor/store"
 	notifier "github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/kvdb"
@@ -45,6 +46,9 @@ type Monitor struct {
 
 	store *store.MonitorStore
 
+	// alertSinks receive the evidence of the detected misbehaviours
+	alertSinks []alert.Sink
+
 	metrics *metrics.MonitorMetrics
 
 	wg      sync.WaitGroup
@@ -101,6 +105,7 @@ func New(
 		curEpoch:            genesisEpoch,
 		checkpointChecklist: types.NewCheckpointsBookkeeper(),
 		store:               ms,
+		alertSinks:          alert.NewSinks(&cfg.Alert),
 		metrics:             monitorMetrics,
 		quit:                make(chan struct{}),
 		started:             atomic.NewBool(false),
@@ -206,6 +211,7 @@ func (m *Monitor) handleNewConfirmedHeader(block *types.IndexedBlock) error {
 func (m *Monitor) handleNewConfirmedCheckpoint(ckpt *types.CheckpointRecord) error {
 	if err := m.VerifyCheckpoint(ckpt.RawCheckpoint); err != nil {
 		if sdkerrors.IsOf(err, types.ErrInconsistentBlockHash) {
+			m.recordCheckpointMismatch(ckpt, err)
 			// also record conflicting checkpoints since we need to ensure that
 			// alarm will be sent if conflicting checkpoints are censored
 			if m.Cfg.EnableLivenessChecker {
@@ -341,6 +347,38 @@ func (m *Monitor) Stop() {
 	}
 }
 
+// Status is a snapshot of the progress of the monitor
+type Status struct {
+	CheckedEpoch      uint64 // the latest epoch whose checkpoint has been verified
+	CheckedBTCHeight  uint64 // the latest confirmed BTC height scanned for checkpoints
+	LivenessChecklist []*types.CheckpointRecord
+}
+
+// Status returns the progress of the monitor as persisted in its store, together
+// with the checkpoints that have not passed the liveness check yet. Thread-safe.
+func (m *Monitor) Status() (*Status, error) {
+	checkedEpoch, _, err := m.store.LatestEpoch()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest checked epoch: %w", err)
+	}
+
+	checkedHeight, _, err := m.store.LatestHeight()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest checked BTC height: %w", err)
+	}
+
+	checklist := m.checkpointChecklist.GetAll()
+	sort.Slice(checklist, func(i, j int) bool {
+		return checklist[i].EpochNum() < checklist[j].EpochNum()
+	})
+
+	return &Status{
+		CheckedEpoch:      checkedEpoch,
+		CheckedBTCHeight:  checkedHeight,
+		LivenessChecklist: checklist,
+	}, nil
+}
+
 func (m *Monitor) Metrics() *metrics.MonitorMetrics {
 	return m.metrics
 }
@@ -0,0 +1,219 @@
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/vigilante/proto"
+)
+
+var (
+	// storing the evidence of the detected misbehaviours, keyed by epoch and type
+	evidenceBucketName = []byte("evidence")
+)
+
+type EvidenceType uint8
+
+const (
+	// EvidenceLivenessViolation is a checkpoint that was not reported to Babylon
+	// within max-live-btc-heights BTC blocks
+	EvidenceLivenessViolation EvidenceType = iota
+	// EvidenceCheckpointMismatch is a valid BTC checkpoint whose block hash differs
+	// from the one of the Babylon checkpoint of the same epoch
+	EvidenceCheckpointMismatch
+)
+
+func (t EvidenceType) String() string {
+	switch t {
+	case EvidenceLivenessViolation:
+		return "liveness-violation"
+	case EvidenceCheckpointMismatch:
+		return "checkpoint-mismatch"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// MarshalText encodes the type by its name in the JSON of the evidence
+func (t EvidenceType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *EvidenceType) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case EvidenceLivenessViolation.String():
+		*t = EvidenceLivenessViolation
+	case EvidenceCheckpointMismatch.String():
+		*t = EvidenceCheckpointMismatch
+	default:
+		return fmt.Errorf("unknown evidence type %q", text)
+	}
+
+	return nil
+}
+
+// Evidence is a misbehaviour of Babylon detected by the monitor. A liveness
+// violation is resolved once the censored checkpoint is eventually reported.
+type Evidence struct {
+	Type                EvidenceType `json:"type"`
+	Epoch               uint64       `json:"epoch"`
+	CheckpointHash      string       `json:"checkpoint_hash"`
+	BTCHeightEpochEnded uint32       `json:"btc_height_epoch_ended"` // H1
+	BTCHeightFirstSeen  uint32       `json:"btc_height_first_seen"`  // H2
+	BTCHeightReported   uint32       `json:"btc_height_reported"`    // H3, 0 if the checkpoint is not reported
+	BTCTipHeight        uint32       `json:"btc_tip_height"`
+	Gap                 uint64       `json:"gap"`
+	Details             string       `json:"details"`
+	DetectedTime        time.Time    `json:"detected_time"`
+	ResolvedTime        time.Time    `json:"resolved_time"` // zero if the evidence is not resolved
+}
+
+func (e *Evidence) IsResolved() bool {
+	return !e.ResolvedTime.IsZero()
+}
+
+// PutEvidence creates or overwrites the evidence of its type at its epoch
+func (s *MonitorStore) PutEvidence(ev *Evidence) error {
+	evBytes, err := pm.Marshal(ev.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(evidenceBucketName)
+		if bucket == nil {
+			return ErrCorruptedDB
+		}
+
+		return bucket.Put(evidenceKey(ev.Epoch, ev.Type), evBytes)
+	})
+}
+
+// Evidence returns the evidence of the given type at the given epoch
+func (s *MonitorStore) Evidence(epoch uint64, evType EvidenceType) (*Evidence, bool, error) {
+	var ev *Evidence
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(evidenceBucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		evBytes := b.Get(evidenceKey(epoch, evType))
+		if evBytes == nil {
+			return ErrNotFound
+		}
+
+		var err error
+		ev, err = evidenceFromBytes(evBytes)
+
+		return err
+	}, func() {})
+
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return ev, true, nil
+}
+
+// ListEvidence returns up to limit evidence records in ascending epoch order,
+// starting from startEpoch. A zero limit returns all the records from startEpoch.
+func (s *MonitorStore) ListEvidence(startEpoch uint64, limit uint32) ([]*Evidence, error) {
+	var evidence []*Evidence
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(evidenceBucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		c := b.ReadCursor()
+		for k, v := c.Seek(uint64ToBytes(startEpoch)); k != nil; k, v = c.Next() {
+			if limit > 0 && uint32(len(evidence)) >= limit {
+				break
+			}
+			ev, err := evidenceFromBytes(v)
+			if err != nil {
+				return err
+			}
+			evidence = append(evidence, ev)
+		}
+
+		return nil
+	}, func() {
+		evidence = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return evidence, nil
+}
+
+// evidenceKey orders the evidence records by epoch
+func evidenceKey(epoch uint64, evType EvidenceType) []byte {
+	key := make([]byte, 9)
+	binary.BigEndian.PutUint64(key, epoch)
+	key[8] = byte(evType)
+
+	return key
+}
+
+func evidenceFromBytes(evBytes []byte) (*Evidence, error) {
+	protoEv := &proto.Evidence{}
+	if err := pm.Unmarshal(evBytes, protoEv); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptedDB, err)
+	}
+
+	ev := &Evidence{}
+	ev.FromProto(protoEv)
+
+	return ev, nil
+}
+
+func (e *Evidence) ToProto() *proto.Evidence {
+	protoEv := &proto.Evidence{
+		Type:                uint32(e.Type),
+		Epoch:               e.Epoch,
+		CheckpointHash:      e.CheckpointHash,
+		BtcHeightEpochEnded: e.BTCHeightEpochEnded,
+		BtcHeightFirstSeen:  e.BTCHeightFirstSeen,
+		BtcHeightReported:   e.BTCHeightReported,
+		BtcTipHeight:        e.BTCTipHeight,
+		Gap:                 e.Gap,
+		Details:             e.Details,
+		DetectedTime:        e.DetectedTime.Unix(),
+	}
+	if e.IsResolved() {
+		protoEv.ResolvedTime = e.ResolvedTime.Unix()
+	}
+
+	return protoEv
+}
+
+func (e *Evidence) FromProto(protoEv *proto.Evidence) {
+	e.Type = EvidenceType(protoEv.Type)
+	e.Epoch = protoEv.Epoch
+	e.CheckpointHash = protoEv.CheckpointHash
+	e.BTCHeightEpochEnded = protoEv.BtcHeightEpochEnded
+	e.BTCHeightFirstSeen = protoEv.BtcHeightFirstSeen
+	e.BTCHeightReported = protoEv.BtcHeightReported
+	e.BTCTipHeight = protoEv.BtcTipHeight
+	e.Gap = protoEv.Gap
+	e.Details = protoEv.Details
+	e.DetectedTime = time.Unix(protoEv.DetectedTime, 0)
+	if protoEv.ResolvedTime != 0 {
+		e.ResolvedTime = time.Unix(protoEv.ResolvedTime, 0)
+	} else {
+		e.ResolvedTime = time.Time{}
+	}
+}
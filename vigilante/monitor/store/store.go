@@ -37,7 +37,7 @@ func NewMonitorStore(backend kvdb.Backend) (*MonitorStore, error) {
 }
 
 func (s *MonitorStore) createBuckets() error {
-	buckets := [][]byte{epochsBucketName, heightBucketName}
+	buckets := [][]byte{epochsBucketName, heightBucketName, evidenceBucketName}
 	for _, bucket := range buckets {
 		if err := s.db.Update(func(tx kvdb.RwTx) error {
 			_, err := tx.CreateTopLevelBucket(bucket)
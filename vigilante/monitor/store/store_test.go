@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"math/rand"
 	"testing"
+	"time"
 )
 
 func TestEmptyStore(t *testing.T) {
@@ -74,3 +75,72 @@ func FuzzStoringHeights(f *testing.F) {
 		require.Equal(t, height, storedHeight)
 	})
 }
+
+func FuzzStoringEvidence(f *testing.F) {
+	bbndatagen.AddRandomSeedsToFuzzer(f, 3)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+		db := testutil.MakeTestBackend(t)
+		s, err := store.NewMonitorStore(db)
+		require.NoError(t, err)
+
+		numEpochs := uint64(r.Int63n(20) + 1)
+		for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+			err := s.PutEvidence(&store.Evidence{
+				Type:                store.EvidenceLivenessViolation,
+				Epoch:               epoch,
+				CheckpointHash:      bbndatagen.GenRandomHexStr(r, 32),
+				BTCHeightEpochEnded: uint32(r.Int31n(1000)),
+				BTCHeightFirstSeen:  uint32(r.Int31n(1000)),
+				BTCTipHeight:        uint32(r.Int31n(1000) + 1000),
+				Gap:                 uint64(r.Int63n(1000)),
+				DetectedTime:        time.Unix(r.Int63n(1000000), 0),
+			})
+			require.NoError(t, err)
+		}
+		// a mismatch at the same epoch does not overwrite the liveness violation
+		mismatchEpoch := uint64(r.Int63n(int64(numEpochs)) + 1)
+		err = s.PutEvidence(&store.Evidence{
+			Type:         store.EvidenceCheckpointMismatch,
+			Epoch:        mismatchEpoch,
+			Details:      "inconsistent block hash",
+			DetectedTime: time.Unix(r.Int63n(1000000), 0),
+		})
+		require.NoError(t, err)
+
+		evidence, err := s.ListEvidence(0, 0)
+		require.NoError(t, err)
+		require.Len(t, evidence, int(numEpochs)+1)
+		for i := 1; i < len(evidence); i++ {
+			require.LessOrEqual(t, evidence[i-1].Epoch, evidence[i].Epoch)
+		}
+
+		evidence, err = s.ListEvidence(mismatchEpoch, 1)
+		require.NoError(t, err)
+		require.Len(t, evidence, 1)
+		require.Equal(t, store.EvidenceLivenessViolation, evidence[0].Type)
+		require.False(t, evidence[0].IsResolved())
+
+		// resolve the liveness violation
+		ev := evidence[0]
+		ev.BTCHeightReported = ev.BTCTipHeight + 1
+		ev.ResolvedTime = ev.DetectedTime.Add(time.Hour)
+		require.NoError(t, s.PutEvidence(ev))
+
+		stored, exists, err := s.Evidence(mismatchEpoch, store.EvidenceLivenessViolation)
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, ev, stored)
+
+		stored, exists, err = s.Evidence(mismatchEpoch, store.EvidenceCheckpointMismatch)
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, "inconsistent block hash", stored.Details)
+
+		_, exists, err = s.Evidence(numEpochs+1, store.EvidenceLivenessViolation)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
@@ -0,0 +1,430 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.6.1
+// source: bstracker.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TrackedDelegation is a BTC delegation whose slashing txs are watched by the
+// atomic slasher
+type TrackedDelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StakingTxHash           []byte `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	SlashingTxHash          []byte `protobuf:"bytes,2,opt,name=slashing_tx_hash,json=slashingTxHash,proto3" json:"slashing_tx_hash,omitempty"`
+	UnbondingSlashingTxHash []byte `protobuf:"bytes,3,opt,name=unbonding_slashing_tx_hash,json=unbondingSlashingTxHash,proto3" json:"unbonding_slashing_tx_hash,omitempty"`
+}
+
+func (x *TrackedDelegation) Reset() {
+	*x = TrackedDelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bstracker_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackedDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackedDelegation) ProtoMessage() {}
+
+func (x *TrackedDelegation) ProtoReflect() protoreflect.Message {
+	mi := &file_bstracker_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackedDelegation.ProtoReflect.Descriptor instead.
+func (*TrackedDelegation) Descriptor() ([]byte, []int) {
+	return file_bstracker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrackedDelegation) GetStakingTxHash() []byte {
+	if x != nil {
+		return x.StakingTxHash
+	}
+	return nil
+}
+
+func (x *TrackedDelegation) GetSlashingTxHash() []byte {
+	if x != nil {
+		return x.SlashingTxHash
+	}
+	return nil
+}
+
+func (x *TrackedDelegation) GetUnbondingSlashingTxHash() []byte {
+	if x != nil {
+		return x.UnbondingSlashingTxHash
+	}
+	return nil
+}
+
+// SlashingReport is a slashing tx of a tracked delegation found in a BTC block,
+// pending to be reported as a selective slashing to Babylon
+type SlashingReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path          uint32 `protobuf:"varint,1,opt,name=path,proto3" json:"path,omitempty"` // 0 for the slashing tx of the staking tx, 1 for the one of the unbonding tx
+	StakingTxHash []byte `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	SlashingTx    []byte `protobuf:"bytes,3,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"` // the serialized slashing tx, including the witness
+	BtcHeight     uint32 `protobuf:"varint,4,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`   // the height of the BTC block including the slashing tx
+}
+
+func (x *SlashingReport) Reset() {
+	*x = SlashingReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bstracker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingReport) ProtoMessage() {}
+
+func (x *SlashingReport) ProtoReflect() protoreflect.Message {
+	mi := &file_bstracker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingReport.ProtoReflect.Descriptor instead.
+func (*SlashingReport) Descriptor() ([]byte, []int) {
+	return file_bstracker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SlashingReport) GetPath() uint32 {
+	if x != nil {
+		return x.Path
+	}
+	return 0
+}
+
+func (x *SlashingReport) GetStakingTxHash() []byte {
+	if x != nil {
+		return x.StakingTxHash
+	}
+	return nil
+}
+
+func (x *SlashingReport) GetSlashingTx() []byte {
+	if x != nil {
+		return x.SlashingTx
+	}
+	return nil
+}
+
+func (x *SlashingReport) GetBtcHeight() uint32 {
+	if x != nil {
+		return x.BtcHeight
+	}
+	return 0
+}
+
+// SlashingRecord is the latest attempt of the BTC slasher to slash a BTC delegation
+// via the staking or the unbonding path
+type SlashingRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FpBtcPk         string `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3" json:"fp_btc_pk,omitempty"` // hex of the BIP-340 PK of the slashed finality provider
+	StakingTxHash   []byte `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	Path            uint32 `protobuf:"varint,3,opt,name=path,proto3" json:"path,omitempty"` // 0 for the slashing tx of the staking tx, 1 for the one of the unbonding tx
+	SlashingTxHash  []byte `protobuf:"bytes,4,opt,name=slashing_tx_hash,json=slashingTxHash,proto3" json:"slashing_tx_hash,omitempty"`
+	SlashingTx      []byte `protobuf:"bytes,5,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"`                 // the serialized slashing tx with witness, empty if it was not built
+	Status          uint32 `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                                          // 0 failed, 1 broadcast, 2 confirmed, 3 unslashable
+	BroadcastHeight uint32 `protobuf:"varint,7,opt,name=broadcast_height,json=broadcastHeight,proto3" json:"broadcast_height,omitempty"` // the BTC tip height at the first broadcast, 0 if not broadcast
+	ConfirmHeight   uint32 `protobuf:"varint,8,opt,name=confirm_height,json=confirmHeight,proto3" json:"confirm_height,omitempty"`       // the height of the BTC block including the slashing tx, 0 if not confirmed
+	Error           string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                             // the error of the last attempt, empty if it succeeded
+	Attempts        uint32 `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	UpdatedTime     int64  `protobuf:"varint,11,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"` // unix timestamp in seconds
+}
+
+func (x *SlashingRecord) Reset() {
+	*x = SlashingRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bstracker_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingRecord) ProtoMessage() {}
+
+func (x *SlashingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_bstracker_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingRecord.ProtoReflect.Descriptor instead.
+func (*SlashingRecord) Descriptor() ([]byte, []int) {
+	return file_bstracker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SlashingRecord) GetFpBtcPk() string {
+	if x != nil {
+		return x.FpBtcPk
+	}
+	return ""
+}
+
+func (x *SlashingRecord) GetStakingTxHash() []byte {
+	if x != nil {
+		return x.StakingTxHash
+	}
+	return nil
+}
+
+func (x *SlashingRecord) GetPath() uint32 {
+	if x != nil {
+		return x.Path
+	}
+	return 0
+}
+
+func (x *SlashingRecord) GetSlashingTxHash() []byte {
+	if x != nil {
+		return x.SlashingTxHash
+	}
+	return nil
+}
+
+func (x *SlashingRecord) GetSlashingTx() []byte {
+	if x != nil {
+		return x.SlashingTx
+	}
+	return nil
+}
+
+func (x *SlashingRecord) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SlashingRecord) GetBroadcastHeight() uint32 {
+	if x != nil {
+		return x.BroadcastHeight
+	}
+	return 0
+}
+
+func (x *SlashingRecord) GetConfirmHeight() uint32 {
+	if x != nil {
+		return x.ConfirmHeight
+	}
+	return 0
+}
+
+func (x *SlashingRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SlashingRecord) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *SlashingRecord) GetUpdatedTime() int64 {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return 0
+}
+
+var File_bstracker_proto protoreflect.FileDescriptor
+
+var file_bstracker_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x3b, 0x0a, 0x1a, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x8c, 0x01,
+	0x0a, 0x0e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x74, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x62, 0x74, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xf2, 0x02, 0x0a,
+	0x0e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x1a, 0x0a, 0x09, 0x66, 0x70, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x70, 0x42, 0x74, 0x63, 0x50, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x61, 0x62, 0x79, 0x6c, 0x6f, 0x6e, 0x6c, 0x61, 0x62, 0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x76,
+	0x69, 0x67, 0x69, 0x6c, 0x61, 0x6e, 0x74, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bstracker_proto_rawDescOnce sync.Once
+	file_bstracker_proto_rawDescData = file_bstracker_proto_rawDesc
+)
+
+func file_bstracker_proto_rawDescGZIP() []byte {
+	file_bstracker_proto_rawDescOnce.Do(func() {
+		file_bstracker_proto_rawDescData = protoimpl.X.CompressGZIP(file_bstracker_proto_rawDescData)
+	})
+	return file_bstracker_proto_rawDescData
+}
+
+var file_bstracker_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_bstracker_proto_goTypes = []interface{}{
+	(*TrackedDelegation)(nil), // 0: proto.TrackedDelegation
+	(*SlashingReport)(nil),    // 1: proto.SlashingReport
+	(*SlashingRecord)(nil),    // 2: proto.SlashingRecord
+}
+var file_bstracker_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_bstracker_proto_init() }
+func file_bstracker_proto_init() {
+	if File_bstracker_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_bstracker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackedDelegation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bstracker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bstracker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bstracker_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bstracker_proto_goTypes,
+		DependencyIndexes: file_bstracker_proto_depIdxs,
+		MessageInfos:      file_bstracker_proto_msgTypes,
+	}.Build()
+	File_bstracker_proto = out.File
+	file_bstracker_proto_rawDesc = nil
+	file_bstracker_proto_goTypes = nil
+	file_bstracker_proto_depIdxs = nil
+}
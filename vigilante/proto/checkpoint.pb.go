@@ -29,6 +29,7 @@ type StoredCheckpoint struct {
 	Tx1   []byte `protobuf:"bytes,1,opt,name=tx1,proto3" json:"tx1,omitempty"` // wire.MsgTx serialized as bytes
 	Tx2   []byte `protobuf:"bytes,2,opt,name=tx2,proto3" json:"tx2,omitempty"`
 	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Child []byte `protobuf:"bytes,4,opt,name=child,proto3" json:"child,omitempty"` // the CPFP child bumping the checkpoint, empty if none
 }
 
 func (x *StoredCheckpoint) Reset() {
@@ -84,19 +85,199 @@ func (x *StoredCheckpoint) GetEpoch() uint64 {
 	return 0
 }
 
+func (x *StoredCheckpoint) GetChild() []byte {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// SubmittedTx holds a single broadcast version of a checkpoint transaction
+type SubmittedTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxIndex         uint32 `protobuf:"varint,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`                         // 0 for the first tx of the checkpoint, 1 for the second, 2 for a CPFP child
+	Tx              []byte `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`                                                   // wire.MsgTx serialized as bytes
+	Fee             int64  `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`                                                // in satoshis
+	FeeRate         int64  `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`                         // in satoshis per kvB
+	BroadcastTime   int64  `protobuf:"varint,5,opt,name=broadcast_time,json=broadcastTime,proto3" json:"broadcast_time,omitempty"`       // unix timestamp in seconds
+	InclusionHeight uint32 `protobuf:"varint,6,opt,name=inclusion_height,json=inclusionHeight,proto3" json:"inclusion_height,omitempty"` // 0 if the tx has not been observed in a BTC block
+	BroadcastHeight uint32 `protobuf:"varint,7,opt,name=broadcast_height,json=broadcastHeight,proto3" json:"broadcast_height,omitempty"` // height of the BTC tip when the tx was broadcast, 0 if unknown
+}
+
+func (x *SubmittedTx) Reset() {
+	*x = SubmittedTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_checkpoint_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmittedTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmittedTx) ProtoMessage() {}
+
+func (x *SubmittedTx) ProtoReflect() protoreflect.Message {
+	mi := &file_checkpoint_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmittedTx.ProtoReflect.Descriptor instead.
+func (*SubmittedTx) Descriptor() ([]byte, []int) {
+	return file_checkpoint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubmittedTx) GetTxIndex() uint32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetTx() []byte {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *SubmittedTx) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetFeeRate() int64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetBroadcastTime() int64 {
+	if x != nil {
+		return x.BroadcastTime
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetInclusionHeight() uint32 {
+	if x != nil {
+		return x.InclusionHeight
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetBroadcastHeight() uint32 {
+	if x != nil {
+		return x.BroadcastHeight
+	}
+	return 0
+}
+
+// CheckpointHistory holds every transaction broadcast for the checkpoint of an epoch
+type CheckpointHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64         `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Txs   []*SubmittedTx `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *CheckpointHistory) Reset() {
+	*x = CheckpointHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_checkpoint_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointHistory) ProtoMessage() {}
+
+func (x *CheckpointHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_checkpoint_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointHistory.ProtoReflect.Descriptor instead.
+func (*CheckpointHistory) Descriptor() ([]byte, []int) {
+	return file_checkpoint_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CheckpointHistory) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CheckpointHistory) GetTxs() []*SubmittedTx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
 var File_checkpoint_proto protoreflect.FileDescriptor
 
 var file_checkpoint_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x10, 0x53, 0x74, 0x6f,
+	0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x62, 0x0a, 0x10, 0x53, 0x74, 0x6f,
 	0x72, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a,
 	0x03, 0x74, 0x78, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x31, 0x12,
 	0x10, 0x0a, 0x03, 0x74, 0x78, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78,
 	0x32, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x62, 0x79, 0x6c, 0x6f, 0x6e, 0x6c, 0x61, 0x62,
-	0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x67, 0x69, 0x6c, 0x61, 0x6e, 0x74, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x22, 0xe2, 0x01,
+	0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x54, 0x78, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x4f, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x24, 0x0a,
+	0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x03,
+	0x74, 0x78, 0x73, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x61, 0x62, 0x79, 0x6c, 0x6f, 0x6e, 0x6c, 0x61, 0x62, 0x73, 0x2d, 0x69, 0x6f,
+	0x2f, 0x76, 0x69, 0x67, 0x69, 0x6c, 0x61, 0x6e, 0x74, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -111,16 +292,19 @@ func file_checkpoint_proto_rawDescGZIP() []byte {
 	return file_checkpoint_proto_rawDescData
 }
 
-var file_checkpoint_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_checkpoint_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_checkpoint_proto_goTypes = []interface{}{
-	(*StoredCheckpoint)(nil), // 0: proto.StoredCheckpoint
+	(*StoredCheckpoint)(nil),  // 0: proto.StoredCheckpoint
+	(*SubmittedTx)(nil),       // 1: proto.SubmittedTx
+	(*CheckpointHistory)(nil), // 2: proto.CheckpointHistory
 }
 var file_checkpoint_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: proto.CheckpointHistory.txs:type_name -> proto.SubmittedTx
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_checkpoint_proto_init() }
@@ -141,6 +325,30 @@ func file_checkpoint_proto_init() {
 				return nil
 			}
 		}
+		file_checkpoint_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmittedTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_checkpoint_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -148,7 +356,7 @@ func file_checkpoint_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_checkpoint_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
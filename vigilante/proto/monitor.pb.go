@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.6.1
+// source: monitor.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Evidence is a misbehaviour of Babylon detected by the monitor
+type Evidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"` // 0 for a liveness violation, 1 for a checkpoint mismatch
+	Epoch               uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CheckpointHash      string `protobuf:"bytes,3,opt,name=checkpoint_hash,json=checkpointHash,proto3" json:"checkpoint_hash,omitempty"`
+	BtcHeightEpochEnded uint32 `protobuf:"varint,4,opt,name=btc_height_epoch_ended,json=btcHeightEpochEnded,proto3" json:"btc_height_epoch_ended,omitempty"` // H1, the BTC light client height when the epoch ended
+	BtcHeightFirstSeen  uint32 `protobuf:"varint,5,opt,name=btc_height_first_seen,json=btcHeightFirstSeen,proto3" json:"btc_height_first_seen,omitempty"`    // H2, the BTC height at which the checkpoint first appeared
+	BtcHeightReported   uint32 `protobuf:"varint,6,opt,name=btc_height_reported,json=btcHeightReported,proto3" json:"btc_height_reported,omitempty"`         // H3, the BTC light client height when the checkpoint was reported, 0 if not reported
+	BtcTipHeight        uint32 `protobuf:"varint,7,opt,name=btc_tip_height,json=btcTipHeight,proto3" json:"btc_tip_height,omitempty"`                        // the BTC light client tip height at the latest check
+	Gap                 uint64 `protobuf:"varint,8,opt,name=gap,proto3" json:"gap,omitempty"`                                                                // the number of BTC blocks the checkpoint has not been reported for
+	Details             string `protobuf:"bytes,9,opt,name=details,proto3" json:"details,omitempty"`
+	DetectedTime        int64  `protobuf:"varint,10,opt,name=detected_time,json=detectedTime,proto3" json:"detected_time,omitempty"` // unix timestamp in seconds
+	ResolvedTime        int64  `protobuf:"varint,11,opt,name=resolved_time,json=resolvedTime,proto3" json:"resolved_time,omitempty"` // unix timestamp in seconds, 0 if the evidence is not resolved
+}
+
+func (x *Evidence) Reset() {
+	*x = Evidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_monitor_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Evidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Evidence) ProtoMessage() {}
+
+func (x *Evidence) ProtoReflect() protoreflect.Message {
+	mi := &file_monitor_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Evidence.ProtoReflect.Descriptor instead.
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return file_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Evidence) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Evidence) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Evidence) GetCheckpointHash() string {
+	if x != nil {
+		return x.CheckpointHash
+	}
+	return ""
+}
+
+func (x *Evidence) GetBtcHeightEpochEnded() uint32 {
+	if x != nil {
+		return x.BtcHeightEpochEnded
+	}
+	return 0
+}
+
+func (x *Evidence) GetBtcHeightFirstSeen() uint32 {
+	if x != nil {
+		return x.BtcHeightFirstSeen
+	}
+	return 0
+}
+
+func (x *Evidence) GetBtcHeightReported() uint32 {
+	if x != nil {
+		return x.BtcHeightReported
+	}
+	return 0
+}
+
+func (x *Evidence) GetBtcTipHeight() uint32 {
+	if x != nil {
+		return x.BtcTipHeight
+	}
+	return 0
+}
+
+func (x *Evidence) GetGap() uint64 {
+	if x != nil {
+		return x.Gap
+	}
+	return 0
+}
+
+func (x *Evidence) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Evidence) GetDetectedTime() int64 {
+	if x != nil {
+		return x.DetectedTime
+	}
+	return 0
+}
+
+func (x *Evidence) GetResolvedTime() int64 {
+	if x != nil {
+		return x.ResolvedTime
+	}
+	return 0
+}
+
+var File_monitor_proto protoreflect.FileDescriptor
+
+var file_monitor_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x03, 0x0a, 0x08, 0x45, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x74, 0x63, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x62, 0x74, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x62,
+	0x74, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x62, 0x74, 0x63, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x2e,
+	0x0a, 0x13, 0x62, 0x74, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x62, 0x74, 0x63,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x62, 0x74, 0x63, 0x5f, 0x74, 0x69, 0x70, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x74, 0x63, 0x54, 0x69, 0x70, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x67, 0x61, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x62, 0x79, 0x6c, 0x6f, 0x6e,
+	0x6c, 0x61, 0x62, 0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x67, 0x69, 0x6c, 0x61, 0x6e, 0x74,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_monitor_proto_rawDescOnce sync.Once
+	file_monitor_proto_rawDescData = file_monitor_proto_rawDesc
+)
+
+func file_monitor_proto_rawDescGZIP() []byte {
+	file_monitor_proto_rawDescOnce.Do(func() {
+		file_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(file_monitor_proto_rawDescData)
+	})
+	return file_monitor_proto_rawDescData
+}
+
+var file_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_monitor_proto_goTypes = []interface{}{
+	(*Evidence)(nil), // 0: proto.Evidence
+}
+var file_monitor_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_monitor_proto_init() }
+func file_monitor_proto_init() {
+	if File_monitor_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_monitor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Evidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_monitor_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_monitor_proto_goTypes,
+		DependencyIndexes: file_monitor_proto_depIdxs,
+		MessageInfos:      file_monitor_proto_msgTypes,
+	}.Build()
+	File_monitor_proto = out.File
+	file_monitor_proto_rawDesc = nil
+	file_monitor_proto_goTypes = nil
+	file_monitor_proto_depIdxs = nil
+}
@@ -1,6 +1,7 @@
 package reporter
 
 import (
+	"errors"
 	"fmt"
 	"github.com/babylonlabs-io/vigilante/types"
 	"github.com/btcsuite/btcd/wire"
@@ -81,9 +82,11 @@ func (r *Reporter) handleNewBlock(height uint32, header *wire.BlockHeader) error
 	return r.processNewBlock(ib)
 }
 
-// processNewBlock handles further processing of a newly added block.
+// processNewBlock handles further processing of a newly added block, along with the
+// blocks held back as no majority of the quorum agreed on them so far.
 func (r *Reporter) processNewBlock(ib *types.IndexedBlock) error {
 	var headersToProcess []*types.IndexedBlock
+	headersToProcess = append(headersToProcess, r.heldBackBlocks...)
 	headersToProcess = append(headersToProcess, ib)
 
 	if len(headersToProcess) == 0 {
@@ -95,13 +98,31 @@ func (r *Reporter) processNewBlock(ib *types.IndexedBlock) error {
 	signer := r.babylonClient.MustGetAddr()
 	// Process headers
 	if _, err := r.ProcessHeaders(signer, headersToProcess); err != nil {
+		// the chain of the BTC node is not wrong, only unconfirmed yet, so the headers
+		// are retried along with the next block instead of bootstrapping again
+		if errors.Is(err, ErrNoQuorum) {
+			r.holdBack(headersToProcess, err)
+
+			return nil
+		}
+
 		r.logger.Warnf("Failed to submit headers: %v", err)
 
 		return fmt.Errorf("failed to submit headers: %w", err)
 	}
 
+	r.heldBackBlocks = nil
+
 	// Process checkpoints
 	_, _ = r.ProcessCheckpoints(signer, headersToProcess)
 
 	return nil
 }
+
+// holdBack holds back the headers and checkpoints of the blocks until a majority of
+// the quorum agrees on the best chain. Checkpoints are held back too, as Babylon
+// cannot verify them without the headers.
+func (r *Reporter) holdBack(ibs []*types.IndexedBlock, err error) {
+	r.logger.Warnf("Holding back %d headers until the next block: %v", len(ibs), err)
+	r.heldBackBlocks = ibs
+}
@@ -0,0 +1,101 @@
+package reporter
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/client/babylonclient"
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/golang/mock/gomock"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/vigilante/btcclient/quorum"
+	"github.com/babylonlabs-io/vigilante/config"
+	"github.com/babylonlabs-io/vigilante/metrics"
+	vdatagen "github.com/babylonlabs-io/vigilante/testutil/datagen"
+	"github.com/babylonlabs-io/vigilante/testutil/mocks"
+	"github.com/babylonlabs-io/vigilante/types"
+)
+
+// switchingBackend agrees with the given blocks once agree is set, and diverges before
+type switchingBackend struct {
+	blocks map[uint32]chainhash.Hash
+	agree  atomic.Bool
+}
+
+func (b *switchingBackend) Name() string { return "switching" }
+
+func (b *switchingBackend) BestHeight() (uint32, error) { return 0, nil }
+
+func (b *switchingBackend) BlockHash(height uint32) (*chainhash.Hash, error) {
+	if !b.agree.Load() {
+		return &chainhash.Hash{}, nil
+	}
+	hash := b.blocks[height]
+
+	return &hash, nil
+}
+
+func (b *switchingBackend) Stop() {}
+
+func TestProcessNewBlockHoldsBackHeadersWithoutQuorum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	cfg := config.DefaultConfig()
+	cfg.Reporter.Quorum.RequireMajority = true
+	logger, err := cfg.CreateLogger()
+	require.NoError(t, err)
+
+	mockBabylonClient := NewMockBabylonClient(ctrl)
+	mockBabylonClient.EXPECT().BTCCheckpointParams().Return(
+		&btcctypes.QueryParamsResponse{Params: btcctypes.DefaultParams()}, nil).AnyTimes()
+	mockBabylonClient.EXPECT().MustGetAddr().Return("").AnyTimes()
+
+	rep, err := New(
+		&cfg.Reporter,
+		logger,
+		mocks.NewMockBTCClient(ctrl),
+		mockBabylonClient,
+		&mock.ChainNotifier{},
+		cfg.Common.RetrySleepTime,
+		cfg.Common.MaxRetrySleepTime,
+		metrics.NewReporterMetrics(),
+	)
+	require.NoError(t, err)
+
+	blocks, _, _ := vdatagen.GenRandomBlockchainWithBabylonTx(r, 2, 0, 0)
+	backend := &switchingBackend{blocks: map[uint32]chainhash.Hash{}}
+	var ibs []*types.IndexedBlock
+	for i, block := range blocks {
+		ib := types.NewIndexedBlockFromMsgBlock(uint32(i+1), block)
+		ibs = append(ibs, ib)
+		backend.blocks[ib.Height] = ib.BlockHash()
+	}
+	rep.quorum = quorum.New([]quorum.Backend{backend}, time.Second, 2)
+
+	// the diverging backend holds back the headers without failing, so that the
+	// reporter does not bootstrap again
+	require.NoError(t, rep.processNewBlock(ibs[0]))
+	require.Equal(t, ibs[:1], rep.heldBackBlocks)
+
+	// once the backend agrees, the held back headers are submitted along with the next block
+	backend.agree.Store(true)
+	mockBabylonClient.EXPECT().ContainsBTCBlock(gomock.Any()).Return(
+		&btclctypes.QueryContainsBytesResponse{Contains: false}, nil).Times(1)
+	mockBabylonClient.EXPECT().InsertHeaders(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, msg *btclctypes.MsgInsertHeaders) (*babylonclient.RelayerTxResponse, error) {
+			require.Len(t, msg.Headers, len(ibs))
+
+			return &babylonclient.RelayerTxResponse{Code: 0}, nil
+		}).Times(1)
+
+	require.NoError(t, rep.processNewBlock(ibs[1]))
+	require.Empty(t, rep.heldBackBlocks)
+}
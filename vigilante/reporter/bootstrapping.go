@@ -71,6 +71,9 @@ func (r *Reporter) bootstrap() error {
 		return err
 	}
 
+	// the blocks held back before are processed again from the new cache
+	r.heldBackBlocks = nil
+
 	// initialize cache with the latest blocks
 	if err := r.initBTCCache(); err != nil {
 		return err
@@ -96,7 +99,8 @@ func (r *Reporter) bootstrap() error {
 	// we already checked for consistency, we can be sure that even if rest of the block headers is different than in Babylon
 	// due to reorg, our fork will be better than the one in Babylon.
 	_, err = r.ProcessHeaders(signer, ibs)
-	if err != nil {
+	noQuorum := errors.Is(err, ErrNoQuorum)
+	if err != nil && !noQuorum {
 		// this can happen when there are two contentious vigilantes or if our btc node is behind.
 		r.logger.Errorf("Failed to submit headers: %v", err)
 		// returning error as it is up to the caller to decide what do next
@@ -113,6 +117,15 @@ func (r *Reporter) bootstrap() error {
 
 	r.logger.Infof("Size of the BTC cache: %d", r.btcCache.Size())
 
+	// without a majority of the quorum, the headers and the checkpoints of the cached
+	// blocks are retried along with the next block instead of bootstrapping again
+	if noQuorum {
+		r.holdBack(blocksToHoldBack(r.btcCache.GetAllBlocks(), ibs), err)
+		r.logger.Info("Finished bootstrapping with the headers held back")
+
+		return nil
+	}
+
 	// fetch k+w blocks from cache and submit checkpoints
 	ibs = r.btcCache.GetAllBlocks()
 	_, _ = r.ProcessCheckpoints(signer, ibs)
@@ -122,6 +135,24 @@ func (r *Reporter) bootstrap() error {
 	return nil
 }
 
+// blocksToHoldBack returns the cached blocks preceding the blocks whose headers are not
+// submitted, which only have their checkpoints to process, followed by those blocks
+func blocksToHoldBack(cachedBlocks []*types.IndexedBlock, ibs []*types.IndexedBlock) []*types.IndexedBlock {
+	if len(ibs) == 0 {
+		return cachedBlocks
+	}
+
+	var held []*types.IndexedBlock
+	for _, ib := range cachedBlocks {
+		if ib.Height >= ibs[0].Height {
+			break
+		}
+		held = append(held, ib)
+	}
+
+	return append(held, ibs...)
+}
+
 func (r *Reporter) reporterQuitCtx() (context.Context, func()) {
 	quit := r.quitChan()
 	ctx, cancel := context.WithCancel(context.Background())
@@ -175,10 +206,13 @@ func (r *Reporter) initBTCCache() error {
 		ibs                  []*types.IndexedBlock
 	)
 
-	r.btcCache, err = types.NewBTCCache(r.Cfg.BTCCacheSize) // TODO: give an option to be unsized
+	btcCache, err := types.NewBTCCache(r.Cfg.BTCCacheSize) // TODO: give an option to be unsized
 	if err != nil {
 		panic(err)
 	}
+	r.btcCacheMu.Lock()
+	r.btcCache = btcCache
+	r.btcCacheMu.Unlock()
 
 	// get T, i.e., total block count in BBN header chain
 	tipRes, err := r.babylonClient.BTCHeaderChainTip()
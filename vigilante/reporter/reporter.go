@@ -13,6 +13,7 @@ import (
 	"github.com/babylonlabs-io/babylon/btctxformatter"
 	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
 	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/babylonlabs-io/vigilante/btcclient/quorum"
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
 	"github.com/babylonlabs-io/vigilante/types"
@@ -26,6 +27,8 @@ type Reporter struct {
 	btcClient     btcclient.BTCClient
 	babylonClient BabylonClient
 	btcNotifier   notifier.ChainNotifier
+	// quorum cross-validates the best chain of btcClient, nil if no backend is configured
+	quorum *quorum.Quorum
 
 	// retry attributes
 	retrySleepTime    time.Duration
@@ -34,6 +37,8 @@ type Reporter struct {
 	// Internal states of the reporter
 	CheckpointCache               *types.CheckpointCache
 	btcCache                      *types.BTCCache
+	btcCacheMu                    sync.RWMutex          // guards replacing btcCache while it is read by Status
+	heldBackBlocks                []*types.IndexedBlock // blocks whose headers and checkpoints wait for a majority of the quorum
 	btcConfirmationDepth          uint32
 	checkpointFinalizationTimeout uint32
 	metrics                       *metrics.ReporterMetrics
@@ -82,6 +87,16 @@ func New(
 	// Note that BTC cache is initialised only after bootstrapping
 	ckptCache := types.NewCheckpointCache(checkpointTag, btctxformatter.CurrentVersion)
 
+	var btcQuorum *quorum.Quorum
+	if len(cfg.Quorum.Backends) > 0 {
+		backends, err := quorum.NewBackends(&cfg.Quorum)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the BTC quorum backends: %w", err)
+		}
+		btcQuorum = quorum.New(backends, cfg.Quorum.Timeout, cfg.Quorum.MinVoters)
+		logger.Infof("cross-validating the BTC best chain with %d backends", len(backends))
+	}
+
 	return &Reporter{
 		Cfg:                           cfg,
 		logger:                        logger,
@@ -90,6 +105,7 @@ func New(
 		btcClient:                     btcClient,
 		babylonClient:                 babylonClient,
 		btcNotifier:                   btcNotifier,
+		quorum:                        btcQuorum,
 		CheckpointCache:               ckptCache,
 		btcConfirmationDepth:          k,
 		checkpointFinalizationTimeout: w,
@@ -175,6 +191,32 @@ func (r *Reporter) ShuttingDown() bool {
 	}
 }
 
+// Status is a snapshot of the BTC cache and the checkpoint cache of the reporter
+type Status struct {
+	BTCCacheTip        *types.IndexedBlock // nil if the BTC cache is not initialised yet
+	BTCCacheSize       int
+	PendingSegments    []*types.CkptSegment // segments waiting for the other segment of their checkpoint
+	MatchedCheckpoints int                  // checkpoints matched but not submitted to Babylon yet
+}
+
+// Status returns the current state of the reporter. Thread-safe.
+func (r *Reporter) Status() *Status {
+	status := &Status{
+		PendingSegments:    r.CheckpointCache.PendingSegments(),
+		MatchedCheckpoints: r.CheckpointCache.NumCheckpoints(),
+	}
+
+	r.btcCacheMu.RLock()
+	btcCache := r.btcCache
+	r.btcCacheMu.RUnlock()
+	if btcCache != nil {
+		status.BTCCacheTip = btcCache.Tip()
+		status.BTCCacheSize = btcCache.Size()
+	}
+
+	return status
+}
+
 // WaitForShutdown blocks until all vigilante goroutines have finished executing.
 func (r *Reporter) WaitForShutdown() {
 	// TODO: let Babylon client WaitForShutDown
@@ -2,6 +2,7 @@ package reporter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/babylonlabs-io/vigilante/retrywrap"
 	"strconv"
@@ -9,9 +10,13 @@ import (
 	"github.com/avast/retry-go/v4"
 	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/babylonlabs-io/vigilante/btcclient/quorum"
 	"github.com/babylonlabs-io/vigilante/types"
 )
 
+// ErrNoQuorum is returned when no majority of the BTC backends agrees with the BTC node
+var ErrNoQuorum = errors.New("no majority of the BTC backends agrees on the best chain")
+
 func chunkBy[T any](items []T, chunkSize int) [][]T {
 	var chunks [][]T
 	for chunkSize < len(items) {
@@ -74,6 +79,43 @@ func (r *Reporter) getHeaderMsgsToSubmit(signer string, ibs []*types.IndexedBloc
 	return headerMsgsToSubmit, nil
 }
 
+// checkQuorum cross-validates the last of the given blocks with the BTC backends of the
+// quorum. Without a majority agreeing on it, the divergence is only reported unless
+// the quorum requires a majority.
+func (r *Reporter) checkQuorum(ibs []*types.IndexedBlock) error {
+	if r.quorum == nil || len(ibs) == 0 {
+		return nil
+	}
+
+	tip := ibs[len(ibs)-1]
+	tipHash := tip.BlockHash()
+	result := r.quorum.Check(tip.Height, &tipHash)
+
+	for backend, vote := range result.Votes {
+		r.metrics.QuorumVotesCounterVec.WithLabelValues(backend, vote.String()).Inc()
+		if vote != quorum.VoteAgree {
+			r.logger.Warnf("BTC backend %s votes %s on block %s at height %d", backend, vote, tipHash, tip.Height)
+		}
+	}
+	r.metrics.QuorumAgreeingVotesGauge.Set(float64(result.Agreeing))
+
+	if result.HasMajority() {
+		return nil
+	}
+
+	if !r.Cfg.Quorum.RequireMajority {
+		r.logger.Warnf("only %d out of %d votes agree on block %s at height %d, submitting the headers anyway",
+			result.Agreeing, result.Total, tipHash, tip.Height)
+
+		return nil
+	}
+
+	r.metrics.QuorumRejectedHeadersCounter.Add(float64(len(ibs)))
+
+	return fmt.Errorf("%w: only %d out of %d votes agree on block %s at height %d",
+		ErrNoQuorum, result.Agreeing, result.Total, tipHash, tip.Height)
+}
+
 func (r *Reporter) submitHeaderMsgs(msg *btclctypes.MsgInsertHeaders) error {
 	// submit the headers
 	err := retrywrap.Do(func() error {
@@ -108,6 +150,10 @@ func (r *Reporter) submitHeaderMsgs(msg *btclctypes.MsgInsertHeaders) error {
 // ProcessHeaders extracts and reports headers from a list of blocks
 // It returns the number of headers that need to be reported (after deduplication)
 func (r *Reporter) ProcessHeaders(signer string, ibs []*types.IndexedBlock) (int, error) {
+	if err := r.checkQuorum(ibs); err != nil {
+		return 0, err
+	}
+
 	// get a list of MsgInsertHeader msgs with headers to be submitted
 	headerMsgsToSubmit, err := r.getHeaderMsgsToSubmit(signer, ibs)
 	if err != nil {
@@ -1,13 +1,14 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.0
+// 	protoc-gen-go v1.33.0
 // 	protoc        v3.21.2
 // source: api.proto
 
-package __
+package api
 
 import (
 	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -149,29 +150,1723 @@ func (x *VersionResponse) GetBuildMetadata() string {
 	return ""
 }
 
+type SubmittedTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxIndex         uint32 `protobuf:"varint,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"` // 0 for the first tx of the checkpoint, 1 for the second, 2 for a CPFP child
+	TxId            string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Fee             int64  `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`                                                // in satoshis
+	FeeRate         int64  `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`                         // in satoshis per kvB
+	BroadcastTime   int64  `protobuf:"varint,5,opt,name=broadcast_time,json=broadcastTime,proto3" json:"broadcast_time,omitempty"`       // unix timestamp in seconds
+	InclusionHeight uint32 `protobuf:"varint,6,opt,name=inclusion_height,json=inclusionHeight,proto3" json:"inclusion_height,omitempty"` // 0 if the tx has not been observed in a BTC block
+	TxHex           string `protobuf:"bytes,7,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	BroadcastHeight uint32 `protobuf:"varint,8,opt,name=broadcast_height,json=broadcastHeight,proto3" json:"broadcast_height,omitempty"` // height of the BTC tip when the tx was broadcast, 0 if unknown
+}
+
+func (x *SubmittedTx) Reset() {
+	*x = SubmittedTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmittedTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmittedTx) ProtoMessage() {}
+
+func (x *SubmittedTx) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmittedTx.ProtoReflect.Descriptor instead.
+func (*SubmittedTx) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmittedTx) GetTxIndex() uint32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *SubmittedTx) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetFeeRate() int64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetBroadcastTime() int64 {
+	if x != nil {
+		return x.BroadcastTime
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetInclusionHeight() uint32 {
+	if x != nil {
+		return x.InclusionHeight
+	}
+	return 0
+}
+
+func (x *SubmittedTx) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+func (x *SubmittedTx) GetBroadcastHeight() uint32 {
+	if x != nil {
+		return x.BroadcastHeight
+	}
+	return 0
+}
+
+type CheckpointHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64         `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Txs   []*SubmittedTx `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *CheckpointHistory) Reset() {
+	*x = CheckpointHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointHistory) ProtoMessage() {}
+
+func (x *CheckpointHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointHistory.ProtoReflect.Descriptor instead.
+func (*CheckpointHistory) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckpointHistory) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CheckpointHistory) GetTxs() []*SubmittedTx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+type CheckpointHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *CheckpointHistoryRequest) Reset() {
+	*x = CheckpointHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointHistoryRequest) ProtoMessage() {}
+
+func (x *CheckpointHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointHistoryRequest.ProtoReflect.Descriptor instead.
+func (*CheckpointHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckpointHistoryRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type CheckpointHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	History *CheckpointHistory `protobuf:"bytes,1,opt,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *CheckpointHistoryResponse) Reset() {
+	*x = CheckpointHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointHistoryResponse) ProtoMessage() {}
+
+func (x *CheckpointHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointHistoryResponse.ProtoReflect.Descriptor instead.
+func (*CheckpointHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckpointHistoryResponse) GetHistory() *CheckpointHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ListCheckpointHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	Limit      uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 returns all the histories from start_epoch
+}
+
+func (x *ListCheckpointHistoryRequest) Reset() {
+	*x = ListCheckpointHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCheckpointHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCheckpointHistoryRequest) ProtoMessage() {}
+
+func (x *ListCheckpointHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCheckpointHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListCheckpointHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCheckpointHistoryRequest) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *ListCheckpointHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCheckpointHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Histories []*CheckpointHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+}
+
+func (x *ListCheckpointHistoryResponse) Reset() {
+	*x = ListCheckpointHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCheckpointHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCheckpointHistoryResponse) ProtoMessage() {}
+
+func (x *ListCheckpointHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCheckpointHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListCheckpointHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCheckpointHistoryResponse) GetHistories() []*CheckpointHistory {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+type SubmitterStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitterStatusRequest) Reset() {
+	*x = SubmitterStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitterStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitterStatusRequest) ProtoMessage() {}
+
+func (x *SubmitterStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitterStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubmitterStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{8}
+}
+
+type SubmitterStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastSubmittedEpoch uint64 `protobuf:"varint,1,opt,name=last_submitted_epoch,json=lastSubmittedEpoch,proto3" json:"last_submitted_epoch,omitempty"` // 0 if no checkpoint was submitted since the submitter started
+	Tx1Id              string `protobuf:"bytes,2,opt,name=tx1_id,json=tx1Id,proto3" json:"tx1_id,omitempty"`
+	Tx2Id              string `protobuf:"bytes,3,opt,name=tx2_id,json=tx2Id,proto3" json:"tx2_id,omitempty"`                                        // empty for a single tx checkpoint
+	ChildTxId          string `protobuf:"bytes,4,opt,name=child_tx_id,json=childTxId,proto3" json:"child_tx_id,omitempty"`                          // empty if the checkpoint was not bumped with a CPFP child
+	LastSubmittedTime  int64  `protobuf:"varint,5,opt,name=last_submitted_time,json=lastSubmittedTime,proto3" json:"last_submitted_time,omitempty"` // unix timestamp in seconds
+	NextResendTime     int64  `protobuf:"varint,6,opt,name=next_resend_time,json=nextResendTime,proto3" json:"next_resend_time,omitempty"`          // unix timestamp in seconds, the checkpoint is bumped if it is not included by then
+}
+
+func (x *SubmitterStatusResponse) Reset() {
+	*x = SubmitterStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitterStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitterStatusResponse) ProtoMessage() {}
+
+func (x *SubmitterStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitterStatusResponse.ProtoReflect.Descriptor instead.
+func (*SubmitterStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubmitterStatusResponse) GetLastSubmittedEpoch() uint64 {
+	if x != nil {
+		return x.LastSubmittedEpoch
+	}
+	return 0
+}
+
+func (x *SubmitterStatusResponse) GetTx1Id() string {
+	if x != nil {
+		return x.Tx1Id
+	}
+	return ""
+}
+
+func (x *SubmitterStatusResponse) GetTx2Id() string {
+	if x != nil {
+		return x.Tx2Id
+	}
+	return ""
+}
+
+func (x *SubmitterStatusResponse) GetChildTxId() string {
+	if x != nil {
+		return x.ChildTxId
+	}
+	return ""
+}
+
+func (x *SubmitterStatusResponse) GetLastSubmittedTime() int64 {
+	if x != nil {
+		return x.LastSubmittedTime
+	}
+	return 0
+}
+
+func (x *SubmitterStatusResponse) GetNextResendTime() int64 {
+	if x != nil {
+		return x.NextResendTime
+	}
+	return 0
+}
+
+type CheckpointSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index       uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // part of the checkpoint the segment carries
+	TxId        string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockHeight uint32 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash   string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (x *CheckpointSegment) Reset() {
+	*x = CheckpointSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointSegment) ProtoMessage() {}
+
+func (x *CheckpointSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointSegment.ProtoReflect.Descriptor instead.
+func (*CheckpointSegment) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckpointSegment) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CheckpointSegment) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *CheckpointSegment) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *CheckpointSegment) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type ReporterStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReporterStatusRequest) Reset() {
+	*x = ReporterStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReporterStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReporterStatusRequest) ProtoMessage() {}
+
+func (x *ReporterStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReporterStatusRequest.ProtoReflect.Descriptor instead.
+func (*ReporterStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{11}
+}
+
+type ReporterStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BtcCacheTipHeight  uint32               `protobuf:"varint,1,opt,name=btc_cache_tip_height,json=btcCacheTipHeight,proto3" json:"btc_cache_tip_height,omitempty"` // 0 if the BTC cache is not initialised yet
+	BtcCacheTipHash    string               `protobuf:"bytes,2,opt,name=btc_cache_tip_hash,json=btcCacheTipHash,proto3" json:"btc_cache_tip_hash,omitempty"`
+	BtcCacheSize       uint32               `protobuf:"varint,3,opt,name=btc_cache_size,json=btcCacheSize,proto3" json:"btc_cache_size,omitempty"`
+	PendingSegments    []*CheckpointSegment `protobuf:"bytes,4,rep,name=pending_segments,json=pendingSegments,proto3" json:"pending_segments,omitempty"`
+	MatchedCheckpoints uint32               `protobuf:"varint,5,opt,name=matched_checkpoints,json=matchedCheckpoints,proto3" json:"matched_checkpoints,omitempty"` // checkpoints matched but not submitted to Babylon yet
+}
+
+func (x *ReporterStatusResponse) Reset() {
+	*x = ReporterStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReporterStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReporterStatusResponse) ProtoMessage() {}
+
+func (x *ReporterStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReporterStatusResponse.ProtoReflect.Descriptor instead.
+func (*ReporterStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReporterStatusResponse) GetBtcCacheTipHeight() uint32 {
+	if x != nil {
+		return x.BtcCacheTipHeight
+	}
+	return 0
+}
+
+func (x *ReporterStatusResponse) GetBtcCacheTipHash() string {
+	if x != nil {
+		return x.BtcCacheTipHash
+	}
+	return ""
+}
+
+func (x *ReporterStatusResponse) GetBtcCacheSize() uint32 {
+	if x != nil {
+		return x.BtcCacheSize
+	}
+	return 0
+}
+
+func (x *ReporterStatusResponse) GetPendingSegments() []*CheckpointSegment {
+	if x != nil {
+		return x.PendingSegments
+	}
+	return nil
+}
+
+func (x *ReporterStatusResponse) GetMatchedCheckpoints() uint32 {
+	if x != nil {
+		return x.MatchedCheckpoints
+	}
+	return 0
+}
+
+type LivenessCheckEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch              uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CheckpointHash     string `protobuf:"bytes,2,opt,name=checkpoint_hash,json=checkpointHash,proto3" json:"checkpoint_hash,omitempty"`
+	FirstSeenBtcHeight uint32 `protobuf:"varint,3,opt,name=first_seen_btc_height,json=firstSeenBtcHeight,proto3" json:"first_seen_btc_height,omitempty"`
+}
+
+func (x *LivenessCheckEntry) Reset() {
+	*x = LivenessCheckEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LivenessCheckEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessCheckEntry) ProtoMessage() {}
+
+func (x *LivenessCheckEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessCheckEntry.ProtoReflect.Descriptor instead.
+func (*LivenessCheckEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LivenessCheckEntry) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LivenessCheckEntry) GetCheckpointHash() string {
+	if x != nil {
+		return x.CheckpointHash
+	}
+	return ""
+}
+
+func (x *LivenessCheckEntry) GetFirstSeenBtcHeight() uint32 {
+	if x != nil {
+		return x.FirstSeenBtcHeight
+	}
+	return 0
+}
+
+type MonitorStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MonitorStatusRequest) Reset() {
+	*x = MonitorStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorStatusRequest) ProtoMessage() {}
+
+func (x *MonitorStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorStatusRequest.ProtoReflect.Descriptor instead.
+func (*MonitorStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{14}
+}
+
+type MonitorStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CheckedEpoch      uint64                `protobuf:"varint,1,opt,name=checked_epoch,json=checkedEpoch,proto3" json:"checked_epoch,omitempty"`               // latest epoch whose checkpoint was verified
+	CheckedBtcHeight  uint64                `protobuf:"varint,2,opt,name=checked_btc_height,json=checkedBtcHeight,proto3" json:"checked_btc_height,omitempty"` // latest confirmed BTC height scanned for checkpoints
+	LivenessChecklist []*LivenessCheckEntry `protobuf:"bytes,3,rep,name=liveness_checklist,json=livenessChecklist,proto3" json:"liveness_checklist,omitempty"` // checkpoints that have not passed the liveness check yet
+}
+
+func (x *MonitorStatusResponse) Reset() {
+	*x = MonitorStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorStatusResponse) ProtoMessage() {}
+
+func (x *MonitorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorStatusResponse.ProtoReflect.Descriptor instead.
+func (*MonitorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MonitorStatusResponse) GetCheckedEpoch() uint64 {
+	if x != nil {
+		return x.CheckedEpoch
+	}
+	return 0
+}
+
+func (x *MonitorStatusResponse) GetCheckedBtcHeight() uint64 {
+	if x != nil {
+		return x.CheckedBtcHeight
+	}
+	return 0
+}
+
+func (x *MonitorStatusResponse) GetLivenessChecklist() []*LivenessCheckEntry {
+	if x != nil {
+		return x.LivenessChecklist
+	}
+	return nil
+}
+
+type MonitorEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // liveness-violation or checkpoint-mismatch
+	Epoch               uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CheckpointHash      string `protobuf:"bytes,3,opt,name=checkpoint_hash,json=checkpointHash,proto3" json:"checkpoint_hash,omitempty"`
+	BtcHeightEpochEnded uint32 `protobuf:"varint,4,opt,name=btc_height_epoch_ended,json=btcHeightEpochEnded,proto3" json:"btc_height_epoch_ended,omitempty"` // H1
+	BtcHeightFirstSeen  uint32 `protobuf:"varint,5,opt,name=btc_height_first_seen,json=btcHeightFirstSeen,proto3" json:"btc_height_first_seen,omitempty"`    // H2
+	BtcHeightReported   uint32 `protobuf:"varint,6,opt,name=btc_height_reported,json=btcHeightReported,proto3" json:"btc_height_reported,omitempty"`         // H3, 0 if the checkpoint is not reported
+	BtcTipHeight        uint32 `protobuf:"varint,7,opt,name=btc_tip_height,json=btcTipHeight,proto3" json:"btc_tip_height,omitempty"`                        // BTC light client tip at the latest check of an unreported checkpoint
+	Gap                 uint64 `protobuf:"varint,8,opt,name=gap,proto3" json:"gap,omitempty"`
+	Details             string `protobuf:"bytes,9,opt,name=details,proto3" json:"details,omitempty"`
+	DetectedTime        int64  `protobuf:"varint,10,opt,name=detected_time,json=detectedTime,proto3" json:"detected_time,omitempty"` // unix timestamp in seconds
+	ResolvedTime        int64  `protobuf:"varint,11,opt,name=resolved_time,json=resolvedTime,proto3" json:"resolved_time,omitempty"` // unix timestamp in seconds, 0 if the evidence is not resolved
+}
+
+func (x *MonitorEvidence) Reset() {
+	*x = MonitorEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorEvidence) ProtoMessage() {}
+
+func (x *MonitorEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorEvidence.ProtoReflect.Descriptor instead.
+func (*MonitorEvidence) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MonitorEvidence) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MonitorEvidence) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *MonitorEvidence) GetCheckpointHash() string {
+	if x != nil {
+		return x.CheckpointHash
+	}
+	return ""
+}
+
+func (x *MonitorEvidence) GetBtcHeightEpochEnded() uint32 {
+	if x != nil {
+		return x.BtcHeightEpochEnded
+	}
+	return 0
+}
+
+func (x *MonitorEvidence) GetBtcHeightFirstSeen() uint32 {
+	if x != nil {
+		return x.BtcHeightFirstSeen
+	}
+	return 0
+}
+
+func (x *MonitorEvidence) GetBtcHeightReported() uint32 {
+	if x != nil {
+		return x.BtcHeightReported
+	}
+	return 0
+}
+
+func (x *MonitorEvidence) GetBtcTipHeight() uint32 {
+	if x != nil {
+		return x.BtcTipHeight
+	}
+	return 0
+}
+
+func (x *MonitorEvidence) GetGap() uint64 {
+	if x != nil {
+		return x.Gap
+	}
+	return 0
+}
+
+func (x *MonitorEvidence) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *MonitorEvidence) GetDetectedTime() int64 {
+	if x != nil {
+		return x.DetectedTime
+	}
+	return 0
+}
+
+func (x *MonitorEvidence) GetResolvedTime() int64 {
+	if x != nil {
+		return x.ResolvedTime
+	}
+	return 0
+}
+
+type ListMonitorEvidenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	Limit      uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 returns all the evidence from start_epoch
+}
+
+func (x *ListMonitorEvidenceRequest) Reset() {
+	*x = ListMonitorEvidenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMonitorEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMonitorEvidenceRequest) ProtoMessage() {}
+
+func (x *ListMonitorEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMonitorEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*ListMonitorEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMonitorEvidenceRequest) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *ListMonitorEvidenceRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMonitorEvidenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evidence []*MonitorEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *ListMonitorEvidenceResponse) Reset() {
+	*x = ListMonitorEvidenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMonitorEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMonitorEvidenceResponse) ProtoMessage() {}
+
+func (x *ListMonitorEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMonitorEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*ListMonitorEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMonitorEvidenceResponse) GetEvidence() []*MonitorEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type BTCStakingTrackerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BTCStakingTrackerStatusRequest) Reset() {
+	*x = BTCStakingTrackerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BTCStakingTrackerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BTCStakingTrackerStatusRequest) ProtoMessage() {}
+
+func (x *BTCStakingTrackerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BTCStakingTrackerStatusRequest.ProtoReflect.Descriptor instead.
+func (*BTCStakingTrackerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{19}
+}
+
+type BTCStakingTrackerStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnbondingTrackedDelegations         uint32 `protobuf:"varint,1,opt,name=unbonding_tracked_delegations,json=unbondingTrackedDelegations,proto3" json:"unbonding_tracked_delegations,omitempty"` // active delegations watched for unbonding
+	PendingActivations                  uint32 `protobuf:"varint,2,opt,name=pending_activations,json=pendingActivations,proto3" json:"pending_activations,omitempty"`                              // verified delegations waiting for their inclusion proof
+	ActivationsInProgress               uint32 `protobuf:"varint,3,opt,name=activations_in_progress,json=activationsInProgress,proto3" json:"activations_in_progress,omitempty"`
+	VerifiedNotInChainDelegations       uint32 `protobuf:"varint,4,opt,name=verified_not_in_chain_delegations,json=verifiedNotInChainDelegations,proto3" json:"verified_not_in_chain_delegations,omitempty"`
+	VerifiedInsufficientConfDelegations uint32 `protobuf:"varint,5,opt,name=verified_insufficient_conf_delegations,json=verifiedInsufficientConfDelegations,proto3" json:"verified_insufficient_conf_delegations,omitempty"`
+	AtomicSlasherTrackedDelegations     uint32 `protobuf:"varint,6,opt,name=atomic_slasher_tracked_delegations,json=atomicSlasherTrackedDelegations,proto3" json:"atomic_slasher_tracked_delegations,omitempty"`
+	SlashingInProgress                  uint32 `protobuf:"varint,7,opt,name=slashing_in_progress,json=slashingInProgress,proto3" json:"slashing_in_progress,omitempty"` // delegations whose slashing txs are being submitted
+}
+
+func (x *BTCStakingTrackerStatusResponse) Reset() {
+	*x = BTCStakingTrackerStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BTCStakingTrackerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BTCStakingTrackerStatusResponse) ProtoMessage() {}
+
+func (x *BTCStakingTrackerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BTCStakingTrackerStatusResponse.ProtoReflect.Descriptor instead.
+func (*BTCStakingTrackerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BTCStakingTrackerStatusResponse) GetUnbondingTrackedDelegations() uint32 {
+	if x != nil {
+		return x.UnbondingTrackedDelegations
+	}
+	return 0
+}
+
+func (x *BTCStakingTrackerStatusResponse) GetPendingActivations() uint32 {
+	if x != nil {
+		return x.PendingActivations
+	}
+	return 0
+}
+
+func (x *BTCStakingTrackerStatusResponse) GetActivationsInProgress() uint32 {
+	if x != nil {
+		return x.ActivationsInProgress
+	}
+	return 0
+}
+
+func (x *BTCStakingTrackerStatusResponse) GetVerifiedNotInChainDelegations() uint32 {
+	if x != nil {
+		return x.VerifiedNotInChainDelegations
+	}
+	return 0
+}
+
+func (x *BTCStakingTrackerStatusResponse) GetVerifiedInsufficientConfDelegations() uint32 {
+	if x != nil {
+		return x.VerifiedInsufficientConfDelegations
+	}
+	return 0
+}
+
+func (x *BTCStakingTrackerStatusResponse) GetAtomicSlasherTrackedDelegations() uint32 {
+	if x != nil {
+		return x.AtomicSlasherTrackedDelegations
+	}
+	return 0
+}
+
+func (x *BTCStakingTrackerStatusResponse) GetSlashingInProgress() uint32 {
+	if x != nil {
+		return x.SlashingInProgress
+	}
+	return 0
+}
+
+type SlashingRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FpBtcPk         string `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3" json:"fp_btc_pk,omitempty"` // hex of the BIP-340 PK of the slashed finality provider
+	StakingTxHash   string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	Path            string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"` // staking or unbonding
+	SlashingTxHash  string `protobuf:"bytes,4,opt,name=slashing_tx_hash,json=slashingTxHash,proto3" json:"slashing_tx_hash,omitempty"`
+	SlashingTx      []byte `protobuf:"bytes,5,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"`                 // with witness, empty if it could not be built
+	Status          string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                           // failed, broadcast, confirmed or unslashable
+	BroadcastHeight uint32 `protobuf:"varint,7,opt,name=broadcast_height,json=broadcastHeight,proto3" json:"broadcast_height,omitempty"` // 0 if not broadcast
+	ConfirmHeight   uint32 `protobuf:"varint,8,opt,name=confirm_height,json=confirmHeight,proto3" json:"confirm_height,omitempty"`       // 0 if not confirmed
+	Error           string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                             // error of the last attempt, empty if it succeeded
+	Attempts        uint32 `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	UpdatedTime     int64  `protobuf:"varint,11,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"` // unix timestamp in seconds
+}
+
+func (x *SlashingRecord) Reset() {
+	*x = SlashingRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingRecord) ProtoMessage() {}
+
+func (x *SlashingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingRecord.ProtoReflect.Descriptor instead.
+func (*SlashingRecord) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SlashingRecord) GetFpBtcPk() string {
+	if x != nil {
+		return x.FpBtcPk
+	}
+	return ""
+}
+
+func (x *SlashingRecord) GetStakingTxHash() string {
+	if x != nil {
+		return x.StakingTxHash
+	}
+	return ""
+}
+
+func (x *SlashingRecord) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SlashingRecord) GetSlashingTxHash() string {
+	if x != nil {
+		return x.SlashingTxHash
+	}
+	return ""
+}
+
+func (x *SlashingRecord) GetSlashingTx() []byte {
+	if x != nil {
+		return x.SlashingTx
+	}
+	return nil
+}
+
+func (x *SlashingRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SlashingRecord) GetBroadcastHeight() uint32 {
+	if x != nil {
+		return x.BroadcastHeight
+	}
+	return 0
+}
+
+func (x *SlashingRecord) GetConfirmHeight() uint32 {
+	if x != nil {
+		return x.ConfirmHeight
+	}
+	return 0
+}
+
+func (x *SlashingRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SlashingRecord) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *SlashingRecord) GetUpdatedTime() int64 {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return 0
+}
+
+type ListSlashingRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FpBtcPk string `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3" json:"fp_btc_pk,omitempty"` // empty returns the records of all the finality providers
+	Limit   uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                     // 0 returns all the records
+}
+
+func (x *ListSlashingRecordsRequest) Reset() {
+	*x = ListSlashingRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSlashingRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlashingRecordsRequest) ProtoMessage() {}
+
+func (x *ListSlashingRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlashingRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListSlashingRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListSlashingRecordsRequest) GetFpBtcPk() string {
+	if x != nil {
+		return x.FpBtcPk
+	}
+	return ""
+}
+
+func (x *ListSlashingRecordsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSlashingRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*SlashingRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *ListSlashingRecordsResponse) Reset() {
+	*x = ListSlashingRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSlashingRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlashingRecordsResponse) ProtoMessage() {}
+
+func (x *ListSlashingRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlashingRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListSlashingRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListSlashingRecordsResponse) GetRecords() []*SlashingRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
 var File_api_proto protoreflect.FileDescriptor
 
 var file_api_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x72, 0x70, 0x63,
-	0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
-	0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61,
-	0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0x48, 0x0a, 0x10, 0x56, 0x69, 0x67, 0x69, 0x6c, 0x61,
-	0x6e, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x10,
+	0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x61, 0x6a, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xfe, 0x01, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4d, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x22, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52,
+	0x03, 0x74, 0x78, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x55, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x55, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf3, 0x01,
+	0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x74,
+	0x78, 0x31, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x31,
+	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x32, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x32, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x90, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x62, 0x74,
+	0x63, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x74, 0x69, 0x70, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x62, 0x74, 0x63, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x54, 0x69, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x62,
+	0x74, 0x63, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x74, 0x69, 0x70, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x74, 0x63, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x54, 0x69, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x74, 0x63, 0x5f,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x62, 0x74, 0x63, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x41,
+	0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x31, 0x0a, 0x15, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x42, 0x74, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x15, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x74,
+	0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x74, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x46, 0x0a, 0x12, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x98, 0x03, 0x0a, 0x0f, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x33, 0x0a, 0x16, 0x62, 0x74, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x62, 0x74, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x62, 0x74, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x12, 0x62, 0x74, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x74, 0x63, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x62, 0x74, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x74, 0x63, 0x5f, 0x74,
+	0x69, 0x70, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x62, 0x74, 0x63, 0x54, 0x69, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x70, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4f, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x42, 0x54, 0x43,
+	0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xec, 0x03, 0x0a, 0x1f,
+	0x42, 0x54, 0x43, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x1d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x21,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x26, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x23, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x49, 0x6e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x22, 0x61,
+	0x74, 0x6f, 0x6d, 0x69, 0x63, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1f, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf2, 0x02, 0x0a, 0x0e, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a,
+	0x09, 0x66, 0x70, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x66, 0x70, 0x42, 0x74, 0x63, 0x50, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x4e, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x09, 0x66, 0x70, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x66, 0x70, 0x42, 0x74, 0x63, 0x50, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x4c, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x32, 0xaa, 0x08,
+	0x0a, 0x10, 0x56, 0x69, 0x67, 0x69, 0x6c, 0x61, 0x6e, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d,
+	0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x7d, 0x0a,
+	0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x7d, 0x12, 0x81, 0x01, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x6a, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x66, 0x0a, 0x0e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
+	0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x62, 0x0a, 0x0d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x76, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1f, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x8b, 0x01, 0x0a, 0x17, 0x42, 0x54, 0x43, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x54, 0x43, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x54, 0x43, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x74, 0x63, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2d,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x89,
+	0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x12, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x74, 0x63, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2d, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x2d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f,
+	0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -186,19 +1881,64 @@ func file_api_proto_rawDescGZIP() []byte {
 	return file_api_proto_rawDescData
 }
 
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_api_proto_goTypes = []interface{}{
-	(*VersionRequest)(nil),  // 0: rpc.VersionRequest
-	(*VersionResponse)(nil), // 1: rpc.VersionResponse
+	(*VersionRequest)(nil),                  // 0: rpc.VersionRequest
+	(*VersionResponse)(nil),                 // 1: rpc.VersionResponse
+	(*SubmittedTx)(nil),                     // 2: rpc.SubmittedTx
+	(*CheckpointHistory)(nil),               // 3: rpc.CheckpointHistory
+	(*CheckpointHistoryRequest)(nil),        // 4: rpc.CheckpointHistoryRequest
+	(*CheckpointHistoryResponse)(nil),       // 5: rpc.CheckpointHistoryResponse
+	(*ListCheckpointHistoryRequest)(nil),    // 6: rpc.ListCheckpointHistoryRequest
+	(*ListCheckpointHistoryResponse)(nil),   // 7: rpc.ListCheckpointHistoryResponse
+	(*SubmitterStatusRequest)(nil),          // 8: rpc.SubmitterStatusRequest
+	(*SubmitterStatusResponse)(nil),         // 9: rpc.SubmitterStatusResponse
+	(*CheckpointSegment)(nil),               // 10: rpc.CheckpointSegment
+	(*ReporterStatusRequest)(nil),           // 11: rpc.ReporterStatusRequest
+	(*ReporterStatusResponse)(nil),          // 12: rpc.ReporterStatusResponse
+	(*LivenessCheckEntry)(nil),              // 13: rpc.LivenessCheckEntry
+	(*MonitorStatusRequest)(nil),            // 14: rpc.MonitorStatusRequest
+	(*MonitorStatusResponse)(nil),           // 15: rpc.MonitorStatusResponse
+	(*MonitorEvidence)(nil),                 // 16: rpc.MonitorEvidence
+	(*ListMonitorEvidenceRequest)(nil),      // 17: rpc.ListMonitorEvidenceRequest
+	(*ListMonitorEvidenceResponse)(nil),     // 18: rpc.ListMonitorEvidenceResponse
+	(*BTCStakingTrackerStatusRequest)(nil),  // 19: rpc.BTCStakingTrackerStatusRequest
+	(*BTCStakingTrackerStatusResponse)(nil), // 20: rpc.BTCStakingTrackerStatusResponse
+	(*SlashingRecord)(nil),                  // 21: rpc.SlashingRecord
+	(*ListSlashingRecordsRequest)(nil),      // 22: rpc.ListSlashingRecordsRequest
+	(*ListSlashingRecordsResponse)(nil),     // 23: rpc.ListSlashingRecordsResponse
 }
 var file_api_proto_depIdxs = []int32{
-	0, // 0: rpc.VigilanteService.Version:input_type -> rpc.VersionRequest
-	1, // 1: rpc.VigilanteService.Version:output_type -> rpc.VersionResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: rpc.CheckpointHistory.txs:type_name -> rpc.SubmittedTx
+	3,  // 1: rpc.CheckpointHistoryResponse.history:type_name -> rpc.CheckpointHistory
+	3,  // 2: rpc.ListCheckpointHistoryResponse.histories:type_name -> rpc.CheckpointHistory
+	10, // 3: rpc.ReporterStatusResponse.pending_segments:type_name -> rpc.CheckpointSegment
+	13, // 4: rpc.MonitorStatusResponse.liveness_checklist:type_name -> rpc.LivenessCheckEntry
+	16, // 5: rpc.ListMonitorEvidenceResponse.evidence:type_name -> rpc.MonitorEvidence
+	21, // 6: rpc.ListSlashingRecordsResponse.records:type_name -> rpc.SlashingRecord
+	0,  // 7: rpc.VigilanteService.Version:input_type -> rpc.VersionRequest
+	4,  // 8: rpc.VigilanteService.CheckpointHistory:input_type -> rpc.CheckpointHistoryRequest
+	6,  // 9: rpc.VigilanteService.ListCheckpointHistory:input_type -> rpc.ListCheckpointHistoryRequest
+	8,  // 10: rpc.VigilanteService.SubmitterStatus:input_type -> rpc.SubmitterStatusRequest
+	11, // 11: rpc.VigilanteService.ReporterStatus:input_type -> rpc.ReporterStatusRequest
+	14, // 12: rpc.VigilanteService.MonitorStatus:input_type -> rpc.MonitorStatusRequest
+	17, // 13: rpc.VigilanteService.ListMonitorEvidence:input_type -> rpc.ListMonitorEvidenceRequest
+	19, // 14: rpc.VigilanteService.BTCStakingTrackerStatus:input_type -> rpc.BTCStakingTrackerStatusRequest
+	22, // 15: rpc.VigilanteService.ListSlashingRecords:input_type -> rpc.ListSlashingRecordsRequest
+	1,  // 16: rpc.VigilanteService.Version:output_type -> rpc.VersionResponse
+	5,  // 17: rpc.VigilanteService.CheckpointHistory:output_type -> rpc.CheckpointHistoryResponse
+	7,  // 18: rpc.VigilanteService.ListCheckpointHistory:output_type -> rpc.ListCheckpointHistoryResponse
+	9,  // 19: rpc.VigilanteService.SubmitterStatus:output_type -> rpc.SubmitterStatusResponse
+	12, // 20: rpc.VigilanteService.ReporterStatus:output_type -> rpc.ReporterStatusResponse
+	15, // 21: rpc.VigilanteService.MonitorStatus:output_type -> rpc.MonitorStatusResponse
+	18, // 22: rpc.VigilanteService.ListMonitorEvidence:output_type -> rpc.ListMonitorEvidenceResponse
+	20, // 23: rpc.VigilanteService.BTCStakingTrackerStatus:output_type -> rpc.BTCStakingTrackerStatusResponse
+	23, // 24: rpc.VigilanteService.ListSlashingRecords:output_type -> rpc.ListSlashingRecordsResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -219,8 +1959,272 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionResponse); i {
+		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmittedTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCheckpointHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCheckpointHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitterStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitterStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReporterStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReporterStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LivenessCheckEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMonitorEvidenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMonitorEvidenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BTCStakingTrackerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BTCStakingTrackerStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSlashingRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSlashingRecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -238,7 +2242,7 @@ func file_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -265,6 +2269,22 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type VigilanteServiceClient interface {
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// CheckpointHistory returns every tx the submitter broadcast for the checkpoint of an epoch
+	CheckpointHistory(ctx context.Context, in *CheckpointHistoryRequest, opts ...grpc.CallOption) (*CheckpointHistoryResponse, error)
+	// ListCheckpointHistory returns the submission histories of a range of epochs
+	ListCheckpointHistory(ctx context.Context, in *ListCheckpointHistoryRequest, opts ...grpc.CallOption) (*ListCheckpointHistoryResponse, error)
+	// SubmitterStatus returns the last checkpoint the submitter sent to BTC
+	SubmitterStatus(ctx context.Context, in *SubmitterStatusRequest, opts ...grpc.CallOption) (*SubmitterStatusResponse, error)
+	// ReporterStatus returns the BTC cache tip and the checkpoint segments waiting for their counterpart
+	ReporterStatus(ctx context.Context, in *ReporterStatusRequest, opts ...grpc.CallOption) (*ReporterStatusResponse, error)
+	// MonitorStatus returns the progress of the checkpoint verification and the liveness checklist
+	MonitorStatus(ctx context.Context, in *MonitorStatusRequest, opts ...grpc.CallOption) (*MonitorStatusResponse, error)
+	// ListMonitorEvidence returns the liveness violations and checkpoint mismatches detected by the monitor
+	ListMonitorEvidence(ctx context.Context, in *ListMonitorEvidenceRequest, opts ...grpc.CallOption) (*ListMonitorEvidenceResponse, error)
+	// BTCStakingTrackerStatus returns the number of BTC delegations tracked by the BTC staking tracker
+	BTCStakingTrackerStatus(ctx context.Context, in *BTCStakingTrackerStatusRequest, opts ...grpc.CallOption) (*BTCStakingTrackerStatusResponse, error)
+	// ListSlashingRecords returns the slashing txs submitted to Bitcoin by the BTC slasher
+	ListSlashingRecords(ctx context.Context, in *ListSlashingRecordsRequest, opts ...grpc.CallOption) (*ListSlashingRecordsResponse, error)
 }
 
 type vigilanteServiceClient struct {
@@ -284,9 +2304,97 @@ func (c *vigilanteServiceClient) Version(ctx context.Context, in *VersionRequest
 	return out, nil
 }
 
+func (c *vigilanteServiceClient) CheckpointHistory(ctx context.Context, in *CheckpointHistoryRequest, opts ...grpc.CallOption) (*CheckpointHistoryResponse, error) {
+	out := new(CheckpointHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rpc.VigilanteService/CheckpointHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vigilanteServiceClient) ListCheckpointHistory(ctx context.Context, in *ListCheckpointHistoryRequest, opts ...grpc.CallOption) (*ListCheckpointHistoryResponse, error) {
+	out := new(ListCheckpointHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rpc.VigilanteService/ListCheckpointHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vigilanteServiceClient) SubmitterStatus(ctx context.Context, in *SubmitterStatusRequest, opts ...grpc.CallOption) (*SubmitterStatusResponse, error) {
+	out := new(SubmitterStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpc.VigilanteService/SubmitterStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vigilanteServiceClient) ReporterStatus(ctx context.Context, in *ReporterStatusRequest, opts ...grpc.CallOption) (*ReporterStatusResponse, error) {
+	out := new(ReporterStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpc.VigilanteService/ReporterStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vigilanteServiceClient) MonitorStatus(ctx context.Context, in *MonitorStatusRequest, opts ...grpc.CallOption) (*MonitorStatusResponse, error) {
+	out := new(MonitorStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpc.VigilanteService/MonitorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vigilanteServiceClient) ListMonitorEvidence(ctx context.Context, in *ListMonitorEvidenceRequest, opts ...grpc.CallOption) (*ListMonitorEvidenceResponse, error) {
+	out := new(ListMonitorEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/rpc.VigilanteService/ListMonitorEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vigilanteServiceClient) BTCStakingTrackerStatus(ctx context.Context, in *BTCStakingTrackerStatusRequest, opts ...grpc.CallOption) (*BTCStakingTrackerStatusResponse, error) {
+	out := new(BTCStakingTrackerStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpc.VigilanteService/BTCStakingTrackerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vigilanteServiceClient) ListSlashingRecords(ctx context.Context, in *ListSlashingRecordsRequest, opts ...grpc.CallOption) (*ListSlashingRecordsResponse, error) {
+	out := new(ListSlashingRecordsResponse)
+	err := c.cc.Invoke(ctx, "/rpc.VigilanteService/ListSlashingRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VigilanteServiceServer is the server API for VigilanteService service.
 type VigilanteServiceServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// CheckpointHistory returns every tx the submitter broadcast for the checkpoint of an epoch
+	CheckpointHistory(context.Context, *CheckpointHistoryRequest) (*CheckpointHistoryResponse, error)
+	// ListCheckpointHistory returns the submission histories of a range of epochs
+	ListCheckpointHistory(context.Context, *ListCheckpointHistoryRequest) (*ListCheckpointHistoryResponse, error)
+	// SubmitterStatus returns the last checkpoint the submitter sent to BTC
+	SubmitterStatus(context.Context, *SubmitterStatusRequest) (*SubmitterStatusResponse, error)
+	// ReporterStatus returns the BTC cache tip and the checkpoint segments waiting for their counterpart
+	ReporterStatus(context.Context, *ReporterStatusRequest) (*ReporterStatusResponse, error)
+	// MonitorStatus returns the progress of the checkpoint verification and the liveness checklist
+	MonitorStatus(context.Context, *MonitorStatusRequest) (*MonitorStatusResponse, error)
+	// ListMonitorEvidence returns the liveness violations and checkpoint mismatches detected by the monitor
+	ListMonitorEvidence(context.Context, *ListMonitorEvidenceRequest) (*ListMonitorEvidenceResponse, error)
+	// BTCStakingTrackerStatus returns the number of BTC delegations tracked by the BTC staking tracker
+	BTCStakingTrackerStatus(context.Context, *BTCStakingTrackerStatusRequest) (*BTCStakingTrackerStatusResponse, error)
+	// ListSlashingRecords returns the slashing txs submitted to Bitcoin by the BTC slasher
+	ListSlashingRecords(context.Context, *ListSlashingRecordsRequest) (*ListSlashingRecordsResponse, error)
 }
 
 // UnimplementedVigilanteServiceServer can be embedded to have forward compatible implementations.
@@ -296,6 +2404,30 @@ type UnimplementedVigilanteServiceServer struct {
 func (*UnimplementedVigilanteServiceServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
+func (*UnimplementedVigilanteServiceServer) CheckpointHistory(context.Context, *CheckpointHistoryRequest) (*CheckpointHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointHistory not implemented")
+}
+func (*UnimplementedVigilanteServiceServer) ListCheckpointHistory(context.Context, *ListCheckpointHistoryRequest) (*ListCheckpointHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheckpointHistory not implemented")
+}
+func (*UnimplementedVigilanteServiceServer) SubmitterStatus(context.Context, *SubmitterStatusRequest) (*SubmitterStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitterStatus not implemented")
+}
+func (*UnimplementedVigilanteServiceServer) ReporterStatus(context.Context, *ReporterStatusRequest) (*ReporterStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReporterStatus not implemented")
+}
+func (*UnimplementedVigilanteServiceServer) MonitorStatus(context.Context, *MonitorStatusRequest) (*MonitorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorStatus not implemented")
+}
+func (*UnimplementedVigilanteServiceServer) ListMonitorEvidence(context.Context, *ListMonitorEvidenceRequest) (*ListMonitorEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMonitorEvidence not implemented")
+}
+func (*UnimplementedVigilanteServiceServer) BTCStakingTrackerStatus(context.Context, *BTCStakingTrackerStatusRequest) (*BTCStakingTrackerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCStakingTrackerStatus not implemented")
+}
+func (*UnimplementedVigilanteServiceServer) ListSlashingRecords(context.Context, *ListSlashingRecordsRequest) (*ListSlashingRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlashingRecords not implemented")
+}
 
 func RegisterVigilanteServiceServer(s *grpc.Server, srv VigilanteServiceServer) {
 	s.RegisterService(&_VigilanteService_serviceDesc, srv)
@@ -319,6 +2451,150 @@ func _VigilanteService_Version_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VigilanteService_CheckpointHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VigilanteServiceServer).CheckpointHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.VigilanteService/CheckpointHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VigilanteServiceServer).CheckpointHistory(ctx, req.(*CheckpointHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VigilanteService_ListCheckpointHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckpointHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VigilanteServiceServer).ListCheckpointHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.VigilanteService/ListCheckpointHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VigilanteServiceServer).ListCheckpointHistory(ctx, req.(*ListCheckpointHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VigilanteService_SubmitterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitterStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VigilanteServiceServer).SubmitterStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.VigilanteService/SubmitterStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VigilanteServiceServer).SubmitterStatus(ctx, req.(*SubmitterStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VigilanteService_ReporterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReporterStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VigilanteServiceServer).ReporterStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.VigilanteService/ReporterStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VigilanteServiceServer).ReporterStatus(ctx, req.(*ReporterStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VigilanteService_MonitorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VigilanteServiceServer).MonitorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.VigilanteService/MonitorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VigilanteServiceServer).MonitorStatus(ctx, req.(*MonitorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VigilanteService_ListMonitorEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMonitorEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VigilanteServiceServer).ListMonitorEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.VigilanteService/ListMonitorEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VigilanteServiceServer).ListMonitorEvidence(ctx, req.(*ListMonitorEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VigilanteService_BTCStakingTrackerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BTCStakingTrackerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VigilanteServiceServer).BTCStakingTrackerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.VigilanteService/BTCStakingTrackerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VigilanteServiceServer).BTCStakingTrackerStatus(ctx, req.(*BTCStakingTrackerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VigilanteService_ListSlashingRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlashingRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VigilanteServiceServer).ListSlashingRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.VigilanteService/ListSlashingRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VigilanteServiceServer).ListSlashingRecords(ctx, req.(*ListSlashingRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _VigilanteService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpc.VigilanteService",
 	HandlerType: (*VigilanteServiceServer)(nil),
@@ -327,6 +2603,38 @@ var _VigilanteService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _VigilanteService_Version_Handler,
 		},
+		{
+			MethodName: "CheckpointHistory",
+			Handler:    _VigilanteService_CheckpointHistory_Handler,
+		},
+		{
+			MethodName: "ListCheckpointHistory",
+			Handler:    _VigilanteService_ListCheckpointHistory_Handler,
+		},
+		{
+			MethodName: "SubmitterStatus",
+			Handler:    _VigilanteService_SubmitterStatus_Handler,
+		},
+		{
+			MethodName: "ReporterStatus",
+			Handler:    _VigilanteService_ReporterStatus_Handler,
+		},
+		{
+			MethodName: "MonitorStatus",
+			Handler:    _VigilanteService_MonitorStatus_Handler,
+		},
+		{
+			MethodName: "ListMonitorEvidence",
+			Handler:    _VigilanteService_ListMonitorEvidence_Handler,
+		},
+		{
+			MethodName: "BTCStakingTrackerStatus",
+			Handler:    _VigilanteService_BTCStakingTrackerStatus_Handler,
+		},
+		{
+			MethodName: "ListSlashingRecords",
+			Handler:    _VigilanteService_ListSlashingRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -0,0 +1,35 @@
+package rpcserver
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/babylonlabs-io/vigilante/config"
+	pb "github.com/babylonlabs-io/vigilante/rpcserver/api"
+)
+
+// NewClient dials the VigilanteService of a running vigilante, trusting the
+// TLS certificate the server wrote to cfg.RPCCertFile. If addr is empty, the
+// first configured endpoint is used.
+func NewClient(cfg *config.GRPCConfig, addr string) (pb.VigilanteServiceClient, *grpc.ClientConn, error) {
+	if addr == "" {
+		if len(cfg.Endpoints) == 0 {
+			return nil, nil, fmt.Errorf("no gRPC endpoint configured")
+		}
+		addr = cfg.Endpoints[0]
+	}
+
+	creds, err := credentials.NewClientTLSFromFile(cfg.RPCCertFile, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("load RPC cert: %w", err)
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial %s: %w", addr, err)
+	}
+
+	return pb.NewVigilanteServiceClient(conn), conn, nil
+}
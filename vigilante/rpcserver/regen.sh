@@ -1,3 +1,6 @@
 #!/bin/sh
 
-protoc -I. --go_out=plugins=grpc:api api.proto
+# google/api/annotations.proto is vendored by grpc-gateway v1
+GOOGLEAPIS=$(go list -m -f '{{.Dir}}' github.com/grpc-ecosystem/grpc-gateway)/third_party/googleapis
+
+protoc -I. -I"$GOOGLEAPIS" --go_out=plugins=grpc:api --grpc-gateway_out=logtostderr=true:api api.proto
@@ -17,8 +17,13 @@
 package rpcserver
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -26,9 +31,11 @@ import (
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/monitor"
 	"github.com/babylonlabs-io/vigilante/reporter"
+	pb "github.com/babylonlabs-io/vigilante/rpcserver/api"
 	"github.com/babylonlabs-io/vigilante/submitter"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
@@ -42,6 +49,9 @@ type Server struct {
 	Reporter          *reporter.Reporter
 	Monitor           *monitor.Monitor
 	BTCStakingTracker *bst.BTCStakingTracker
+
+	keyPair tls.Certificate
+	service pb.VigilanteServiceServer
 }
 
 func New(
@@ -72,11 +82,21 @@ func New(
 			grpc_prometheus.UnaryServerInterceptor,
 		)),
 	)
-	reflection.Register(server)      // register reflection service
-	StartVigilanteService(server)    // register our vigilante service
-	grpc_prometheus.Register(server) // register Prometheus metrics service
+	reflection.Register(server)                                                       // register reflection service
+	service := StartVigilanteService(server, submitter, reporter, monitor, bstracker) // register our vigilante service
+	grpc_prometheus.Register(server)                                                  // register Prometheus metrics service
 
-	return &Server{server, cfg, logger, submitter, reporter, monitor, bstracker}, nil
+	return &Server{
+		Server:            server,
+		Cfg:               cfg,
+		logger:            logger,
+		Submitter:         submitter,
+		Reporter:          reporter,
+		Monitor:           monitor,
+		BTCStakingTracker: bstracker,
+		keyPair:           keyPair,
+		service:           service,
+	}, nil
 }
 
 func (s *Server) Start() {
@@ -101,4 +121,34 @@ func (s *Server) Start() {
 		}(lis)
 		s.logger.Infof("Successfully started the GRPC server at %v", lis.Addr().String())
 	}
+
+	if len(s.Cfg.GatewayEndpoints) > 0 {
+		s.startGateway()
+	}
+}
+
+// startGateway serves the VigilanteService as a REST API over HTTPS on the gateway
+// endpoints, e.g. GET /v1/submitter/status
+func (s *Server) startGateway() {
+	mux := runtime.NewServeMux()
+	if err := pb.RegisterVigilanteServiceHandlerServer(context.Background(), mux, s.service); err != nil {
+		s.logger.Errorf("Register REST gateway: %v", err)
+
+		return
+	}
+
+	for _, endpoint := range s.Cfg.GatewayEndpoints {
+		srv := &http.Server{
+			Addr:              endpoint,
+			Handler:           mux,
+			TLSConfig:         &tls.Config{Certificates: []tls.Certificate{s.keyPair}, MinVersion: tls.VersionTLS12},
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := srv.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.logger.Errorf("Serve REST gateway: %v", err)
+			}
+		}()
+		s.logger.Infof("Successfully started the REST gateway at %v", endpoint)
+	}
 }
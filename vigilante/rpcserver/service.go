@@ -1,10 +1,20 @@
 package rpcserver
 
 import (
+	"encoding/hex"
+
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	bst "github.com/babylonlabs-io/vigilante/btcstaking-tracker"
+	"github.com/babylonlabs-io/vigilante/monitor"
+	"github.com/babylonlabs-io/vigilante/reporter"
 	pb "github.com/babylonlabs-io/vigilante/rpcserver/api"
+	"github.com/babylonlabs-io/vigilante/submitter"
+	"github.com/babylonlabs-io/vigilante/submitter/store"
+	"github.com/babylonlabs-io/vigilante/utils"
 )
 
 // Public API version constants
@@ -15,12 +25,32 @@ const (
 	verPatch  = 1
 )
 
-type service struct{}
+type service struct {
+	submitter *submitter.Submitter
+	reporter  *reporter.Reporter
+	monitor   *monitor.Monitor
+	bstracker *bst.BTCStakingTracker
+}
 
 // StartVigilanteService creates an implementation of the VigilanteService and
-// registers it with the gRPC server.
-func StartVigilanteService(gs *grpc.Server) {
-	pb.RegisterVigilanteServiceServer(gs, &service{})
+// registers it with the gRPC server. The components that are not running in this
+// process are nil, and their RPCs return codes.Unavailable.
+func StartVigilanteService(
+	gs *grpc.Server,
+	submitter *submitter.Submitter,
+	reporter *reporter.Reporter,
+	monitor *monitor.Monitor,
+	bstracker *bst.BTCStakingTracker,
+) pb.VigilanteServiceServer {
+	svc := &service{
+		submitter: submitter,
+		reporter:  reporter,
+		monitor:   monitor,
+		bstracker: bstracker,
+	}
+	pb.RegisterVigilanteServiceServer(gs, svc)
+
+	return svc
 }
 
 func (s *service) Version(_ context.Context, _ *pb.VersionRequest) (*pb.VersionResponse, error) {
@@ -31,3 +61,234 @@ func (s *service) Version(_ context.Context, _ *pb.VersionRequest) (*pb.VersionR
 		Patch:         verPatch,
 	}, nil
 }
+
+func (s *service) CheckpointHistory(_ context.Context, req *pb.CheckpointHistoryRequest) (*pb.CheckpointHistoryResponse, error) {
+	if s.submitter == nil {
+		return nil, status.Error(codes.Unavailable, "submitter is not running")
+	}
+
+	history, exists, err := s.submitter.Store().CheckpointHistory(req.Epoch)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !exists {
+		return nil, status.Errorf(codes.NotFound, "no checkpoint submitted for epoch %d", req.Epoch)
+	}
+
+	pbHistory, err := checkpointHistoryToPb(history)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CheckpointHistoryResponse{History: pbHistory}, nil
+}
+
+func (s *service) ListCheckpointHistory(_ context.Context, req *pb.ListCheckpointHistoryRequest) (*pb.ListCheckpointHistoryResponse, error) {
+	if s.submitter == nil {
+		return nil, status.Error(codes.Unavailable, "submitter is not running")
+	}
+
+	histories, err := s.submitter.Store().CheckpointHistories(req.StartEpoch, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ListCheckpointHistoryResponse{}
+	for _, history := range histories {
+		pbHistory, err := checkpointHistoryToPb(history)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Histories = append(resp.Histories, pbHistory)
+	}
+
+	return resp, nil
+}
+
+func (s *service) SubmitterStatus(_ context.Context, _ *pb.SubmitterStatusRequest) (*pb.SubmitterStatusResponse, error) {
+	if s.submitter == nil {
+		return nil, status.Error(codes.Unavailable, "submitter is not running")
+	}
+
+	st := s.submitter.Status()
+	if st == nil {
+		// no checkpoint has been submitted since the submitter started
+		return &pb.SubmitterStatusResponse{}, nil
+	}
+
+	resp := &pb.SubmitterStatusResponse{
+		LastSubmittedEpoch: st.Epoch,
+		Tx1Id:              st.Tx1ID.String(),
+		LastSubmittedTime:  st.SubmittedTime.Unix(),
+		NextResendTime:     st.NextResendTime.Unix(),
+	}
+	if st.Tx2ID != nil {
+		resp.Tx2Id = st.Tx2ID.String()
+	}
+	if st.ChildTxID != nil {
+		resp.ChildTxId = st.ChildTxID.String()
+	}
+
+	return resp, nil
+}
+
+func (s *service) ReporterStatus(_ context.Context, _ *pb.ReporterStatusRequest) (*pb.ReporterStatusResponse, error) {
+	if s.reporter == nil {
+		return nil, status.Error(codes.Unavailable, "reporter is not running")
+	}
+
+	st := s.reporter.Status()
+	resp := &pb.ReporterStatusResponse{
+		BtcCacheSize:       uint32(st.BTCCacheSize),
+		MatchedCheckpoints: uint32(st.MatchedCheckpoints),
+	}
+	if st.BTCCacheTip != nil {
+		resp.BtcCacheTipHeight = st.BTCCacheTip.Height
+		resp.BtcCacheTipHash = st.BTCCacheTip.BlockHash().String()
+	}
+	for _, seg := range st.PendingSegments {
+		pbSeg := &pb.CheckpointSegment{Index: uint32(seg.Index)}
+		if seg.AssocBlock != nil {
+			pbSeg.TxId = seg.AssocBlock.Txs[seg.TxIdx].Hash().String()
+			pbSeg.BlockHeight = seg.AssocBlock.Height
+			pbSeg.BlockHash = seg.AssocBlock.BlockHash().String()
+		}
+		resp.PendingSegments = append(resp.PendingSegments, pbSeg)
+	}
+
+	return resp, nil
+}
+
+func (s *service) MonitorStatus(_ context.Context, _ *pb.MonitorStatusRequest) (*pb.MonitorStatusResponse, error) {
+	if s.monitor == nil {
+		return nil, status.Error(codes.Unavailable, "monitor is not running")
+	}
+
+	st, err := s.monitor.Status()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.MonitorStatusResponse{
+		CheckedEpoch:     st.CheckedEpoch,
+		CheckedBtcHeight: st.CheckedBTCHeight,
+	}
+	for _, record := range st.LivenessChecklist {
+		resp.LivenessChecklist = append(resp.LivenessChecklist, &pb.LivenessCheckEntry{
+			Epoch:              record.EpochNum(),
+			CheckpointHash:     record.ID(),
+			FirstSeenBtcHeight: record.FirstSeenBtcHeight,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *service) ListMonitorEvidence(_ context.Context, req *pb.ListMonitorEvidenceRequest) (*pb.ListMonitorEvidenceResponse, error) {
+	if s.monitor == nil {
+		return nil, status.Error(codes.Unavailable, "monitor is not running")
+	}
+
+	evidence, err := s.monitor.Evidence(req.StartEpoch, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ListMonitorEvidenceResponse{}
+	for _, ev := range evidence {
+		pbEv := &pb.MonitorEvidence{
+			Type:                ev.Type.String(),
+			Epoch:               ev.Epoch,
+			CheckpointHash:      ev.CheckpointHash,
+			BtcHeightEpochEnded: ev.BTCHeightEpochEnded,
+			BtcHeightFirstSeen:  ev.BTCHeightFirstSeen,
+			BtcHeightReported:   ev.BTCHeightReported,
+			BtcTipHeight:        ev.BTCTipHeight,
+			Gap:                 ev.Gap,
+			Details:             ev.Details,
+			DetectedTime:        ev.DetectedTime.Unix(),
+		}
+		if ev.IsResolved() {
+			pbEv.ResolvedTime = ev.ResolvedTime.Unix()
+		}
+		resp.Evidence = append(resp.Evidence, pbEv)
+	}
+
+	return resp, nil
+}
+
+func (s *service) BTCStakingTrackerStatus(_ context.Context, _ *pb.BTCStakingTrackerStatusRequest) (*pb.BTCStakingTrackerStatusResponse, error) {
+	if s.bstracker == nil {
+		return nil, status.Error(codes.Unavailable, "BTC staking tracker is not running")
+	}
+
+	st := s.bstracker.Status()
+
+	return &pb.BTCStakingTrackerStatusResponse{
+		UnbondingTrackedDelegations:         uint32(st.UnbondingTracked),
+		PendingActivations:                  uint32(st.PendingActivations),
+		ActivationsInProgress:               uint32(st.ActivationsInProgress),
+		VerifiedNotInChainDelegations:       uint32(st.VerifiedNotInChain),
+		VerifiedInsufficientConfDelegations: uint32(st.VerifiedInsufficientConf),
+		AtomicSlasherTrackedDelegations:     uint32(st.AtomicSlasherTrackedDelegations),
+		SlashingInProgress:                  uint32(st.SlashingInProgress),
+	}, nil
+}
+
+func (s *service) ListSlashingRecords(_ context.Context, req *pb.ListSlashingRecordsRequest) (*pb.ListSlashingRecordsResponse, error) {
+	if s.bstracker == nil {
+		return nil, status.Error(codes.Unavailable, "BTC staking tracker is not running")
+	}
+
+	records, err := s.bstracker.SlashingRecords(req.FpBtcPk, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ListSlashingRecordsResponse{}
+	for _, record := range records {
+		pbRecord := &pb.SlashingRecord{
+			FpBtcPk:         record.FpBtcPk,
+			StakingTxHash:   record.StakingTxHash.String(),
+			Path:            record.Path.String(),
+			SlashingTxHash:  record.SlashingTxHash.String(),
+			Status:          record.Status.String(),
+			BroadcastHeight: record.BroadcastHeight,
+			ConfirmHeight:   record.ConfirmHeight,
+			Error:           record.Error,
+			Attempts:        record.Attempts,
+			UpdatedTime:     record.UpdatedTime.Unix(),
+		}
+		if record.SlashingTx != nil {
+			txBytes, err := utils.SerializeMsgTx(record.SlashingTx)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			pbRecord.SlashingTx = txBytes
+		}
+		resp.Records = append(resp.Records, pbRecord)
+	}
+
+	return resp, nil
+}
+
+func checkpointHistoryToPb(history *store.CheckpointHistory) (*pb.CheckpointHistory, error) {
+	pbHistory := &pb.CheckpointHistory{Epoch: history.Epoch}
+	for _, stx := range history.Txs {
+		txBytes, err := utils.SerializeMsgTx(stx.Tx)
+		if err != nil {
+			return nil, err
+		}
+		pbHistory.Txs = append(pbHistory.Txs, &pb.SubmittedTx{
+			TxIndex:         stx.TxIndex,
+			TxId:            stx.Tx.TxHash().String(),
+			Fee:             int64(stx.Fee),
+			FeeRate:         int64(stx.FeeRate),
+			BroadcastTime:   stx.BroadcastTime.Unix(),
+			InclusionHeight: stx.InclusionHeight,
+			BroadcastHeight: stx.BroadcastHeight,
+			TxHex:           hex.EncodeToString(txBytes),
+		})
+	}
+
+	return pbHistory, nil
+}
@@ -0,0 +1,337 @@
+package feepolicy
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/babylonlabs-io/vigilante/config"
+	"github.com/babylonlabs-io/vigilante/submitter/store"
+)
+
+const (
+	// blockVSize is the maximum virtual size of the txs of a BTC block
+	blockVSize = blockchain.MaxBlockWeight / blockchain.WitnessScaleFactor
+	// mempoolCacheTTL is how long the verbose getrawmempool result is reused, as it
+	// is expensive for the node with a large mempool and the fee rate is estimated
+	// for every tx
+	mempoolCacheTTL = 30 * time.Second
+)
+
+// Policy estimates the fee rate of the checkpoint txs by combining the bitcoind
+// estimatesmartfee estimation with the optional sources of FeePolicyConfig:
+//   - the fee rate of the mempool txs fitting into the target number of blocks
+//   - the inclusion delays of the past checkpoints kept in the submitter store
+//   - the blocks elapsed since the first broadcast of the current checkpoint,
+//     relative to the checkpoint finalization timeout of Babylon
+type Policy struct {
+	chainfee.Estimator
+	wallet              btcclient.BTCWallet
+	store               *store.SubmitterStore
+	cfg                 *config.FeePolicyConfig
+	finalizationTimeout uint32
+	logger              *zap.SugaredLogger
+
+	mempoolMu        sync.Mutex
+	mempoolEntries   []btcclient.MempoolEntry
+	mempoolFetchedAt time.Time
+}
+
+func New(
+	cfg *config.FeePolicyConfig,
+	est chainfee.Estimator,
+	wallet btcclient.BTCWallet,
+	subStore *store.SubmitterStore,
+	finalizationTimeout uint32,
+	parentLogger *zap.Logger,
+) *Policy {
+	return &Policy{
+		Estimator:           est,
+		wallet:              wallet,
+		store:               subStore,
+		cfg:                 cfg,
+		finalizationTimeout: finalizationTimeout,
+		logger:              parentLogger.With(zap.String("module", "feepolicy")).Sugar(),
+	}
+}
+
+// FeeRate returns the fee rate of the txs of the checkpoint of the given epoch,
+// ensuring it within [tx-fee-min, tx-fee-max]
+func (p *Policy) FeeRate(epoch uint64) chainfee.SatPerKVByte {
+	btcCfg := p.wallet.GetBTCConfig()
+	targetBlockNum := btcCfg.TargetBlockNum
+
+	// check we are within the uint32 range
+	if targetBlockNum < 0 || targetBlockNum > int64(^uint32(0)) {
+		panic(fmt.Errorf("targetBlockNum (%d) is out of uint32 range", targetBlockNum)) // software bug, panic
+	}
+	target := uint32(targetBlockNum)
+
+	// the deadline shortens the target of the estimations as well
+	estimationTarget, deadlineMultiplier := target, 1.0
+	if p.cfg.DeadlineMode {
+		estimationTarget, deadlineMultiplier = p.deadlineAdjustment(epoch, target)
+	}
+
+	feeRate := p.smartFeeRate(estimationTarget, btcCfg.DefaultFee)
+
+	if p.cfg.MempoolEstimation {
+		mempoolRate, err := p.mempoolFeeRate(estimationTarget)
+		if err != nil {
+			p.logger.Errorf("failed to estimate the fee rate from the mempool: %v", err)
+		} else if mempoolRate > feeRate {
+			p.logger.Debugf("the mempool fee rate %v is higher than the estimated fee rate %v", mempoolRate, feeRate)
+			feeRate = mempoolRate
+		}
+	}
+
+	feedbackMultiplier := 1.0
+	if p.cfg.FeedbackEpochs > 0 {
+		feedbackMultiplier = p.feedbackMultiplier(epoch, target)
+	}
+
+	if multiplier := feedbackMultiplier * deadlineMultiplier; multiplier > 1 {
+		p.logger.Debugf("raising the fee rate %v by the inclusion delay multiplier %.2f and the deadline multiplier %.2f",
+			feeRate, feedbackMultiplier, deadlineMultiplier)
+		feeRate = chainfee.SatPerKVByte(math.Ceil(float64(feeRate) * multiplier))
+	}
+
+	p.logger.Debugf("current tx fee rate is %v", feeRate)
+
+	if feeRate > btcCfg.TxFeeMax {
+		p.logger.Debugf("current tx fee rate is higher than the maximum tx fee rate %v, using the max", btcCfg.TxFeeMax)
+		feeRate = btcCfg.TxFeeMax
+	}
+	if feeRate < btcCfg.TxFeeMin {
+		p.logger.Debugf("current tx fee rate is lower than the minimum tx fee rate %v, using the min", btcCfg.TxFeeMin)
+		feeRate = btcCfg.TxFeeMin
+	}
+
+	return feeRate
+}
+
+// smartFeeRate returns the fee rate estimated by bitcoind, or the default fee rate
+// if the estimation fails
+func (p *Policy) smartFeeRate(target uint32, defaultFee chainfee.SatPerKVByte) chainfee.SatPerKVByte {
+	fee, err := p.EstimateFeePerKW(target)
+	if err != nil {
+		p.logger.Errorf("failed to estimate transaction fee. Using default fee %v: %s", defaultFee, err.Error())
+
+		return defaultFee
+	}
+
+	return fee.FeePerKVByte()
+}
+
+func (p *Policy) mempoolFeeRate(target uint32) (chainfee.SatPerKVByte, error) {
+	entries, err := p.getMempoolEntries()
+	if err != nil {
+		return 0, err
+	}
+
+	return MempoolFeeRate(entries, target), nil
+}
+
+// getMempoolEntries returns the mempool txs of the node, fetched at most once
+// every mempoolCacheTTL
+func (p *Policy) getMempoolEntries() ([]btcclient.MempoolEntry, error) {
+	p.mempoolMu.Lock()
+	defer p.mempoolMu.Unlock()
+
+	if p.mempoolEntries != nil && time.Since(p.mempoolFetchedAt) < mempoolCacheTTL {
+		return p.mempoolEntries, nil
+	}
+
+	entries, err := p.wallet.GetMempoolEntries()
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		entries = []btcclient.MempoolEntry{}
+	}
+	p.mempoolEntries = entries
+	p.mempoolFetchedAt = time.Now()
+
+	return entries, nil
+}
+
+// MempoolFeeRate returns the fee rate of the last tx fitting into the target number
+// of blocks if the mempool txs were mined in descending fee rate order, or zero if
+// the whole mempool fits into them
+func MempoolFeeRate(entries []btcclient.MempoolEntry, target uint32) chainfee.SatPerKVByte {
+	rates := make([]chainfee.SatPerKVByte, len(entries))
+	for i, entry := range entries {
+		if entry.VSize > 0 {
+			rates[i] = chainfee.SatPerKVByte(entry.Fee * 1000 / btcutil.Amount(entry.VSize))
+		}
+	}
+
+	indices := make([]int, len(entries))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return rates[indices[i]] > rates[indices[j]]
+	})
+
+	capacity := int64(target) * blockVSize
+	var size int64
+	for _, i := range indices {
+		size += entries[i].VSize
+		if size >= capacity {
+			return rates[i]
+		}
+	}
+
+	return 0
+}
+
+// feedbackMultiplier derives the fee rate multiplier from the inclusion delays of
+// the checkpoints of the FeedbackEpochs epochs before the given one
+func (p *Policy) feedbackMultiplier(epoch uint64, target uint32) float64 {
+	if epoch == 0 {
+		return 1
+	}
+
+	startEpoch := uint64(0)
+	if epoch > uint64(p.cfg.FeedbackEpochs) {
+		startEpoch = epoch - uint64(p.cfg.FeedbackEpochs)
+	}
+
+	histories, err := p.store.CheckpointHistories(startEpoch, p.cfg.FeedbackEpochs)
+	if err != nil {
+		p.logger.Errorf("failed to get the submission histories from epoch %v: %v", startEpoch, err)
+
+		return 1
+	}
+
+	var delays []uint32
+	for _, history := range histories {
+		if history.Epoch >= epoch {
+			break
+		}
+		if delay, ok := InclusionDelay(history); ok {
+			delays = append(delays, delay)
+		}
+	}
+
+	return FeedbackMultiplier(delays, target, p.cfg.MaxFeedbackMultiplier)
+}
+
+// InclusionDelay returns the number of blocks from the first broadcast of the txs of
+// the checkpoint to the inclusion of the last one. It returns false if any of the
+// heights is unknown.
+func InclusionDelay(history *store.CheckpointHistory) (uint32, bool) {
+	broadcastHeight := firstBroadcastHeight(history)
+	var inclusionHeight uint32
+	for _, stx := range history.Txs {
+		if stx.InclusionHeight > inclusionHeight {
+			inclusionHeight = stx.InclusionHeight
+		}
+	}
+
+	if broadcastHeight == 0 || inclusionHeight < broadcastHeight {
+		return 0, false
+	}
+
+	return inclusionHeight - broadcastHeight, true
+}
+
+// firstBroadcastHeight returns the lowest known broadcast height of the txs of the
+// checkpoint, or zero if none is known
+func firstBroadcastHeight(history *store.CheckpointHistory) uint32 {
+	var height uint32
+	for _, stx := range history.Txs {
+		if stx.BroadcastHeight != 0 && (height == 0 || stx.BroadcastHeight < height) {
+			height = stx.BroadcastHeight
+		}
+	}
+
+	return height
+}
+
+// FeedbackMultiplier returns the ratio of the average inclusion delay to the target
+// number of blocks, within [1, maxMultiplier]
+func FeedbackMultiplier(delays []uint32, target uint32, maxMultiplier float64) float64 {
+	if len(delays) == 0 || target == 0 {
+		return 1
+	}
+
+	var sum float64
+	for _, delay := range delays {
+		sum += float64(delay)
+	}
+	multiplier := sum / float64(len(delays)) / float64(target)
+
+	return math.Max(1, math.Min(multiplier, maxMultiplier))
+}
+
+// deadlineAdjustment adjusts the target and the fee rate based on the blocks elapsed
+// since the first broadcast of the checkpoint of the given epoch
+func (p *Policy) deadlineAdjustment(epoch uint64, target uint32) (uint32, float64) {
+	if p.finalizationTimeout == 0 {
+		return target, 1
+	}
+
+	history, exists, err := p.store.CheckpointHistory(epoch)
+	if err != nil {
+		p.logger.Errorf("failed to get the submission history of the checkpoint %v: %v", epoch, err)
+
+		return target, 1
+	} else if !exists {
+		// nothing has been broadcast for the checkpoint yet
+		return target, 1
+	}
+
+	broadcastHeight := firstBroadcastHeight(history)
+	if broadcastHeight == 0 {
+		return target, 1
+	}
+
+	tip, err := p.wallet.GetBestBlock()
+	if err != nil {
+		p.logger.Errorf("failed to get the best BTC block: %v", err)
+
+		return target, 1
+	}
+
+	var elapsed uint32
+	if tip > broadcastHeight {
+		elapsed = tip - broadcastHeight
+	}
+
+	return DeadlineAdjustment(elapsed, p.finalizationTimeout, target, p.cfg.DeadlineStartRatio, p.cfg.DeadlineMaxMultiplier)
+}
+
+// DeadlineAdjustment returns the target number of blocks and the fee rate multiplier
+// after elapsed blocks out of the finalization timeout. The target is capped by the
+// remaining blocks, and the multiplier grows linearly from 1 at startRatio of the
+// timeout to maxMultiplier at the timeout.
+func DeadlineAdjustment(elapsed, timeout, target uint32, startRatio, maxMultiplier float64) (uint32, float64) {
+	if timeout == 0 {
+		return target, 1
+	}
+
+	if elapsed >= timeout {
+		return 1, maxMultiplier
+	}
+
+	if remaining := timeout - elapsed; remaining < target {
+		target = remaining
+	}
+
+	progress := float64(elapsed) / float64(timeout)
+	if progress <= startRatio {
+		return target, 1
+	}
+
+	return target, 1 + (maxMultiplier-1)*(progress-startRatio)/(1-startRatio)
+}
@@ -0,0 +1,155 @@
+package feepolicy_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	bbndatagen "github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/golang/mock/gomock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/babylonlabs-io/vigilante/config"
+	"github.com/babylonlabs-io/vigilante/submitter/feepolicy"
+	"github.com/babylonlabs-io/vigilante/submitter/store"
+	"github.com/babylonlabs-io/vigilante/testutil"
+	"github.com/babylonlabs-io/vigilante/testutil/mocks"
+)
+
+func FuzzMempoolFeeRate(f *testing.F) {
+	bbndatagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+
+		// each fee rate level, in sat/vB, fills a whole block
+		numBlocks := r.Intn(10) + 1
+		var entries []btcclient.MempoolEntry
+		for i := 0; i < numBlocks; i++ {
+			feeRate := btcutil.Amount((numBlocks - i) * 10)
+			for j := 0; j < 10; j++ {
+				entries = append(entries, btcclient.MempoolEntry{VSize: 100000, Fee: feeRate * 100000})
+			}
+		}
+		r.Shuffle(len(entries), func(i, j int) {
+			entries[i], entries[j] = entries[j], entries[i]
+		})
+
+		target := uint32(r.Intn(numBlocks) + 1)
+		expected := chainfee.SatPerKVByte((numBlocks - int(target) + 1) * 10000)
+		require.Equal(t, expected, feepolicy.MempoolFeeRate(entries, target))
+
+		// no fee rate is needed when the whole mempool fits
+		require.Zero(t, feepolicy.MempoolFeeRate(entries, uint32(numBlocks+1)))
+	})
+}
+
+func TestFeedbackMultiplier(t *testing.T) {
+	t.Parallel()
+
+	history := &store.CheckpointHistory{
+		Epoch: 1,
+		Txs: []*store.SubmittedTx{
+			{TxIndex: 0, BroadcastHeight: 100, InclusionHeight: 103},
+			{TxIndex: 1, BroadcastHeight: 100},
+			{TxIndex: 1, BroadcastHeight: 102, InclusionHeight: 106},
+		},
+	}
+	delay, ok := feepolicy.InclusionDelay(history)
+	require.True(t, ok)
+	require.Equal(t, uint32(6), delay)
+
+	// the delay is unknown until the inclusion is observed
+	_, ok = feepolicy.InclusionDelay(&store.CheckpointHistory{
+		Txs: []*store.SubmittedTx{{TxIndex: 0, BroadcastHeight: 100}},
+	})
+	require.False(t, ok)
+
+	require.Equal(t, 1.0, feepolicy.FeedbackMultiplier(nil, 2, 3))
+	require.Equal(t, 1.0, feepolicy.FeedbackMultiplier([]uint32{1, 2}, 2, 3))
+	require.Equal(t, 1.5, feepolicy.FeedbackMultiplier([]uint32{2, 4}, 2, 3))
+	require.Equal(t, 3.0, feepolicy.FeedbackMultiplier([]uint32{20, 40}, 2, 3))
+}
+
+func TestDeadlineAdjustment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		elapsed            uint32
+		expectedTarget     uint32
+		expectedMultiplier float64
+	}{
+		{"just broadcast", 0, 6, 1},
+		{"before the start ratio", 40, 6, 1},
+		{"halfway from the start ratio", 75, 6, 2},
+		{"fewer blocks remaining than the target", 97, 3, 2.88},
+		{"timeout elapsed", 120, 1, 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			target, multiplier := feepolicy.DeadlineAdjustment(tc.elapsed, 100, 6, 0.5, 3)
+			require.Equal(t, tc.expectedTarget, target)
+			require.InDelta(t, tc.expectedMultiplier, multiplier, 1e-9)
+		})
+	}
+}
+
+func TestFeeRate(t *testing.T) {
+	t.Parallel()
+
+	btcConfig := config.DefaultBTCConfig()
+	btcConfig.TargetBlockNum = 2
+	btcConfig.TxFeeMin = chainfee.SatPerKVByte(1000)
+	btcConfig.TxFeeMax = chainfee.SatPerKVByte(100000)
+	wallet := mocks.NewMockBTCWallet(gomock.NewController(t))
+	wallet.EXPECT().GetBTCConfig().Return(&btcConfig).AnyTimes()
+	wallet.EXPECT().GetBestBlock().Return(uint32(175), nil).AnyTimes()
+	// two blocks of 20 sat/vB followed by 5 sat/vB txs, fetched once as the
+	// mempool is cached across the estimations
+	wallet.EXPECT().GetMempoolEntries().Return([]btcclient.MempoolEntry{
+		{VSize: 1000000, Fee: 20000000},
+		{VSize: 1000000, Fee: 20000000},
+		{VSize: 1000000, Fee: 5000000},
+	}, nil).Times(1)
+
+	subStore, err := store.NewSubmitterStore(testutil.MakeTestBackend(t))
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x00}))
+	// the checkpoint of epoch 1 took 4 blocks to be included, twice the target
+	require.NoError(t, subStore.AddSubmittedTx(1, store.NewSubmittedTx(0, tx, 1000, 100, time.Now(), 90)))
+	require.NoError(t, subStore.SetInclusionHeight(1, tx.TxHash(), 94))
+	// the checkpoint of epoch 2 was first broadcast 75 blocks ago
+	require.NoError(t, subStore.AddSubmittedTx(2, store.NewSubmittedTx(0, tx, 1000, 100, time.Now(), 100)))
+
+	// estimated fee rate of 10 sat/vB
+	est := chainfee.NewStaticEstimator(chainfee.SatPerKVByte(10000).FeePerKWeight(), chainfee.SatPerKVByte(1000).FeePerKWeight())
+
+	cfg := config.DefaultFeePolicyConfig()
+	policy := feepolicy.New(&cfg, est, wallet, subStore, 100, zap.NewNop())
+	require.Equal(t, chainfee.SatPerKVByte(10000), policy.FeeRate(2))
+
+	cfg.MempoolEstimation = true
+	require.Equal(t, chainfee.SatPerKVByte(20000), policy.FeeRate(2))
+
+	cfg.FeedbackEpochs = 5
+	require.Equal(t, chainfee.SatPerKVByte(40000), policy.FeeRate(2))
+
+	cfg.DeadlineMode = true
+	require.Equal(t, chainfee.SatPerKVByte(80000), policy.FeeRate(2))
+
+	// the fee rate is still capped
+	cfg.MaxFeedbackMultiplier = 3
+	cfg.DeadlineMaxMultiplier = 5
+	require.Equal(t, btcConfig.TxFeeMax, policy.FeeRate(2))
+}
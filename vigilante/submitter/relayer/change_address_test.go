@@ -16,6 +16,7 @@ import (
 	"github.com/babylonlabs-io/vigilante/config"
 	"github.com/babylonlabs-io/vigilante/metrics"
 	"github.com/babylonlabs-io/vigilante/submitter/relayer"
+	"github.com/babylonlabs-io/vigilante/submitter/signer"
 	"github.com/babylonlabs-io/vigilante/testutil/mocks"
 )
 
@@ -50,7 +51,7 @@ func TestGetChangeAddress(t *testing.T) {
 	logger, err := config.NewRootLogger("auto", "debug")
 	require.NoError(t, err)
 	testRelayer := relayer.New(wallet, btcConfig.WalletName, []byte("bbnt"), btctxformatter.CurrentVersion, submitterAddr,
-		submitterMetrics.RelayerMetrics, nil, &cfg, logger, testutil.MakeTestBackend(t))
+		submitterMetrics.RelayerMetrics, nil, signer.NewWalletSigner(wallet), &cfg, 0, logger, testutil.MakeTestBackend(t))
 
 	// 1. only SegWit Bech32 addresses
 	SegWitBech32p2wshAddrsStr = append(SegWitBech32p2wshAddrsStr, SegWitBech32p2wpkhAddrsStr...)
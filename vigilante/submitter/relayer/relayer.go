@@ -5,12 +5,16 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"github.com/babylonlabs-io/vigilante/submitter/feepolicy"
+	"github.com/babylonlabs-io/vigilante/submitter/signer"
 	"github.com/babylonlabs-io/vigilante/submitter/store"
 	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"math"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/babylonlabs-io/babylon/btctxformatter"
@@ -32,6 +36,9 @@ import (
 const (
 	changePosition                = 1
 	dustThreshold  btcutil.Amount = 546
+	// cpfpChildIndex is the index a CPFP child takes in the submission history,
+	// following the two txs of the checkpoint
+	cpfpChildIndex = 2
 )
 
 type GetLatestCheckpointFunc func() (*store.StoredCheckpoint, bool, error)
@@ -41,7 +48,11 @@ type SendTransactionFunc func(tx *wire.MsgTx) (*chainhash.Hash, error)
 type Relayer struct {
 	chainfee.Estimator
 	btcclient.BTCWallet
+	signer                  signer.Signer
+	feePolicy               *feepolicy.Policy
 	store                   *store.SubmitterStore
+	currentEpoch            uint64 // epoch of the checkpoint being submitted, used by the fee policy
+	status                  atomic.Pointer[Status]
 	lastSubmittedCheckpoint *types.CheckpointInfo
 	tag                     btctxformatter.BabylonTag
 	version                 btctxformatter.FormatVersion
@@ -60,7 +71,9 @@ func New(
 	submitterAddress sdk.AccAddress,
 	metrics *metrics.RelayerMetrics,
 	est chainfee.Estimator,
+	txSigner signer.Signer,
 	config *config.SubmitterConfig,
+	finalizationTimeout uint32,
 	parentLogger *zap.Logger,
 	db kvdb.Backend,
 ) *Relayer {
@@ -74,6 +87,8 @@ func New(
 	return &Relayer{
 		Estimator:               est,
 		BTCWallet:               wallet,
+		signer:                  txSigner,
+		feePolicy:               feepolicy.New(&config.FeePolicy, est, wallet, subStore, finalizationTimeout, parentLogger),
 		walletName:              walletName,
 		store:                   subStore,
 		tag:                     tag,
@@ -99,11 +114,12 @@ func (rl *Relayer) SendCheckpointToBTC(ckpt *ckpttypes.RawCheckpointWithMetaResp
 		// we do not consider this case as a failed submission but a software bug
 		return nil
 	}
+	rl.currentEpoch = ckptEpoch
 
-	storeCkptFunc := func(tx1, tx2 *wire.MsgTx, epochNum uint64) error {
-		storedCkpt := store.NewStoredCheckpoint(tx1, tx2, epochNum)
-
-		return rl.store.PutCheckpoint(storedCkpt)
+	// the previous checkpoint is done with once we move to a new epoch,
+	// record where its txs ended up before we lose track of them
+	if rl.lastSubmittedCheckpoint.Tx1 != nil && rl.lastSubmittedCheckpoint.Epoch < ckptEpoch {
+		rl.recordInclusionHeights(rl.lastSubmittedCheckpoint.Epoch)
 	}
 
 	if rl.shouldSendCompleteCkpt(ckptEpoch) || rl.shouldSendTx2(ckptEpoch) {
@@ -132,12 +148,11 @@ func (rl *Relayer) SendCheckpointToBTC(ckpt *ckpttypes.RawCheckpointWithMetaResp
 
 		rl.lastSubmittedCheckpoint = submittedCkpt
 
-		err = storeCkptFunc(submittedCkpt.Tx1.Tx, submittedCkpt.Tx2.Tx, submittedCkpt.Epoch)
-		if err != nil {
+		if err := rl.storeCheckpoint(submittedCkpt); err != nil {
 			return err
 		}
 
-		return nil
+		return rl.recordSubmittedTx(submittedCkpt.Epoch, 1, submittedCkpt.Tx2)
 	} else if rl.shouldSendTx2(ckptEpoch) {
 		rl.logger.Infof("Retrying to send tx2 for epoch %v, tx1 %s", ckptEpoch, rl.lastSubmittedCheckpoint.Tx1.TxID)
 		submittedCkpt, err := rl.retrySendTx2(ckpt.Ckpt)
@@ -147,12 +162,11 @@ func (rl *Relayer) SendCheckpointToBTC(ckpt *ckpttypes.RawCheckpointWithMetaResp
 
 		rl.lastSubmittedCheckpoint = submittedCkpt
 
-		err = storeCkptFunc(submittedCkpt.Tx1.Tx, submittedCkpt.Tx2.Tx, submittedCkpt.Epoch)
-		if err != nil {
+		if err := rl.storeCheckpoint(submittedCkpt); err != nil {
 			return err
 		}
 
-		return nil
+		return rl.recordSubmittedTx(submittedCkpt.Epoch, 1, submittedCkpt.Tx2)
 	}
 
 	return nil
@@ -177,6 +191,9 @@ func (rl *Relayer) MaybeResubmitSecondCheckpointTx(ckpt *ckpttypes.RawCheckpoint
 
 		return nil
 	}
+	rl.currentEpoch = ckptEpoch
+
+	rl.recordInclusionHeights(lastSubmittedEpoch)
 
 	durSeconds := uint(time.Since(rl.lastSubmittedCheckpoint.TS).Seconds())
 	if durSeconds < rl.config.ResendIntervalSeconds {
@@ -186,6 +203,10 @@ func (rl *Relayer) MaybeResubmitSecondCheckpointTx(ckpt *ckpttypes.RawCheckpoint
 	rl.logger.Debugf("The checkpoint for epoch %v was sent more than %v seconds ago but not included on BTC",
 		ckptEpoch, rl.config.ResendIntervalSeconds)
 
+	if rl.shouldBumpWithChild(rl.lastSubmittedCheckpoint) {
+		return rl.bumpWithChild(ckptEpoch)
+	}
+
 	bumpedFee := rl.calculateBumpedFee(rl.lastSubmittedCheckpoint)
 
 	// make sure the bumped fee is effective
@@ -223,13 +244,311 @@ func (rl *Relayer) MaybeResubmitSecondCheckpointTx(ckpt *ckpttypes.RawCheckpoint
 	rl.lastSubmittedCheckpoint.Tx2 = resubmittedTx2
 	rl.lastSubmittedCheckpoint.TS = time.Now()
 
-	storedCkpt := store.NewStoredCheckpoint(
-		rl.lastSubmittedCheckpoint.Tx1.Tx,
-		rl.lastSubmittedCheckpoint.Tx2.Tx,
-		rl.lastSubmittedCheckpoint.Epoch,
-	)
+	if err := rl.storeCheckpoint(rl.lastSubmittedCheckpoint); err != nil {
+		return err
+	}
 
-	return rl.store.PutCheckpoint(storedCkpt)
+	return rl.recordSubmittedTx(rl.lastSubmittedCheckpoint.Epoch, 1, resubmittedTx2)
+}
+
+// shouldBumpWithChild decides, based on the configured strategy, whether the
+// checkpoint is bumped with a CPFP child instead of replacing its second tx
+func (rl *Relayer) shouldBumpWithChild(ckptInfo *types.CheckpointInfo) bool {
+	switch rl.config.FeeBumpStrategy {
+	case config.FeeBumpCPFP:
+		return true
+	case config.FeeBumpAuto:
+		// replacing tx2 would evict the child, so keep bumping with children
+		if ckptInfo.Child != nil {
+			return true
+		}
+
+		// replacing tx2 is cheaper, but only effective if tx1 is not stuck as well
+		tx1InChain, err := rl.isTxInChain(ckptInfo.Tx1)
+		if err != nil {
+			rl.logger.Errorf("failed to get the status of tx1 %s, bumping with RBF: %v", ckptInfo.Tx1.TxID, err)
+
+			return false
+		}
+
+		return !tx1InChain
+	default:
+		return false
+	}
+}
+
+// bumpWithChild bumps the checkpoint by spending the change of its second tx with a
+// child paying for the whole package of unconfirmed checkpoint txs.
+// A child from a previous bump is replaced by the new one.
+func (rl *Relayer) bumpWithChild(ckptEpoch uint64) error {
+	ckptInfo := rl.lastSubmittedCheckpoint
+
+	tx2InChain, err := rl.isTxInChain(ckptInfo.Tx2)
+	if err != nil {
+		return err
+	}
+
+	// No need to bump, the checkpoint is already confirmed
+	if tx2InChain {
+		rl.logger.Debugf("Transaction %v is already confirmed", ckptInfo.Tx2.TxID)
+
+		return nil
+	}
+
+	// tx2 always needs the child, tx1 only if it is still unconfirmed
+	parents := []*types.BtcTxInfo{ckptInfo.Tx2}
+	tx1InChain, err := rl.isTxInChain(ckptInfo.Tx1)
+	if err != nil {
+		return err
+	}
+	if !tx1InChain {
+		parents = append(parents, ckptInfo.Tx1)
+	}
+
+	child, err := rl.buildChildTx(ckptInfo.Tx2, parents, ckptInfo.Child)
+	if err != nil {
+		rl.metrics.FailedResentCheckpointsCounter.Inc()
+
+		return fmt.Errorf("failed to build the CPFP child of the checkpoint %v: %w", ckptEpoch, err)
+	}
+
+	child.TxID, err = rl.sendTxToBTC(child.Tx)
+	if err != nil {
+		rl.metrics.FailedResentCheckpointsCounter.Inc()
+
+		return fmt.Errorf("failed to send the CPFP child of the checkpoint %v: %w", ckptEpoch, err)
+	}
+
+	rl.metrics.CPFPCheckpointsCounter.Inc()
+
+	rl.logger.Infof("Successfully bumped the checkpoint %v with a CPFP child, txid: %s, fee: %v Satoshis, parents: %d",
+		ckptEpoch, child.TxID.String(), child.Fee, len(parents))
+
+	ckptInfo.Child = child
+	ckptInfo.TS = time.Now()
+
+	// the child is resent along with its parents after a restart
+	if err := rl.storeCheckpoint(ckptInfo); err != nil {
+		return err
+	}
+
+	return rl.recordSubmittedTx(ckptEpoch, cpfpChildIndex, child)
+}
+
+// buildChildTx builds a tx sending the change of parent back to the wallet.
+// Its fee brings the fee rate of the package formed by the unconfirmed parents and
+// the child to the current estimate.
+func (rl *Relayer) buildChildTx(
+	parent *types.BtcTxInfo,
+	parents []*types.BtcTxInfo,
+	prevChild *types.BtcTxInfo,
+) (*types.BtcTxInfo, error) {
+	parentTxID := parent.Tx.TxHash()
+	balance := btcutil.Amount(parent.Tx.TxOut[changePosition].Value)
+
+	changeAddr, err := rl.changeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("err getting raw change address %w", err)
+	}
+
+	changePkScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create script for change address: %s err %w", changeAddr, err)
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	txIn := wire.NewTxIn(wire.NewOutPoint(&parentTxID, changePosition), nil, nil)
+	// Enable replace-by-fee so that a later bump can replace the child
+	txIn.Sequence = math.MaxUint32 - 2
+	tx.AddTxIn(txIn)
+	tx.AddTxOut(wire.NewTxOut(int64(balance), changePkScript))
+
+	// sign once to learn the size of the child
+	signedTx, err := rl.signTx(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tx: %w", err)
+	}
+
+	childSize, err := calculateTxVirtualSize(signedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	childFee := rl.calculateChildFee(parents, childSize, prevChild)
+	if childFee > balance || balance-childFee < dustThreshold {
+		return nil, fmt.Errorf("the change of the parent tx %v is not sufficient for the child fee %v", balance, childFee)
+	}
+
+	tx.TxOut[0].Value = int64(balance - childFee)
+
+	signedTx, err = rl.signTx(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tx: %w", err)
+	}
+
+	rl.logger.Debugf("Successfully composed a CPFP child. Tx fee: %v, output value: %v, tx size: %v",
+		childFee, balance-childFee, childSize)
+
+	return &types.BtcTxInfo{
+		Tx:   signedTx,
+		Size: childSize,
+		Fee:  childFee,
+	}, nil
+}
+
+// calculateChildFee calculates the fee of a CPFP child of the given size such that the
+// package of the parents and the child reaches the estimated fee rate. If the child
+// replaces a previous one, it pays at least ResubmitFeeMultiplier times the previous fee,
+// plus the minimum fee for relaying the replacement.
+func (rl *Relayer) calculateChildFee(parents []*types.BtcTxInfo, childSize int64, prevChild *types.BtcTxInfo) btcutil.Amount {
+	packageSize := childSize
+	var parentsFee btcutil.Amount
+	for _, p := range parents {
+		packageSize += p.Size
+		parentsFee += p.Fee
+	}
+
+	// #nosec G115 - Ignored G115 because the application ensures tx sizes are always non-negative.
+	packageFee := rl.getFeeRate().FeeForVSize(lntypes.VByte(packageSize))
+
+	minRelayFee := rl.calcMinRelayFee(childSize)
+	childFee := packageFee - parentsFee
+	if childFee < minRelayFee {
+		childFee = minRelayFee
+	}
+
+	if prevChild != nil {
+		if bumped := prevChild.Fee.MulF64(rl.config.ResubmitFeeMultiplier); childFee < bumped {
+			childFee = bumped
+		}
+		if required := prevChild.Fee + minRelayFee; childFee < required {
+			childFee = required
+		}
+	}
+
+	return childFee
+}
+
+// isTxInChain checks whether the tx has been included in a BTC block
+func (rl *Relayer) isTxInChain(txInfo *types.BtcTxInfo) (bool, error) {
+	_, status, err := rl.TxDetails(txInfo.TxID, txInfo.Tx.TxOut[changePosition].PkScript)
+	if err != nil {
+		return false, err
+	}
+
+	return status == btcclient.TxInChain, nil
+}
+
+// Store returns the store keeping the submitted checkpoints and their history
+func (rl *Relayer) Store() *store.SubmitterStore {
+	return rl.store
+}
+
+// recordSubmittedTx appends a broadcast tx of the last submitted checkpoint to the
+// submission history and updates the status
+func (rl *Relayer) recordSubmittedTx(epoch uint64, txIndex uint32, txInfo *types.BtcTxInfo) error {
+	if err := rl.addSubmittedTx(epoch, txIndex, txInfo); err != nil {
+		return err
+	}
+
+	// every broadcast tx has been applied to the last submitted checkpoint at this point
+	rl.status.Store(rl.newStatus())
+
+	return nil
+}
+
+// addSubmittedTx appends a broadcast tx of the checkpoint to the submission history
+func (rl *Relayer) addSubmittedTx(epoch uint64, txIndex uint32, txInfo *types.BtcTxInfo) error {
+	// the broadcast height only feeds the fee policy, so it is left unknown on failures
+	broadcastHeight, err := rl.GetBestBlock()
+	if err != nil {
+		rl.logger.Errorf("failed to get the best BTC block when recording tx %s: %v", txInfo.TxID, err)
+	}
+
+	stx := store.NewSubmittedTx(txIndex, txInfo.Tx, txInfo.Fee, txInfo.Size, time.Now(), broadcastHeight)
+	if err := rl.store.AddSubmittedTx(epoch, stx); err != nil {
+		return fmt.Errorf("failed to record tx %s of the checkpoint %v: %w", txInfo.TxID, epoch, err)
+	}
+
+	return nil
+}
+
+// Status is a snapshot of the last checkpoint submitted by the relayer
+type Status struct {
+	Epoch          uint64
+	Tx1ID          *chainhash.Hash
+	Tx2ID          *chainhash.Hash // nil until the second tx is sent
+	ChildTxID      *chainhash.Hash // nil if the checkpoint has not been bumped with a CPFP child
+	SubmittedTime  time.Time
+	NextResendTime time.Time // the checkpoint is bumped if it is not included on BTC by then
+}
+
+// Status returns the last checkpoint submitted by the relayer, or nil if no checkpoint
+// has been submitted since it started. Thread-safe.
+func (rl *Relayer) Status() *Status {
+	return rl.status.Load()
+}
+
+func (rl *Relayer) newStatus() *Status {
+	ckptInfo := rl.lastSubmittedCheckpoint
+	status := &Status{
+		Epoch:          ckptInfo.Epoch,
+		Tx1ID:          ckptInfo.Tx1.TxID,
+		SubmittedTime:  ckptInfo.TS,
+		NextResendTime: ckptInfo.TS.Add(time.Duration(rl.config.ResendIntervalSeconds) * time.Second),
+	}
+	if ckptInfo.Tx2 != nil {
+		status.Tx2ID = ckptInfo.Tx2.TxID
+	}
+	if ckptInfo.Child != nil {
+		status.ChildTxID = ckptInfo.Child.TxID
+	}
+
+	return status
+}
+
+// recordInclusionHeights looks up the latest broadcast version of each tx of the
+// checkpoint on BTC and records the height of the block it was included in.
+// Failures are only logged as the history is for auditing purposes.
+func (rl *Relayer) recordInclusionHeights(epoch uint64) {
+	history, exists, err := rl.store.CheckpointHistory(epoch)
+	if err != nil {
+		rl.logger.Errorf("failed to get the submission history of the checkpoint %v: %v", epoch, err)
+
+		return
+	} else if !exists {
+		return
+	}
+
+	// earlier versions of a tx are replaced by the later ones and never included
+	latest := make(map[uint32]*store.SubmittedTx)
+	for _, stx := range history.Txs {
+		latest[stx.TxIndex] = stx
+	}
+
+	for _, stx := range latest {
+		if stx.InclusionHeight != 0 || len(stx.Tx.TxOut) == 0 {
+			continue
+		}
+
+		// the change is the last output of both the checkpoint txs and the CPFP children
+		txID := stx.Tx.TxHash()
+		pkScript := stx.Tx.TxOut[len(stx.Tx.TxOut)-1].PkScript
+		conf, status, err := rl.TxDetails(&txID, pkScript)
+		if err != nil {
+			rl.logger.Debugf("failed to get the details of tx %s of the checkpoint %v: %v", txID, epoch, err)
+
+			continue
+		}
+
+		if status != btcclient.TxInChain || conf == nil {
+			continue
+		}
+
+		if err := rl.store.SetInclusionHeight(epoch, txID, conf.BlockHeight); err != nil {
+			rl.logger.Errorf("failed to record the inclusion height of tx %s of the checkpoint %v: %v", txID, epoch, err)
+		}
+	}
 }
 
 func (rl *Relayer) shouldSendCompleteCkpt(ckptEpoch uint64) bool {
@@ -242,6 +561,16 @@ func (rl *Relayer) shouldSendTx2(ckptEpoch uint64) bool {
 		rl.lastSubmittedCheckpoint.Tx2 == nil
 }
 
+// storeCheckpoint persists the txs of the checkpoint so that they can be resent after a restart
+func (rl *Relayer) storeCheckpoint(ckptInfo *types.CheckpointInfo) error {
+	storedCkpt := store.NewStoredCheckpoint(ckptInfo.Tx1.Tx, ckptInfo.Tx2.Tx, ckptInfo.Epoch)
+	if ckptInfo.Child != nil {
+		storedCkpt.Child = ckptInfo.Child.Tx
+	}
+
+	return rl.store.PutCheckpoint(storedCkpt)
+}
+
 // shouldResendCheckpoint checks whether the bumpedFee is effective for replacement
 func (rl *Relayer) shouldResendCheckpoint(ckptInfo *types.CheckpointInfo, bumpedFee btcutil.Amount) bool {
 	// if the bumped fee is less than the fee of the previous second tx plus the minimum required bumping fee
@@ -255,10 +584,15 @@ func (rl *Relayer) shouldResendCheckpoint(ckptInfo *types.CheckpointInfo, bumped
 }
 
 // calculateBumpedFee calculates the bumped fees of the second tx of the checkpoint
-// based on the current BTC load, considering both tx sizes
-// the result is multiplied by ResubmitFeeMultiplier set in config
+// the previous fee is multiplied by ResubmitFeeMultiplier set in config, and raised
+// to the current fee rate of the fee policy if that is higher
 func (rl *Relayer) calculateBumpedFee(ckptInfo *types.CheckpointInfo) btcutil.Amount {
-	return ckptInfo.Tx2.Fee.MulF64(rl.config.ResubmitFeeMultiplier)
+	bumpedFee := ckptInfo.Tx2.Fee.MulF64(rl.config.ResubmitFeeMultiplier)
+	if policyFee := rl.getFeeRate().FeeForVSize(lntypes.VByte(ckptInfo.Tx2.Size)); policyFee > bumpedFee {
+		bumpedFee = policyFee
+	}
+
+	return bumpedFee
 }
 
 // maybeResendSecondTxOfCheckpointToBTC resends the second tx of the checkpoint with bumpedFee
@@ -275,10 +609,11 @@ func (rl *Relayer) maybeResendSecondTxOfCheckpointToBTC(tx2 *types.BtcTxInfo, bu
 		return nil, nil
 	}
 
-	// set output value of the second tx to be the balance minus the bumped fee
+	// set output value of the second tx to be the balance minus the bumped fee,
+	// where the balance is the output value plus the fee paid by the previous tx
 	// if the bumped fee is higher than the balance, then set the bumped fee to
 	// be equal to the balance to ensure the output value is not negative
-	balance := btcutil.Amount(tx2.Tx.TxOut[changePosition].Value)
+	balance := btcutil.Amount(tx2.Tx.TxOut[changePosition].Value) + tx2.Fee
 
 	// todo: revise this as this means we will end up with output with value 0 that will be rejected by bitcoind as dust output.
 	if bumpedFee > balance {
@@ -301,6 +636,7 @@ func (rl *Relayer) maybeResendSecondTxOfCheckpointToBTC(tx2 *types.BtcTxInfo, bu
 	}
 
 	// update tx info
+	tx2.Tx = tx
 	tx2.Fee = bumpedFee
 	tx2.TxID = txID
 
@@ -331,22 +667,57 @@ func (rl *Relayer) calcMinRelayFee(txVirtualSize int64) btcutil.Amount {
 	return minRelayFee
 }
 
+// signTx has the tx signed by the configured signer as a PSBT
 func (rl *Relayer) signTx(tx *wire.MsgTx) (*wire.MsgTx, error) {
-	// unlock the wallet
-	if err := rl.WalletPassphrase(rl.GetWalletPass(), rl.GetWalletLockTime()); err != nil {
-		return nil, err
+	packet, err := rl.newPsbt(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PSBT: %w", err)
 	}
 
-	signedTx, allSigned, err := rl.BTCWallet.SignRawTransactionWithWallet(tx)
+	return rl.signer.SignPsbt(packet)
+}
+
+// newPsbt creates a PSBT of the tx without its previous signatures, attaching the
+// outputs spent by its inputs if the signer needs them to sign
+func (rl *Relayer) newPsbt(tx *wire.MsgTx) (*psbt.Packet, error) {
+	unsignedTx := tx.Copy()
+	for _, txIn := range unsignedTx.TxIn {
+		txIn.SignatureScript = nil
+		txIn.Witness = nil
+	}
+
+	packet, err := psbt.NewFromUnsignedTx(unsignedTx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !allSigned {
-		return nil, errors.New("transaction is only partially signed")
+	// the wallet signer looks up the spent outputs by itself, which also works
+	// on nodes without -txindex
+	if !rl.signer.NeedsPrevOutputs() {
+		return packet, nil
 	}
 
-	return signedTx, nil
+	for i, txIn := range unsignedTx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		prevTx, err := rl.GetRawTransaction(&prevOut.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tx %s spent by input %d: %w", prevOut.Hash, i, err)
+		}
+
+		prevMsgTx := prevTx.MsgTx()
+		if int(prevOut.Index) >= len(prevMsgTx.TxOut) {
+			return nil, fmt.Errorf("input %d spends non-existing output %v", i, prevOut)
+		}
+
+		utxo := prevMsgTx.TxOut[prevOut.Index]
+		packet.Inputs[i].WitnessUtxo = utxo
+		// signers of legacy inputs need the whole tx to verify the amount
+		if !txscript.IsWitnessProgram(utxo.PkScript) {
+			packet.Inputs[i].NonWitnessUtxo = prevMsgTx
+		}
+	}
+
+	return packet, nil
 }
 
 func (rl *Relayer) encodeCheckpointData(ckpt *ckpttypes.RawCheckpointResponse) ([]byte, []byte, error) {
@@ -404,7 +775,7 @@ func (rl *Relayer) convertCkptToTwoTxAndSubmit(ckpt *ckpttypes.RawCheckpointResp
 		return nil, err
 	}
 
-	tx1, tx2, err := rl.ChainTwoTxAndSend(data1, data2)
+	tx1, tx2, err := rl.ChainTwoTxAndSend(ckpt.EpochNum, data1, data2)
 	if err != nil {
 		return nil, err
 	}
@@ -464,9 +835,11 @@ func (rl *Relayer) buildAndSendTx(builderFunc func() (*types.BtcTxInfo, error))
 	return tx, nil
 }
 
-// ChainTwoTxAndSend builds two chaining txs with the given data:
-// the second tx consumes the output of the first tx
-func (rl *Relayer) ChainTwoTxAndSend(data1 []byte, data2 []byte) (*types.BtcTxInfo, *types.BtcTxInfo, error) {
+// ChainTwoTxAndSend builds two chaining txs with the given data of the checkpoint
+// of the given epoch: the second tx consumes the output of the first tx. The first
+// tx is recorded in the submission history as soon as it is broadcast, so it is
+// recorded even if the second tx fails.
+func (rl *Relayer) ChainTwoTxAndSend(epoch uint64, data1 []byte, data2 []byte) (*types.BtcTxInfo, *types.BtcTxInfo, error) {
 	// recipient is a change address that all the
 	// remaining balance of the utxo is sent to
 
@@ -480,6 +853,10 @@ func (rl *Relayer) ChainTwoTxAndSend(data1 []byte, data2 []byte) (*types.BtcTxIn
 	// cache the success of tx1, we need it if we fail with tx2 send
 	rl.lastSubmittedCheckpoint.Tx1 = tx1
 
+	if err := rl.addSubmittedTx(epoch, 0, tx1); err != nil {
+		return nil, nil, err
+	}
+
 	// Build and send tx2, using tx1 as the parent
 	tx2, err := rl.buildAndSendTx(func() (*types.BtcTxInfo, error) {
 		return rl.buildChainedDataTx(data2, tx1.Tx)
@@ -507,12 +884,7 @@ func (rl *Relayer) buildDataTx(data []byte) (*types.BtcTxInfo, error) {
 	tx.AddTxOut(wire.NewTxOut(0, dataScript))
 
 	// Fund the transaction
-	changePosition := 1
-	feeRate := btcutil.Amount(rl.getFeeRate()).ToBTC()
-	rawTxResult, err := rl.BTCWallet.FundRawTransaction(tx, btcjson.FundRawTransactionOpts{
-		FeeRate:        &feeRate,
-		ChangePosition: &changePosition,
-	}, nil)
+	rawTxResult, err := rl.BTCWallet.FundRawTransaction(tx, rl.fundRawTxOpts(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fund raw tx in buildDataTx: %w", err)
 	}
@@ -521,7 +893,7 @@ func (rl *Relayer) buildDataTx(data []byte) (*types.BtcTxInfo, error) {
 	hasChange := len(rawTxResult.Transaction.TxOut) > changePosition
 	if !hasChange {
 		rl.logger.Debugf("no change, adding change address manually, tx ref: %s", tx.TxHash())
-		changeAddr, err := rl.BTCWallet.GetNewAddress("")
+		changeAddr, err := rl.changeAddress()
 		if err != nil {
 			return nil, fmt.Errorf("err getting raw change address %w", err)
 		}
@@ -533,11 +905,13 @@ func (rl *Relayer) buildDataTx(data []byte) (*types.BtcTxInfo, error) {
 
 		changeOutput := wire.NewTxOut(int64(dustThreshold), changePkScript)
 		rawTxResult.Transaction.AddTxOut(changeOutput)
+		// the change output is paid from the fee
+		rawTxResult.Fee -= dustThreshold
 	}
 
 	rl.logger.Debugf("Building a BTC tx using %s with data %x", tx.TxHash(), data)
 
-	return rl.finalizeTransaction(rawTxResult.Transaction)
+	return rl.finalizeTransaction(rawTxResult.Transaction, rawTxResult.Fee)
 }
 
 // buildChainedDataTx constructs a Bitcoin transaction that spends from a previous transaction.
@@ -566,24 +940,49 @@ func (rl *Relayer) buildChainedDataTx(data []byte, prevTx *wire.MsgTx) (*types.B
 	tx.AddTxOut(wire.NewTxOut(0, dataScript))
 
 	// Fund the transaction
-	changePosition := 1
-	feeRate := btcutil.Amount(rl.getFeeRate()).ToBTC()
-	rawTxResult, err := rl.BTCWallet.FundRawTransaction(tx, btcjson.FundRawTransactionOpts{
-		FeeRate:        &feeRate,
-		ChangePosition: &changePosition,
-	}, nil)
+	rawTxResult, err := rl.BTCWallet.FundRawTransaction(tx, rl.fundRawTxOpts(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fund raw tx in buildChainedDataTx: %w", err)
 	}
 
 	rl.logger.Debugf("Building a BTC tx using %s with data %x", tx.TxHash(), data)
 
-	return rl.finalizeTransaction(rawTxResult.Transaction)
+	return rl.finalizeTransaction(rawTxResult.Transaction, rawTxResult.Fee)
+}
+
+// fundRawTxOpts returns the options of funding the checkpoint txs at the fee rate of
+// the fee policy, with the change at changePosition
+func (rl *Relayer) fundRawTxOpts() btcjson.FundRawTransactionOpts {
+	changePos := changePosition
+	feeRate := btcutil.Amount(rl.getFeeRate()).ToBTC()
+	opts := btcjson.FundRawTransactionOpts{
+		FeeRate:        &feeRate,
+		ChangePosition: &changePos,
+	}
+
+	// the change is spent by the chained tx, so it has to be signable by the signer
+	if changeAddr := rl.signer.ChangeAddress(); changeAddr != nil {
+		encodedAddr := changeAddr.EncodeAddress()
+		opts.ChangeAddress = &encodedAddr
+	}
+
+	return opts
+}
+
+// changeAddress returns the address of the signer the change is sent to, or a new
+// address of the wallet if the signer has none
+func (rl *Relayer) changeAddress() (btcutil.Address, error) {
+	if changeAddr := rl.signer.ChangeAddress(); changeAddr != nil {
+		return changeAddr, nil
+	}
+
+	return rl.BTCWallet.GetNewAddress("")
 }
 
 // finalizeTransaction handles the common logic for validating and finalizing a transaction,
-// including fee calculation, change verification, and signing.
-func (rl *Relayer) finalizeTransaction(tx *wire.MsgTx) (*types.BtcTxInfo, error) {
+// including fee verification, change verification, and signing.
+// txFee is the fee paid by the tx, i.e., the value of its inputs minus that of its outputs
+func (rl *Relayer) finalizeTransaction(tx *wire.MsgTx, txFee btcutil.Amount) (*types.BtcTxInfo, error) {
 	hasChange := len(tx.TxOut) > changePosition
 	var changeAmount btcutil.Amount
 
@@ -607,9 +1006,9 @@ func (rl *Relayer) finalizeTransaction(tx *wire.MsgTx) (*types.BtcTxInfo, error)
 		return nil, err
 	}
 
-	txFee := rl.calcMinRelayFee(txSize)
-	if hasChange && changeAmount < txFee {
-		return nil, fmt.Errorf("the value of the utxo is not sufficient for relaying the tx. Require: %v. Have: %v", txFee, changeAmount)
+	minRelayFee := rl.calcMinRelayFee(txSize)
+	if hasChange && changeAmount < minRelayFee {
+		return nil, fmt.Errorf("the value of the utxo is not sufficient for relaying the tx. Require: %v. Have: %v", minRelayFee, changeAmount)
 	}
 
 	// Sign tx
@@ -624,7 +1023,7 @@ func (rl *Relayer) finalizeTransaction(tx *wire.MsgTx) (*types.BtcTxInfo, error)
 		return nil, fmt.Errorf("failed to serialize signedTx: %w", err)
 	}
 
-	change := changeAmount - txFee
+	change := changeAmount - minRelayFee
 	if hasChange && change < dustThreshold {
 		return nil, fmt.Errorf("change amount is %v less than dust threshold %v", change, dustThreshold)
 	}
@@ -639,37 +1038,10 @@ func (rl *Relayer) finalizeTransaction(tx *wire.MsgTx) (*types.BtcTxInfo, error)
 	}, nil
 }
 
-// getFeeRate returns the estimated fee rate, ensuring it within [tx-fee-max, tx-fee-min]
+// getFeeRate returns the fee rate of the fee policy for the checkpoint being submitted,
+// ensuring it within [tx-fee-max, tx-fee-min]
 func (rl *Relayer) getFeeRate() chainfee.SatPerKVByte {
-	targetBlockNum := rl.GetBTCConfig().TargetBlockNum
-
-	// check we are within the uint32 range
-	if targetBlockNum < 0 || targetBlockNum > int64(^uint32(0)) {
-		panic(fmt.Errorf("targetBlockNum (%d) is out of uint32 range", targetBlockNum)) // software bug, panic
-	}
-	fee, err := rl.EstimateFeePerKW(uint32(targetBlockNum))
-	if err != nil {
-		defaultFee := rl.GetBTCConfig().DefaultFee
-		rl.logger.Errorf("failed to estimate transaction fee. Using default fee %v: %s", defaultFee, err.Error())
-
-		return defaultFee
-	}
-
-	feePerKVByte := fee.FeePerKVByte()
-
-	rl.logger.Debugf("current tx fee rate is %v", feePerKVByte)
-
-	cfg := rl.GetBTCConfig()
-	if feePerKVByte > cfg.TxFeeMax {
-		rl.logger.Debugf("current tx fee rate is higher than the maximum tx fee rate %v, using the max", cfg.TxFeeMax)
-		feePerKVByte = cfg.TxFeeMax
-	}
-	if feePerKVByte < cfg.TxFeeMin {
-		rl.logger.Debugf("current tx fee rate is lower than the minimum tx fee rate %v, using the min", cfg.TxFeeMin)
-		feePerKVByte = cfg.TxFeeMin
-	}
-
-	return feePerKVByte
+	return rl.feePolicy.FeeRate(rl.currentEpoch)
 }
 
 func (rl *Relayer) sendTxToBTC(tx *wire.MsgTx) (*chainhash.Hash, error) {
@@ -731,5 +1103,12 @@ func maybeResendFromStore(
 		return false, err
 	}
 
+	// the CPFP child spends the last tx, so it is resent after its parents
+	if storedCkpt.Child != nil {
+		if err := maybeResendFunc(storedCkpt.Child); err != nil {
+			return false, err
+		}
+	}
+
 	return true, nil
 }
@@ -2,12 +2,26 @@ package relayer
 
 import (
 	"errors"
+	"testing"
+
+	"github.com/babylonlabs-io/vigilante/config"
+	"github.com/babylonlabs-io/vigilante/submitter/feepolicy"
+	"github.com/babylonlabs-io/vigilante/submitter/signer"
 	"github.com/babylonlabs-io/vigilante/submitter/store"
+	"github.com/babylonlabs-io/vigilante/testutil"
+	"github.com/babylonlabs-io/vigilante/testutil/mocks"
+	"github.com/babylonlabs-io/vigilante/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/golang/mock/gomock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/assert"
-	"testing"
+	"go.uber.org/zap"
 )
 
 func Test_maybeResendFromStore(t *testing.T) {
@@ -114,3 +128,215 @@ func Test_maybeResendFromStore(t *testing.T) {
 		})
 	}
 }
+
+func Test_maybeResendFromStoreWithChild(t *testing.T) {
+	t.Parallel()
+
+	tx1 := wire.NewMsgTx(wire.TxVersion)
+	tx1.AddTxOut(wire.NewTxOut(1, nil))
+	tx2 := wire.NewMsgTx(wire.TxVersion)
+	tx2.AddTxOut(wire.NewTxOut(2, nil))
+	child := wire.NewMsgTx(wire.TxVersion)
+	child.AddTxOut(wire.NewTxOut(3, nil))
+
+	var sent []chainhash.Hash
+	result, err := maybeResendFromStore(
+		123,
+		func() (*store.StoredCheckpoint, bool, error) {
+			return &store.StoredCheckpoint{Epoch: 123, Tx1: tx1, Tx2: tx2, Child: child}, true, nil
+		},
+		func(_ *chainhash.Hash) (*btcutil.Tx, error) {
+			return nil, errors.New("transaction not found")
+		},
+		func(tx *wire.MsgTx) (*chainhash.Hash, error) {
+			txHash := tx.TxHash()
+			sent = append(sent, txHash)
+
+			return &txHash, nil
+		},
+	)
+	assert.NoError(t, err)
+	assert.True(t, result)
+	// the child is resent after its parents
+	assert.Equal(t, []chainhash.Hash{tx1.TxHash(), tx2.TxHash(), child.TxHash()}, sent)
+}
+
+func Test_calculateChildFee(t *testing.T) {
+	t.Parallel()
+
+	btcConfig := config.DefaultBTCConfig()
+	btcConfig.TxFeeMin = chainfee.SatPerKVByte(1000)
+	btcConfig.TxFeeMax = chainfee.SatPerKVByte(100000)
+	wallet := mocks.NewMockBTCWallet(gomock.NewController(t))
+	wallet.EXPECT().GetBTCConfig().Return(&btcConfig).AnyTimes()
+
+	// estimated fee rate of 10 sat/vB, min relay fee rate of 1 sat/vB
+	est := chainfee.NewStaticEstimator(chainfee.SatPerKVByte(10000).FeePerKWeight(), chainfee.SatPerKVByte(1000).FeePerKWeight())
+	feePolicyCfg := config.DefaultFeePolicyConfig()
+	rl := &Relayer{
+		Estimator: est,
+		BTCWallet: wallet,
+		feePolicy: feepolicy.New(&feePolicyCfg, est, wallet, nil, 0, zap.NewNop()),
+		config:    &config.SubmitterConfig{ResubmitFeeMultiplier: 2},
+		logger:    zap.NewNop().Sugar(),
+	}
+
+	tx1 := &types.BtcTxInfo{Size: 200, Fee: 200}
+	tx2 := &types.BtcTxInfo{Size: 200, Fee: 200}
+
+	tests := []struct {
+		name        string
+		parents     []*types.BtcTxInfo
+		childSize   int64
+		prevChild   *types.BtcTxInfo
+		expectedFee btcutil.Amount
+	}{
+		{
+			name:        "child pays for both checkpoint txs",
+			parents:     []*types.BtcTxInfo{tx2, tx1},
+			childSize:   100,
+			expectedFee: 5000 - 400,
+		},
+		{
+			name:        "child pays for the second tx only",
+			parents:     []*types.BtcTxInfo{tx2},
+			childSize:   100,
+			expectedFee: 3000 - 200,
+		},
+		{
+			name:        "parents already above the estimate",
+			parents:     []*types.BtcTxInfo{{Size: 200, Fee: 100000}},
+			childSize:   100,
+			expectedFee: 100,
+		},
+		{
+			name:        "replacing a previous child",
+			parents:     []*types.BtcTxInfo{tx2, tx1},
+			childSize:   100,
+			prevChild:   &types.BtcTxInfo{Size: 100, Fee: 4600},
+			expectedFee: 9200,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedFee, rl.calculateChildFee(tc.parents, tc.childSize, tc.prevChild))
+		})
+	}
+}
+
+func Test_signerInputsAndChange(t *testing.T) {
+	t.Parallel()
+
+	btcConfig := config.DefaultBTCConfig()
+	wallet := mocks.NewMockBTCWallet(gomock.NewController(t))
+	wallet.EXPECT().GetBTCConfig().Return(&btcConfig).AnyTimes()
+	est := chainfee.NewStaticEstimator(chainfee.SatPerKVByte(10000).FeePerKWeight(), chainfee.SatPerKVByte(1000).FeePerKWeight())
+	feePolicyCfg := config.DefaultFeePolicyConfig()
+
+	prevTx := wire.NewMsgTx(wire.TxVersion)
+	prevTx.AddTxOut(wire.NewTxOut(10000, []byte{txscript.OP_TRUE}))
+	prevTxHash := prevTx.TxHash()
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevTxHash, 0), nil, nil))
+
+	// the wallet signer neither needs the spent outputs, which would require -txindex,
+	// nor a change address
+	rl := &Relayer{
+		Estimator: est,
+		BTCWallet: wallet,
+		signer:    signer.NewWalletSigner(wallet),
+		feePolicy: feepolicy.New(&feePolicyCfg, est, wallet, nil, 0, zap.NewNop()),
+		logger:    zap.NewNop().Sugar(),
+	}
+	packet, err := rl.newPsbt(tx)
+	assert.NoError(t, err)
+	assert.Nil(t, packet.Inputs[0].WitnessUtxo)
+	assert.Nil(t, rl.fundRawTxOpts().ChangeAddress)
+
+	// the file signer needs the spent outputs, and the change has to be spendable by it
+	key, err := btcec.NewPrivateKey()
+	assert.NoError(t, err)
+	fileSigner, err := signer.NewFileSigner([]*btcec.PrivateKey{key}, &chaincfg.SimNetParams)
+	assert.NoError(t, err)
+	rl.signer = fileSigner
+	wallet.EXPECT().GetRawTransaction(&prevTxHash).Return(btcutil.NewTx(prevTx), nil)
+	packet, err = rl.newPsbt(tx)
+	assert.NoError(t, err)
+	assert.Equal(t, prevTx.TxOut[0], packet.Inputs[0].WitnessUtxo)
+	changeAddr := rl.fundRawTxOpts().ChangeAddress
+	if assert.NotNil(t, changeAddr) {
+		assert.Equal(t, fileSigner.ChangeAddress().EncodeAddress(), *changeAddr)
+	}
+}
+
+func Test_chainTwoTxRecordsTx1WhenTx2Fails(t *testing.T) {
+	t.Parallel()
+
+	btcConfig := config.DefaultBTCConfig()
+	wallet := mocks.NewMockBTCWallet(gomock.NewController(t))
+	wallet.EXPECT().GetBTCConfig().Return(&btcConfig).AnyTimes()
+	wallet.EXPECT().GetNetParams().Return(&chaincfg.SimNetParams).AnyTimes()
+	wallet.EXPECT().GetWalletPass().Return("").AnyTimes()
+	wallet.EXPECT().GetWalletLockTime().Return(int64(10)).AnyTimes()
+	wallet.EXPECT().WalletPassphrase(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	wallet.EXPECT().GetBestBlock().Return(uint32(100), nil).AnyTimes()
+	est := chainfee.NewStaticEstimator(chainfee.SatPerKVByte(10000).FeePerKWeight(), chainfee.SatPerKVByte(1000).FeePerKWeight())
+	feePolicyCfg := config.DefaultFeePolicyConfig()
+
+	key, err := btcec.NewPrivateKey()
+	assert.NoError(t, err)
+	changeAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.SimNetParams)
+	assert.NoError(t, err)
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	assert.NoError(t, err)
+
+	// the wallet funds the data txs with a change output and signs them as they are
+	wallet.EXPECT().FundRawTransaction(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(tx *wire.MsgTx, _ btcjson.FundRawTransactionOpts, _ *bool) (*btcjson.FundRawTransactionResult, error) {
+			funded := tx.Copy()
+			if len(funded.TxIn) == 0 {
+				funded.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+			}
+			funded.AddTxOut(wire.NewTxOut(100_000, changeScript))
+
+			return &btcjson.FundRawTransactionResult{Transaction: funded, Fee: 1000}, nil
+		}).Times(2)
+	wallet.EXPECT().SignRawTransactionWithWallet(gomock.Any()).
+		DoAndReturn(func(tx *wire.MsgTx) (*wire.MsgTx, bool, error) {
+			return tx, true, nil
+		}).Times(2)
+
+	// tx1 is broadcast, and tx2 fails
+	tx1Hash := chainhash.Hash{1}
+	gomock.InOrder(
+		wallet.EXPECT().SendRawTransaction(gomock.Any(), true).Return(&tx1Hash, nil),
+		wallet.EXPECT().SendRawTransaction(gomock.Any(), true).Return(nil, errors.New("tx2 rejected")),
+	)
+
+	s, err := store.NewSubmitterStore(testutil.MakeTestBackend(t))
+	assert.NoError(t, err)
+	rl := &Relayer{
+		Estimator:               est,
+		BTCWallet:               wallet,
+		signer:                  signer.NewWalletSigner(wallet),
+		feePolicy:               feepolicy.New(&feePolicyCfg, est, wallet, nil, 0, zap.NewNop()),
+		store:                   s,
+		lastSubmittedCheckpoint: &types.CheckpointInfo{},
+		logger:                  zap.NewNop().Sugar(),
+	}
+
+	_, _, err = rl.ChainTwoTxAndSend(7, []byte("data1"), []byte("data2"))
+	assert.ErrorContains(t, err, "tx2 rejected")
+
+	// tx1 is on the network, so it is in the history of the checkpoint
+	history, exists, err := s.CheckpointHistory(7)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	if assert.Len(t, history.Txs, 1) {
+		assert.Equal(t, uint32(0), history.Txs[0].TxIndex)
+		assert.Equal(t, rl.lastSubmittedCheckpoint.Tx1.Tx.TxHash(), history.Txs[0].Tx.TxHash())
+		assert.Equal(t, uint32(100), history.Txs[0].BroadcastHeight)
+	}
+}
@@ -0,0 +1,159 @@
+package signer
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// FileSigner signs with private keys loaded from a local file, so that they do not
+// have to be kept in the bitcoind wallet. It signs P2WPKH and P2TR key path inputs.
+// The change of the txs goes to the P2WPKH address of the first key.
+type FileSigner struct {
+	// keys indexed by the pk scripts they can spend
+	keys       map[string]*btcec.PrivateKey
+	changeAddr btcutil.Address
+}
+
+// NewFileSigner creates a signer with the given private keys
+func NewFileSigner(keys []*btcec.PrivateKey, net *chaincfg.Params) (*FileSigner, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no key is given to the file signer")
+	}
+
+	changeAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(keys[0].PubKey().SerializeCompressed()), net,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileSigner{
+		keys:       make(map[string]*btcec.PrivateKey),
+		changeAddr: changeAddr,
+	}
+	for _, key := range keys {
+		pubKey := key.PubKey()
+
+		p2wpkhScript, err := txscript.NewScriptBuilder().
+			AddOp(txscript.OP_0).
+			AddData(btcutil.Hash160(pubKey.SerializeCompressed())).
+			Script()
+		if err != nil {
+			return nil, err
+		}
+		s.keys[string(p2wpkhScript)] = key
+
+		p2trScript, err := txscript.PayToTaprootScript(txscript.ComputeTaprootKeyNoScript(pubKey))
+		if err != nil {
+			return nil, err
+		}
+		s.keys[string(p2trScript)] = key
+	}
+
+	return s, nil
+}
+
+// NewFileSignerFromFile creates a signer with the WIF-encoded private keys in the
+// file, one per line. Empty lines and lines starting with # are ignored.
+func NewFileSignerFromFile(path string, net *chaincfg.Params) (*FileSigner, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the key file: %w", err)
+	}
+
+	var keys []*btcec.PrivateKey
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		wif, err := btcutil.DecodeWIF(line)
+		if err != nil {
+			// do not include the line as it may be a malformed key
+			return nil, fmt.Errorf("invalid WIF key at line %d of the key file", lineNum)
+		}
+		keys = append(keys, wif.PrivKey)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the key file: %w", err)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no key found in the key file %s", path)
+	}
+
+	return NewFileSigner(keys, net)
+}
+
+func (s *FileSigner) NeedsPrevOutputs() bool {
+	return true
+}
+
+func (s *FileSigner) ChangeAddress() btcutil.Address {
+	return s.changeAddr
+}
+
+func (s *FileSigner) SignPsbt(packet *psbt.Packet) (*wire.MsgTx, error) {
+	unsignedTx := packet.UnsignedTx
+
+	// taproot signatures commit to all the outputs spent by the tx
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range unsignedTx.TxIn {
+		utxo := packet.Inputs[i].WitnessUtxo
+		if utxo == nil {
+			return nil, fmt.Errorf("the output spent by input %d is missing in the PSBT", i)
+		}
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, utxo)
+	}
+	sigHashes := txscript.NewTxSigHashes(unsignedTx, prevOutFetcher)
+
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range unsignedTx.TxIn {
+		utxo := packet.Inputs[i].WitnessUtxo
+		key, ok := s.keys[string(utxo.PkScript)]
+		if !ok {
+			return nil, fmt.Errorf("no key for the output spent by input %d", i)
+		}
+
+		if txscript.IsPayToTaproot(utxo.PkScript) {
+			sig, err := txscript.RawTxInTaprootSignature(
+				unsignedTx, sigHashes, i, utxo.Value, utxo.PkScript, nil, txscript.SigHashDefault, key,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to sign input %d: %w", i, err)
+			}
+			packet.Inputs[i].TaprootKeySpendSig = sig
+
+			continue
+		}
+
+		sig, err := txscript.RawTxInWitnessSignature(
+			unsignedTx, sigHashes, i, utxo.Value, utxo.PkScript, txscript.SigHashAll, key,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign input %d: %w", i, err)
+		}
+		if _, err := updater.Sign(i, sig, key.PubKey().SerializeCompressed(), nil, nil); err != nil {
+			return nil, fmt.Errorf("failed to add the signature of input %d: %w", i, err)
+		}
+	}
+
+	return finalizeAndExtract(unsignedTx, packet)
+}
@@ -0,0 +1,108 @@
+package signer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// maxResponseSize bounds the size of the responses of the remote signer
+const maxResponseSize = 1 << 20
+
+// SignPsbtRequest is the body of the requests to the remote signer
+type SignPsbtRequest struct {
+	// Psbt is the base64-encoded PSBT to sign
+	Psbt string `json:"psbt"`
+}
+
+// SignPsbtResponse is the body of the responses of the remote signer
+type SignPsbtResponse struct {
+	// Psbt is the base64-encoded PSBT with all the inputs signed, and possibly finalized
+	Psbt string `json:"psbt"`
+}
+
+// RemoteSigner sends the PSBTs over HTTP to a signer holding the keys.
+// The signer receives a SignPsbtRequest in a POST request and replies with a
+// SignPsbtResponse.
+type RemoteSigner struct {
+	url        string
+	authToken  string
+	changeAddr btcutil.Address
+	client     *http.Client
+}
+
+// NewRemoteSigner creates a remote signer. The change of the txs is sent to
+// changeAddr, or to an address of the wallet if it is nil.
+func NewRemoteSigner(url string, authToken string, timeout time.Duration, changeAddr btcutil.Address) *RemoteSigner {
+	return &RemoteSigner{
+		url:        url,
+		authToken:  authToken,
+		changeAddr: changeAddr,
+		client:     &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *RemoteSigner) NeedsPrevOutputs() bool {
+	return true
+}
+
+func (s *RemoteSigner) ChangeAddress() btcutil.Address {
+	return s.changeAddr
+}
+
+func (s *RemoteSigner) SignPsbt(packet *psbt.Packet) (*wire.MsgTx, error) {
+	encoded, err := packet.B64Encode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the PSBT: %w", err)
+	}
+
+	body, err := json.Marshal(&SignPsbtRequest{Psbt: encoded})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.authToken)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send the PSBT to the remote signer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of the remote signer: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the remote signer responded with status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var signResp SignPsbtResponse
+	if err := json.Unmarshal(respBody, &signResp); err != nil {
+		return nil, fmt.Errorf("invalid response of the remote signer: %w", err)
+	}
+
+	signed, err := psbt.NewFromRawBytes(strings.NewReader(signResp.Psbt), true)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PSBT from the remote signer: %w", err)
+	}
+
+	return finalizeAndExtract(packet.UnsignedTx, signed)
+}
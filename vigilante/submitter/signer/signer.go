@@ -0,0 +1,69 @@
+package signer
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonlabs-io/vigilante/btcclient"
+	"github.com/babylonlabs-io/vigilante/config"
+)
+
+// Signer signs the PSBTs of the checkpoint txs funded by the wallet of the submitter
+type Signer interface {
+	// SignPsbt signs all the inputs of the PSBT and returns the finalized tx
+	SignPsbt(packet *psbt.Packet) (*wire.MsgTx, error)
+	// NeedsPrevOutputs returns whether the inputs of the PSBTs have to carry the
+	// outputs they spend
+	NeedsPrevOutputs() bool
+	// ChangeAddress returns the address the change of the checkpoint txs is sent to,
+	// so that the signer can spend it in the chained txs, or nil if the wallet picks it
+	ChangeAddress() btcutil.Address
+}
+
+// New creates the signer defined by the config
+func New(cfg *config.SignerConfig, wallet btcclient.BTCWallet) (Signer, error) {
+	switch cfg.Type {
+	case config.SignerWallet:
+		return NewWalletSigner(wallet), nil
+	case config.SignerFile:
+		return NewFileSignerFromFile(cfg.KeyFile, wallet.GetNetParams())
+	case config.SignerRemote:
+		var changeAddr btcutil.Address
+		if cfg.ChangeAddress != "" {
+			var err error
+			changeAddr, err = btcutil.DecodeAddress(cfg.ChangeAddress, wallet.GetNetParams())
+			if err != nil {
+				return nil, fmt.Errorf("invalid change address of the remote signer: %w", err)
+			}
+		}
+
+		return NewRemoteSigner(cfg.URL, cfg.AuthToken, cfg.Timeout, changeAddr), nil
+	default:
+		return nil, fmt.Errorf("unknown signer type %q", cfg.Type)
+	}
+}
+
+// finalizeAndExtract finalizes the inputs of the signed PSBT and extracts the
+// tx, making sure it is the tx the signer was asked to sign
+func finalizeAndExtract(unsignedTx *wire.MsgTx, signed *psbt.Packet) (*wire.MsgTx, error) {
+	// the txid does not commit to the witnesses, so it changes only if the
+	// inputs or the outputs have been tampered with
+	if signed.UnsignedTx.TxHash() != unsignedTx.TxHash() {
+		return nil, fmt.Errorf("the signed PSBT is for tx %s instead of %s",
+			signed.UnsignedTx.TxHash(), unsignedTx.TxHash())
+	}
+
+	if err := psbt.MaybeFinalizeAll(signed); err != nil {
+		return nil, fmt.Errorf("failed to finalize the PSBT: %w", err)
+	}
+
+	tx, err := psbt.Extract(signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract the tx from the PSBT: %w", err)
+	}
+
+	return tx, nil
+}
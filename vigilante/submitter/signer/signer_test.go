@@ -0,0 +1,208 @@
+package signer_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bbndatagen "github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/vigilante/submitter/signer"
+)
+
+// genPsbt creates a PSBT spending a P2WPKH and a P2TR output of the key
+func genPsbt(t *testing.T, r *rand.Rand, key *btcec.PrivateKey) *psbt.Packet {
+	p2wpkhAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.SimNetParams)
+	require.NoError(t, err)
+	p2wpkhScript, err := txscript.PayToAddrScript(p2wpkhAddr)
+	require.NoError(t, err)
+	p2trScript, err := txscript.PayToTaprootScript(txscript.ComputeTaprootKeyNoScript(key.PubKey()))
+	require.NoError(t, err)
+
+	utxos := []*wire.TxOut{
+		wire.NewTxOut(r.Int63n(1000000)+10000, p2wpkhScript),
+		wire.NewTxOut(r.Int63n(1000000)+10000, p2trScript),
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for range utxos {
+		prevHash, err := chainhash.NewHash(bbndatagen.GenRandomByteArray(r, chainhash.HashSize))
+		require.NoError(t, err)
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, r.Uint32()%10), nil, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(5000, p2wpkhScript))
+
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	require.NoError(t, err)
+	for i, utxo := range utxos {
+		packet.Inputs[i].WitnessUtxo = utxo
+	}
+
+	return packet
+}
+
+// verifyTx executes the scripts of all the inputs of the signed tx
+func verifyTx(t *testing.T, signedTx *wire.MsgTx, utxos []*wire.TxOut) {
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range signedTx.TxIn {
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, utxos[i])
+	}
+	sigHashes := txscript.NewTxSigHashes(signedTx, prevOutFetcher)
+
+	for i, utxo := range utxos {
+		engine, err := txscript.NewEngine(
+			utxo.PkScript, signedTx, i, txscript.StandardVerifyFlags, nil, sigHashes, utxo.Value, prevOutFetcher,
+		)
+		require.NoError(t, err)
+		require.NoError(t, engine.Execute())
+	}
+}
+
+func getUtxos(packet *psbt.Packet) []*wire.TxOut {
+	var utxos []*wire.TxOut
+	for _, in := range packet.Inputs {
+		utxos = append(utxos, in.WitnessUtxo)
+	}
+
+	return utxos
+}
+
+func FuzzFileSigner(f *testing.F) {
+	bbndatagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		otherKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		// keys are loaded from the file, skipping comments and empty lines
+		wif, err := btcutil.NewWIF(key, &chaincfg.SimNetParams, true)
+		require.NoError(t, err)
+		otherWif, err := btcutil.NewWIF(otherKey, &chaincfg.SimNetParams, true)
+		require.NoError(t, err)
+		keyFile := filepath.Join(t.TempDir(), "keys")
+		content := fmt.Sprintf("# checkpoint funding keys\n%s\n\n%s\n", otherWif.String(), wif.String())
+		require.NoError(t, os.WriteFile(keyFile, []byte(content), 0600))
+
+		fileSigner, err := signer.NewFileSignerFromFile(keyFile, &chaincfg.SimNetParams)
+		require.NoError(t, err)
+
+		// the change goes to the P2WPKH address of the first key, which the signer can spend
+		require.True(t, fileSigner.NeedsPrevOutputs())
+		expectedChangeAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+			btcutil.Hash160(otherKey.PubKey().SerializeCompressed()), &chaincfg.SimNetParams)
+		require.NoError(t, err)
+		require.Equal(t, expectedChangeAddr.EncodeAddress(), fileSigner.ChangeAddress().EncodeAddress())
+
+		packet := genPsbt(t, r, key)
+		utxos := getUtxos(packet)
+		signedTx, err := fileSigner.SignPsbt(packet)
+		require.NoError(t, err)
+		verifyTx(t, signedTx, utxos)
+
+		// inputs of unknown keys cannot be signed
+		otherSigner, err := signer.NewFileSigner([]*btcec.PrivateKey{otherKey}, &chaincfg.SimNetParams)
+		require.NoError(t, err)
+		_, err = otherSigner.SignPsbt(genPsbt(t, r, key))
+		require.Error(t, err)
+	})
+}
+
+func TestFileSignerInvalidKeyFile(t *testing.T) {
+	t.Parallel()
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("not-a-key\n"), 0600))
+
+	_, err := signer.NewFileSignerFromFile(keyFile, &chaincfg.SimNetParams)
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "not-a-key")
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	const authToken = "secret"
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	fileSigner, err := signer.NewFileSigner([]*btcec.PrivateKey{key}, &chaincfg.SimNetParams)
+	require.NoError(t, err)
+
+	var tamper atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer "+authToken {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		var signReq signer.SignPsbtRequest
+		if err := json.NewDecoder(req.Body).Decode(&signReq); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		packet, err := psbt.NewFromRawBytes(strings.NewReader(signReq.Psbt), true)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		if tamper.Load() {
+			packet.UnsignedTx.TxOut[0].Value--
+		}
+
+		// the packet is finalized in place while signing
+		if _, err := fileSigner.SignPsbt(packet); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+		encoded, err := packet.B64Encode()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&signer.SignPsbtResponse{Psbt: encoded})
+	}))
+	defer server.Close()
+
+	remoteSigner := signer.NewRemoteSigner(server.URL, authToken, 5*time.Second, nil)
+	packet := genPsbt(t, r, key)
+	utxos := getUtxos(packet)
+	signedTx, err := remoteSigner.SignPsbt(packet)
+	require.NoError(t, err)
+	verifyTx(t, signedTx, utxos)
+
+	// the signer must be authenticated
+	_, err = signer.NewRemoteSigner(server.URL, "wrong", 5*time.Second, nil).SignPsbt(genPsbt(t, r, key))
+	require.ErrorContains(t, err, "401")
+
+	// a tx different from the requested one is rejected
+	tamper.Store(true)
+	_, err = remoteSigner.SignPsbt(genPsbt(t, r, key))
+	require.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package signer
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonlabs-io/vigilante/btcclient"
+)
+
+// WalletSigner signs with the keys of the bitcoind wallet funding the txs
+type WalletSigner struct {
+	wallet btcclient.BTCWallet
+}
+
+func NewWalletSigner(wallet btcclient.BTCWallet) *WalletSigner {
+	return &WalletSigner{wallet: wallet}
+}
+
+func (s *WalletSigner) SignPsbt(packet *psbt.Packet) (*wire.MsgTx, error) {
+	// unlock the wallet
+	if err := s.wallet.WalletPassphrase(s.wallet.GetWalletPass(), s.wallet.GetWalletLockTime()); err != nil {
+		return nil, err
+	}
+
+	signedTx, allSigned, err := s.wallet.SignRawTransactionWithWallet(packet.UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allSigned {
+		return nil, errors.New("transaction is only partially signed")
+	}
+
+	return signedTx, nil
+}
+
+// NeedsPrevOutputs returns false as the wallet looks up the outputs it spends
+func (s *WalletSigner) NeedsPrevOutputs() bool {
+	return false
+}
+
+// ChangeAddress returns nil as the change goes to an address of the wallet
+func (s *WalletSigner) ChangeAddress() btcutil.Address {
+	return nil
+}
@@ -1,12 +1,19 @@
 package store
 
 import (
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"time"
+
 	"github.com/babylonlabs-io/vigilante/proto"
 	"github.com/babylonlabs-io/vigilante/utils"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/walletdb"
 	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	pm "google.golang.org/protobuf/proto"
 )
 
@@ -18,6 +25,8 @@ var (
 	// storing submitted txns
 	storedCheckpointBucketName = []byte("storedckpt")
 	lastSubmittedCkptKey       = []byte("lastsubckpt")
+	// storing every submitted txn, keyed by epoch
+	checkpointHistoryBucketName = []byte("ckpthistory")
 )
 
 var (
@@ -31,6 +40,7 @@ type StoredCheckpoint struct {
 	Tx1   *wire.MsgTx
 	Tx2   *wire.MsgTx
 	Epoch uint64
+	Child *wire.MsgTx // the CPFP child bumping the checkpoint, nil if none
 }
 
 func NewStoredCheckpoint(tx1, tx2 *wire.MsgTx, epoch uint64) *StoredCheckpoint {
@@ -41,6 +51,48 @@ func NewStoredCheckpoint(tx1, tx2 *wire.MsgTx, epoch uint64) *StoredCheckpoint {
 	}
 }
 
+// SubmittedTx is a single broadcast version of a checkpoint tx
+type SubmittedTx struct {
+	TxIndex         uint32 // 0 for the first tx of the checkpoint, 1 for the second, 2 for a CPFP child
+	Tx              *wire.MsgTx
+	Fee             btcutil.Amount
+	FeeRate         chainfee.SatPerKVByte
+	BroadcastTime   time.Time
+	InclusionHeight uint32 // 0 if the tx has not been observed in a BTC block
+	BroadcastHeight uint32 // height of the BTC tip when the tx was broadcast, 0 if unknown
+}
+
+// NewSubmittedTx creates a SubmittedTx, deriving the fee rate from the fee and the virtual size of the tx
+func NewSubmittedTx(
+	txIndex uint32,
+	tx *wire.MsgTx,
+	fee btcutil.Amount,
+	vSize int64,
+	broadcastTime time.Time,
+	broadcastHeight uint32,
+) *SubmittedTx {
+	var feeRate chainfee.SatPerKVByte
+	if vSize > 0 {
+		feeRate = chainfee.SatPerKVByte(fee * 1000 / btcutil.Amount(vSize))
+	}
+
+	return &SubmittedTx{
+		TxIndex:         txIndex,
+		Tx:              tx,
+		Fee:             fee,
+		FeeRate:         feeRate,
+		BroadcastTime:   broadcastTime,
+		BroadcastHeight: broadcastHeight,
+	}
+}
+
+// CheckpointHistory holds every tx broadcast for the checkpoint of an epoch,
+// in the order they were broadcast
+type CheckpointHistory struct {
+	Epoch uint64
+	Txs   []*SubmittedTx
+}
+
 func NewSubmitterStore(backend kvdb.Backend) (*SubmitterStore, error) {
 	store := &SubmitterStore{db: backend}
 	if err := store.createBuckets(); err != nil {
@@ -51,7 +103,7 @@ func NewSubmitterStore(backend kvdb.Backend) (*SubmitterStore, error) {
 }
 
 func (s *SubmitterStore) createBuckets() error {
-	buckets := [][]byte{storedCheckpointBucketName}
+	buckets := [][]byte{storedCheckpointBucketName, checkpointHistoryBucketName}
 	for _, bucket := range buckets {
 		if err := s.db.Update(func(tx kvdb.RwTx) error {
 			_, err := tx.CreateTopLevelBucket(bucket)
@@ -103,6 +155,111 @@ func (s *SubmitterStore) PutCheckpoint(ckpt *StoredCheckpoint) error {
 	return s.put(lastSubmittedCkptKey, data, storedCheckpointBucketName)
 }
 
+// AddSubmittedTx appends a broadcast tx to the submission history of the given epoch
+func (s *SubmitterStore) AddSubmittedTx(epoch uint64, stx *SubmittedTx) error {
+	return s.updateHistory(epoch, func(history *CheckpointHistory) error {
+		history.Txs = append(history.Txs, stx)
+
+		return nil
+	})
+}
+
+// SetInclusionHeight records the BTC height at which a tx of the given epoch was included
+func (s *SubmitterStore) SetInclusionHeight(epoch uint64, txHash chainhash.Hash, height uint32) error {
+	return s.updateHistory(epoch, func(history *CheckpointHistory) error {
+		for _, stx := range history.Txs {
+			if stx.Tx.TxHash() == txHash {
+				stx.InclusionHeight = height
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("tx %s of epoch %d: %w", txHash, epoch, ErrNotFound)
+	})
+}
+
+// CheckpointHistory returns the submission history of the given epoch
+func (s *SubmitterStore) CheckpointHistory(epoch uint64) (*CheckpointHistory, bool, error) {
+	data, f, err := s.get(uint64ToBytes(epoch), checkpointHistoryBucketName)
+	if err != nil {
+		return nil, false, err
+	} else if !f {
+		return nil, false, nil
+	}
+
+	history, err := historyFromBytes(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return history, true, nil
+}
+
+// CheckpointHistories returns at most limit submission histories, in ascending
+// epoch order, starting from startEpoch. A zero limit returns all of them.
+func (s *SubmitterStore) CheckpointHistories(startEpoch uint64, limit uint32) ([]*CheckpointHistory, error) {
+	var histories []*CheckpointHistory
+
+	err := s.db.View(func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(checkpointHistoryBucketName)
+		if b == nil {
+			return ErrCorruptedDB
+		}
+
+		c := b.ReadCursor()
+		for k, v := c.Seek(uint64ToBytes(startEpoch)); k != nil; k, v = c.Next() {
+			if limit > 0 && len(histories) >= int(limit) {
+				break
+			}
+
+			history, err := historyFromBytes(v)
+			if err != nil {
+				return err
+			}
+			histories = append(histories, history)
+		}
+
+		return nil
+	}, func() {
+		histories = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
+
+func (s *SubmitterStore) updateHistory(epoch uint64, update func(history *CheckpointHistory) error) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(checkpointHistoryBucketName)
+		if bucket == nil {
+			return ErrCorruptedDB
+		}
+
+		key := uint64ToBytes(epoch)
+		history := &CheckpointHistory{Epoch: epoch}
+		if data := bucket.Get(key); data != nil {
+			var err error
+			if history, err = historyFromBytes(data); err != nil {
+				return err
+			}
+		}
+
+		if err := update(history); err != nil {
+			return err
+		}
+
+		data, err := history.toBytes()
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, data)
+	}, func() {})
+}
+
 func (s *SubmitterStore) get(key, bucketName []byte) ([]byte, bool, error) {
 	var returnVal []byte
 
@@ -156,10 +313,19 @@ func (s *StoredCheckpoint) ToProto() (*proto.StoredCheckpoint, error) {
 		return nil, err
 	}
 
+	var bufChild []byte
+	if s.Child != nil {
+		bufChild, err = utils.SerializeMsgTx(s.Child)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &proto.StoredCheckpoint{
 		Tx1:   bufTx1,
 		Tx2:   bufTx2,
 		Epoch: s.Epoch,
+		Child: bufChild,
 	}, nil
 }
 
@@ -176,9 +342,114 @@ func (s *StoredCheckpoint) FromProto(protoTx *proto.StoredCheckpoint) error {
 		return err
 	}
 
+	var child *wire.MsgTx
+	if len(protoTx.Child) > 0 {
+		child, err = utils.DeserializeMsgTx(protoTx.Child)
+		if err != nil {
+			return err
+		}
+	}
+
 	s.Tx1 = tx1
 	s.Tx2 = tx2
 	s.Epoch = protoTx.Epoch
+	s.Child = child
+
+	return nil
+}
+
+// ToProto converts SubmittedTx to its Protobuf equivalent
+func (s *SubmittedTx) ToProto() (*proto.SubmittedTx, error) {
+	bufTx, err := utils.SerializeMsgTx(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SubmittedTx{
+		TxIndex:         s.TxIndex,
+		Tx:              bufTx,
+		Fee:             int64(s.Fee),
+		FeeRate:         int64(s.FeeRate),
+		BroadcastTime:   s.BroadcastTime.Unix(),
+		InclusionHeight: s.InclusionHeight,
+		BroadcastHeight: s.BroadcastHeight,
+	}, nil
+}
+
+// FromProto converts a Protobuf SubmittedTx to the Go struct
+func (s *SubmittedTx) FromProto(protoTx *proto.SubmittedTx) error {
+	tx, err := utils.DeserializeMsgTx(protoTx.Tx)
+	if err != nil {
+		return err
+	}
+
+	s.TxIndex = protoTx.TxIndex
+	s.Tx = tx
+	s.Fee = btcutil.Amount(protoTx.Fee)
+	s.FeeRate = chainfee.SatPerKVByte(protoTx.FeeRate)
+	s.BroadcastTime = time.Unix(protoTx.BroadcastTime, 0)
+	s.InclusionHeight = protoTx.InclusionHeight
+	s.BroadcastHeight = protoTx.BroadcastHeight
+
+	return nil
+}
+
+// ToProto converts CheckpointHistory to its Protobuf equivalent
+func (h *CheckpointHistory) ToProto() (*proto.CheckpointHistory, error) {
+	protoHistory := &proto.CheckpointHistory{Epoch: h.Epoch}
+	for _, stx := range h.Txs {
+		protoTx, err := stx.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		protoHistory.Txs = append(protoHistory.Txs, protoTx)
+	}
+
+	return protoHistory, nil
+}
+
+// FromProto converts a Protobuf CheckpointHistory to the Go struct
+func (h *CheckpointHistory) FromProto(protoHistory *proto.CheckpointHistory) error {
+	h.Epoch = protoHistory.Epoch
+	h.Txs = make([]*SubmittedTx, 0, len(protoHistory.Txs))
+	for _, protoTx := range protoHistory.Txs {
+		var stx SubmittedTx
+		if err := stx.FromProto(protoTx); err != nil {
+			return err
+		}
+		h.Txs = append(h.Txs, &stx)
+	}
 
 	return nil
 }
+
+func (h *CheckpointHistory) toBytes() ([]byte, error) {
+	protoHistory, err := h.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	return pm.Marshal(protoHistory)
+}
+
+func historyFromBytes(data []byte) (*CheckpointHistory, error) {
+	protoHistory := &proto.CheckpointHistory{}
+	if err := pm.Unmarshal(data, protoHistory); err != nil {
+		return nil, err
+	}
+
+	var history CheckpointHistory
+	if err := history.FromProto(protoHistory); err != nil {
+		return nil, err
+	}
+
+	return &history, nil
+}
+
+// Converts an uint64 value to a byte slice, big endian so that keys are sorted by epoch.
+func uint64ToBytes(v uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+
+	return buf[:]
+}
@@ -1,13 +1,18 @@
 package store_test
 
 import (
+	"math/rand"
+	"testing"
+	"time"
+
 	bbndatagen "github.com/babylonlabs-io/babylon/testutil/datagen"
 	"github.com/babylonlabs-io/vigilante/submitter/store"
 	"github.com/babylonlabs-io/vigilante/testutil"
 	"github.com/babylonlabs-io/vigilante/testutil/datagen"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
-	"math/rand"
-	"testing"
 )
 
 func FuzzStoringCkpt(f *testing.F) {
@@ -36,5 +41,82 @@ func FuzzStoringCkpt(f *testing.F) {
 		require.Equal(t, storedCkpt.Epoch, epoch)
 		require.Equal(t, storedCkpt.Tx1.TxHash().String(), tx1.TxHash().String())
 		require.Equal(t, storedCkpt.Tx2.TxHash().String(), tx2.TxHash().String())
+
+		// a checkpoint bumped with a CPFP child
+		child := datagen.GenRandomTx(r)
+		bumpedCkpt := store.NewStoredCheckpoint(tx1, tx2, epoch+1)
+		bumpedCkpt.Child = child
+		err = s.PutCheckpoint(bumpedCkpt)
+		require.NoError(t, err)
+
+		storedCkpt, exists, err = s.LatestCheckpoint()
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, storedCkpt.Tx2.TxHash().String(), tx2.TxHash().String())
+		require.Equal(t, storedCkpt.Child.TxHash().String(), child.TxHash().String())
+	})
+}
+
+func FuzzCheckpointHistory(f *testing.F) {
+	bbndatagen.AddRandomSeedsToFuzzer(f, 3)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+		db := testutil.MakeTestBackend(t)
+		s, err := store.NewSubmitterStore(db)
+		require.NoError(t, err)
+
+		epoch := uint64(r.Int63n(1000) + 1)
+		_, exists, err := s.CheckpointHistory(epoch)
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		tx1 := datagen.GenRandomTx(r)
+		err = s.AddSubmittedTx(epoch, store.NewSubmittedTx(0, tx1, 1000, 250, time.Unix(100, 0), 10))
+		require.NoError(t, err)
+
+		// tx2 is bumped a random number of times
+		numTx2 := int(r.Int31n(5) + 1)
+		var tx2 *wire.MsgTx
+		for i := 0; i < numTx2; i++ {
+			tx2 = datagen.GenRandomTx(r)
+			err = s.AddSubmittedTx(epoch, store.NewSubmittedTx(1, tx2, btcutil.Amount(2000*(i+1)), 250, time.Unix(int64(200+i), 0), uint32(11+i)))
+			require.NoError(t, err)
+		}
+
+		height := uint32(r.Int31n(1000) + 1)
+		require.NoError(t, s.SetInclusionHeight(epoch, tx2.TxHash(), height))
+		require.ErrorIs(t, s.SetInclusionHeight(epoch, datagen.GenRandomTx(r).TxHash(), height), store.ErrNotFound)
+
+		history, exists, err := s.CheckpointHistory(epoch)
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, epoch, history.Epoch)
+		require.Len(t, history.Txs, numTx2+1)
+		require.Equal(t, tx1.TxHash(), history.Txs[0].Tx.TxHash())
+		require.Equal(t, uint32(0), history.Txs[0].InclusionHeight)
+		require.Equal(t, chainfee.SatPerKVByte(4000), history.Txs[0].FeeRate)
+		require.Equal(t, int64(100), history.Txs[0].BroadcastTime.Unix())
+		require.Equal(t, uint32(10), history.Txs[0].BroadcastHeight)
+
+		last := history.Txs[numTx2]
+		require.Equal(t, uint32(1), last.TxIndex)
+		require.Equal(t, tx2.TxHash(), last.Tx.TxHash())
+		require.Equal(t, btcutil.Amount(2000*numTx2), last.Fee)
+		require.Equal(t, height, last.InclusionHeight)
+
+		// histories are listed in epoch order
+		require.NoError(t, s.AddSubmittedTx(epoch+1, store.NewSubmittedTx(0, datagen.GenRandomTx(r), 1000, 250, time.Now(), 0)))
+		histories, err := s.CheckpointHistories(epoch, 0)
+		require.NoError(t, err)
+		require.Len(t, histories, 2)
+		require.Equal(t, epoch, histories[0].Epoch)
+		require.Equal(t, epoch+1, histories[1].Epoch)
+
+		histories, err = s.CheckpointHistories(epoch+1, 1)
+		require.NoError(t, err)
+		require.Len(t, histories, 1)
+		require.Equal(t, epoch+1, histories[0].Epoch)
 	})
 }
@@ -19,6 +19,8 @@ import (
 	"github.com/babylonlabs-io/vigilante/metrics"
 	"github.com/babylonlabs-io/vigilante/submitter/poller"
 	"github.com/babylonlabs-io/vigilante/submitter/relayer"
+	"github.com/babylonlabs-io/vigilante/submitter/signer"
+	"github.com/babylonlabs-io/vigilante/submitter/store"
 )
 
 type Submitter struct {
@@ -80,6 +82,12 @@ func New(
 	}
 	logger.Sugar().Infof("Successfully started fee estimator for bitcoind")
 
+	txSigner, err := signer.New(&cfg.Signer, btcWallet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer: %w", err)
+	}
+	logger.Sugar().Infof("Checkpoint txs are signed by the %s signer", cfg.Signer.Type)
+
 	r := relayer.New(
 		btcWallet,
 		walletName,
@@ -88,7 +96,9 @@ func New(
 		submitterAddr,
 		submitterMetrics.RelayerMetrics,
 		est,
+		txSigner,
 		cfg,
+		btccheckpointParams.Params.CheckpointFinalizationTimeout,
 		logger,
 		db,
 	)
@@ -229,3 +239,14 @@ func (s *Submitter) processCheckpoints() {
 func (s *Submitter) Metrics() *metrics.SubmitterMetrics {
 	return s.metrics
 }
+
+// Status returns the last checkpoint submitted to BTC, or nil if no checkpoint has
+// been submitted since the submitter started
+func (s *Submitter) Status() *relayer.Status {
+	return s.relayer.Status()
+}
+
+// Store returns the store keeping the submitted checkpoints and their history
+func (s *Submitter) Store() *store.SubmitterStore {
+	return s.relayer.Store()
+}
@@ -256,6 +256,21 @@ func (mr *MockBTCWalletMockRecorder) GetBTCConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBTCConfig", reflect.TypeOf((*MockBTCWallet)(nil).GetBTCConfig))
 }
 
+// GetBestBlock mocks base method.
+func (m *MockBTCWallet) GetBestBlock() (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBestBlock")
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBestBlock indicates an expected call of GetBestBlock.
+func (mr *MockBTCWalletMockRecorder) GetBestBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestBlock", reflect.TypeOf((*MockBTCWallet)(nil).GetBestBlock))
+}
+
 // GetHighUTXOAndSum mocks base method.
 func (m *MockBTCWallet) GetHighUTXOAndSum() (*btcjson.ListUnspentResult, float64, error) {
 	m.ctrl.T.Helper()
@@ -272,6 +287,21 @@ func (mr *MockBTCWalletMockRecorder) GetHighUTXOAndSum() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighUTXOAndSum", reflect.TypeOf((*MockBTCWallet)(nil).GetHighUTXOAndSum))
 }
 
+// GetMempoolEntries mocks base method.
+func (m *MockBTCWallet) GetMempoolEntries() ([]btcclient.MempoolEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMempoolEntries")
+	ret0, _ := ret[0].([]btcclient.MempoolEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMempoolEntries indicates an expected call of GetMempoolEntries.
+func (mr *MockBTCWalletMockRecorder) GetMempoolEntries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMempoolEntries", reflect.TypeOf((*MockBTCWallet)(nil).GetMempoolEntries))
+}
+
 // GetNetParams mocks base method.
 func (m *MockBTCWallet) GetNetParams() *chaincfg.Params {
 	m.ctrl.T.Helper()
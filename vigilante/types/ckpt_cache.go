@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/babylonlabs-io/babylon/btctxformatter"
 )
@@ -19,6 +20,8 @@ type CheckpointCache struct {
 	// first key: index of the segment in the checkpoint (0 or 1)
 	// second key: hash of the OP_RETURN data in this ckpt segment
 	Segments map[uint8]map[string]*CkptSegment
+
+	sync.RWMutex
 }
 
 func NewCheckpointCache(tag btctxformatter.BabylonTag, version btctxformatter.FormatVersion) *CheckpointCache {
@@ -37,6 +40,9 @@ func NewCheckpointCache(tag btctxformatter.BabylonTag, version btctxformatter.Fo
 }
 
 func (c *CheckpointCache) AddSegment(ckptSeg *CkptSegment) error {
+	c.Lock()
+	defer c.Unlock()
+
 	if ckptSeg.Index >= btctxformatter.NumberOfParts {
 		return fmt.Errorf("the index of the ckpt segment in block %v is out of scope: got %d, at most %d", ckptSeg.AssocBlock.BlockHash(), ckptSeg.Index, btctxformatter.NumberOfParts-1)
 	}
@@ -47,6 +53,14 @@ func (c *CheckpointCache) AddSegment(ckptSeg *CkptSegment) error {
 }
 
 func (c *CheckpointCache) AddCheckpoint(ckpt *Ckpt) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.addCheckpoint(ckpt)
+}
+
+// Thread-unsafe version of AddCheckpoint
+func (c *CheckpointCache) addCheckpoint(ckpt *Ckpt) {
 	c.Checkpoints = append(c.Checkpoints, ckpt)
 }
 
@@ -61,6 +75,9 @@ func (c *CheckpointCache) sortCheckpoints() {
 // TODO: generalise to NumExpectedProofs > 2
 // TODO: optimise the complexity by hashmap
 func (c *CheckpointCache) Match() {
+	c.Lock()
+	defer c.Unlock()
+
 	for hash1, ckptSeg1 := range c.Segments[uint8(0)] {
 		for hash2, ckptSeg2 := range c.Segments[uint8(1)] {
 			connected, err := btctxformatter.ConnectParts(c.Version, ckptSeg1.Data, ckptSeg2.Data)
@@ -75,7 +92,7 @@ func (c *CheckpointCache) Match() {
 			// create the matched checkpoint
 			ckpt := NewCkpt(ckptSeg1, ckptSeg2, rawCheckpoint.Epoch)
 			// add to the ckptList
-			c.AddCheckpoint(ckpt)
+			c.addCheckpoint(ckpt)
 			// remove the two ckptSeg in segMap
 			delete(c.Segments[uint8(0)], hash1)
 			delete(c.Segments[uint8(1)], hash2)
@@ -87,7 +104,10 @@ func (c *CheckpointCache) Match() {
 }
 
 func (c *CheckpointCache) PopEarliestCheckpoint() *Ckpt {
-	if c.HasCheckpoints() {
+	c.Lock()
+	defer c.Unlock()
+
+	if len(c.Checkpoints) > 0 {
 		ckpt := c.Checkpoints[0]
 		c.Checkpoints = c.Checkpoints[1:]
 
@@ -98,6 +118,9 @@ func (c *CheckpointCache) PopEarliestCheckpoint() *Ckpt {
 }
 
 func (c *CheckpointCache) NumSegments() int {
+	c.RLock()
+	defer c.RUnlock()
+
 	size := 0
 	for _, segMap := range c.Segments {
 		size += len(segMap)
@@ -106,7 +129,25 @@ func (c *CheckpointCache) NumSegments() int {
 	return size
 }
 
+// PendingSegments returns the segments that are not matched with their counterpart yet
+func (c *CheckpointCache) PendingSegments() []*CkptSegment {
+	c.RLock()
+	defer c.RUnlock()
+
+	var segments []*CkptSegment
+	for i := uint8(0); i < btctxformatter.NumberOfParts; i++ {
+		for _, seg := range c.Segments[i] {
+			segments = append(segments, seg)
+		}
+	}
+
+	return segments
+}
+
 func (c *CheckpointCache) NumCheckpoints() int {
+	c.RLock()
+	defer c.RUnlock()
+
 	return len(c.Checkpoints)
 }
 
@@ -97,5 +97,6 @@ func FuzzCheckpointCache(f *testing.F) {
 
 		require.Equal(t, numMatchedPairs, ckptCache.NumCheckpoints())
 		require.Equal(t, (numPairs-numMatchedPairs)*2, ckptCache.NumSegments())
+		require.Len(t, ckptCache.PendingSegments(), ckptCache.NumSegments())
 	})
 }
@@ -14,6 +14,7 @@ type CheckpointInfo struct {
 	TS    time.Time // the timestamp of the checkpoint being sent
 	Tx1   *BtcTxInfo
 	Tx2   *BtcTxInfo
+	Child *BtcTxInfo // the CPFP child spending the change of Tx2, if the checkpoint has been bumped with one
 }
 
 // BtcTxInfo stores information of a BTC tx as part of a checkpoint